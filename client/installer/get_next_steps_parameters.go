@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetNextStepsParams creates a new GetNextStepsParams object
@@ -64,6 +65,11 @@ type GetNextStepsParams struct {
 	ClusterID strfmt.UUID
 	/*HostID*/
 	HostID strfmt.UUID
+	/*WaitSeconds
+	  Maximum time to hold the request until new steps are available for the host. When set, the reply may have no instructions, in which case the agent should ask again right away. Agents that do not set it get the steps immediately and ask again after next_instruction_seconds.
+
+	*/
+	WaitSeconds *int64
 
 	timeout    time.Duration
 	Context    context.Context
@@ -125,6 +131,17 @@ func (o *GetNextStepsParams) SetHostID(hostID strfmt.UUID) {
 	o.HostID = hostID
 }
 
+// WithWaitSeconds adds the waitSeconds to the get next steps params
+func (o *GetNextStepsParams) WithWaitSeconds(waitSeconds *int64) *GetNextStepsParams {
+	o.SetWaitSeconds(waitSeconds)
+	return o
+}
+
+// SetWaitSeconds adds the waitSeconds to the get next steps params
+func (o *GetNextStepsParams) SetWaitSeconds(waitSeconds *int64) {
+	o.WaitSeconds = waitSeconds
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetNextStepsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -143,6 +160,22 @@ func (o *GetNextStepsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		return err
 	}
 
+	if o.WaitSeconds != nil {
+
+		// query param wait_seconds
+		var qrWaitSeconds int64
+		if o.WaitSeconds != nil {
+			qrWaitSeconds = *o.WaitSeconds
+		}
+		qWaitSeconds := swag.FormatInt64(qrWaitSeconds)
+		if qWaitSeconds != "" {
+			if err := r.SetQueryParam("wait_seconds", qWaitSeconds); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
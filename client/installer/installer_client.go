@@ -42,6 +42,9 @@ type API interface {
 	/*
 	   DownloadClusterKubeconfig downloads the kubeconfig file for this cluster*/
 	DownloadClusterKubeconfig(ctx context.Context, params *DownloadClusterKubeconfigParams, writer io.Writer) (*DownloadClusterKubeconfigOK, error)
+	/*
+	   DownloadHostLogs downloads the latest logs tarball uploaded by the host*/
+	DownloadHostLogs(ctx context.Context, params *DownloadHostLogsParams, writer io.Writer) (*DownloadHostLogsOK, error)
 	/*
 	   EnableHost enables a host for inclusion in the cluster*/
 	EnableHost(ctx context.Context, params *EnableHostParams) (*EnableHostOK, error)
@@ -51,15 +54,30 @@ type API interface {
 	/*
 	   GetCluster retrieves the details of the open shift bare metal cluster*/
 	GetCluster(ctx context.Context, params *GetClusterParams) (*GetClusterOK, error)
+	/*
+	   GetClusterConnectivity retrieves the connectivity matrix between the hosts of the cluster*/
+	GetClusterConnectivity(ctx context.Context, params *GetClusterConnectivityParams) (*GetClusterConnectivityOK, error)
+	/*
+	   GetClusterInstallConfig renders the install config that the installation would generate for the cluster in its current state with the pull secret and the SSH key redacted*/
+	GetClusterInstallConfig(ctx context.Context, params *GetClusterInstallConfigParams) (*GetClusterInstallConfigOK, error)
+	/*
+	   GetClusterNetworkTopology retrieves the networks of the hosts of the cluster with their interfaces and the reachability between the hosts over each network*/
+	GetClusterNetworkTopology(ctx context.Context, params *GetClusterNetworkTopologyParams) (*GetClusterNetworkTopologyOK, error)
 	/*
 	   GetCredentials gets the the cluster admin credentials*/
 	GetCredentials(ctx context.Context, params *GetCredentialsParams) (*GetCredentialsOK, error)
+	/*
+	   GetDebugStep retrieves a debug step of the host with its status and output*/
+	GetDebugStep(ctx context.Context, params *GetDebugStepParams) (*GetDebugStepOK, error)
 	/*
 	   GetFreeAddresses retrieves the free address list for a network*/
 	GetFreeAddresses(ctx context.Context, params *GetFreeAddressesParams) (*GetFreeAddressesOK, error)
 	/*
 	   GetHost retrieves the details of the open shift bare metal host*/
 	GetHost(ctx context.Context, params *GetHostParams) (*GetHostOK, error)
+	/*
+	   GetHostPullSecret agents API to fetch the pull secret of the cluster for pulling the images the host needs*/
+	GetHostPullSecret(ctx context.Context, params *GetHostPullSecretParams) (*GetHostPullSecretOK, error)
 	/*
 	   GetNextSteps retrieves the next operations that the host agent needs to perform*/
 	GetNextSteps(ctx context.Context, params *GetNextStepsParams) (*GetNextStepsOK, error)
@@ -69,12 +87,21 @@ type API interface {
 	/*
 	   ListClusters retrieves the list of open shift bare metal clusters*/
 	ListClusters(ctx context.Context, params *ListClustersParams) (*ListClustersOK, error)
+	/*
+	   ListDebugSteps retrieves the debug steps of the host with their status and output*/
+	ListDebugSteps(ctx context.Context, params *ListDebugStepsParams) (*ListDebugStepsOK, error)
+	/*
+	   ListHostSteps retrieves the latest steps that were sent to the host agent with their replies*/
+	ListHostSteps(ctx context.Context, params *ListHostStepsParams) (*ListHostStepsOK, error)
 	/*
 	   ListHosts retrieves the list of open shift bare metal hosts*/
 	ListHosts(ctx context.Context, params *ListHostsParams) (*ListHostsOK, error)
 	/*
 	   PostStepReply posts the result of the operations from the host agent*/
 	PostStepReply(ctx context.Context, params *PostStepReplyParams) (*PostStepReplyNoContent, error)
+	/*
+	   RebindHost moves a host that was not installed yet to another cluster of the same owner*/
+	RebindHost(ctx context.Context, params *RebindHostParams) (*RebindHostOK, error)
 	/*
 	   RegisterCluster creates a new open shift bare metal cluster definition*/
 	RegisterCluster(ctx context.Context, params *RegisterClusterParams) (*RegisterClusterCreated, error)
@@ -85,17 +112,26 @@ type API interface {
 	   ResetCluster resets a failed installation*/
 	ResetCluster(ctx context.Context, params *ResetClusterParams) (*ResetClusterAccepted, error)
 	/*
-	   SetDebugStep sets a single shot debug step that will be sent next time the host agent will ask for a command*/
+	   SearchHosts searches the hosts of all the accessible clusters*/
+	SearchHosts(ctx context.Context, params *SearchHostsParams) (*SearchHostsOK, error)
+	/*
+	   SetDebugStep queues a single shot debug step that will be sent to the host agent when the previously queued debug steps are sent*/
 	SetDebugStep(ctx context.Context, params *SetDebugStepParams) (*SetDebugStepNoContent, error)
 	/*
 	   UpdateCluster updates an open shift bare metal cluster definition*/
 	UpdateCluster(ctx context.Context, params *UpdateClusterParams) (*UpdateClusterCreated, error)
+	/*
+	   UpdateClusterInstallConfig overrides values in the install config*/
+	UpdateClusterInstallConfig(ctx context.Context, params *UpdateClusterInstallConfigParams) (*UpdateClusterInstallConfigCreated, error)
 	/*
 	   UpdateHostInstallProgress updates installation progress*/
 	UpdateHostInstallProgress(ctx context.Context, params *UpdateHostInstallProgressParams) (*UpdateHostInstallProgressOK, error)
 	/*
 	   UploadClusterIngressCert transfers the ingress certificate for the cluster*/
 	UploadClusterIngressCert(ctx context.Context, params *UploadClusterIngressCertParams) (*UploadClusterIngressCertCreated, error)
+	/*
+	   UploadHostLogs agents API to upload a tarball with the agent installer and journal logs of the host*/
+	UploadHostLogs(ctx context.Context, params *UploadHostLogsParams) (*UploadHostLogsNoContent, error)
 }
 
 // New creates a new installer API client.
@@ -308,6 +344,30 @@ func (a *Client) DownloadClusterKubeconfig(ctx context.Context, params *Download
 
 }
 
+/*
+DownloadHostLogs downloads the latest logs tarball uploaded by the host
+*/
+func (a *Client) DownloadHostLogs(ctx context.Context, params *DownloadHostLogsParams, writer io.Writer) (*DownloadHostLogsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DownloadHostLogs",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/logs",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DownloadHostLogsReader{formats: a.formats, writer: writer},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DownloadHostLogsOK), nil
+
+}
+
 /*
 EnableHost enables a host for inclusion in the cluster
 */
@@ -380,6 +440,78 @@ func (a *Client) GetCluster(ctx context.Context, params *GetClusterParams) (*Get
 
 }
 
+/*
+GetClusterConnectivity retrieves the connectivity matrix between the hosts of the cluster
+*/
+func (a *Client) GetClusterConnectivity(ctx context.Context, params *GetClusterConnectivityParams) (*GetClusterConnectivityOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterConnectivity",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/connectivity",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterConnectivityReader{formats: a.formats},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterConnectivityOK), nil
+
+}
+
+/*
+GetClusterInstallConfig renders the install config that the installation would generate for the cluster in its current state with the pull secret and the SSH key redacted
+*/
+func (a *Client) GetClusterInstallConfig(ctx context.Context, params *GetClusterInstallConfigParams) (*GetClusterInstallConfigOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterInstallConfig",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/install-config",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterInstallConfigReader{formats: a.formats},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterInstallConfigOK), nil
+
+}
+
+/*
+GetClusterNetworkTopology retrieves the networks of the hosts of the cluster with their interfaces and the reachability between the hosts over each network
+*/
+func (a *Client) GetClusterNetworkTopology(ctx context.Context, params *GetClusterNetworkTopologyParams) (*GetClusterNetworkTopologyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterNetworkTopology",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/network-topology",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterNetworkTopologyReader{formats: a.formats},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterNetworkTopologyOK), nil
+
+}
+
 /*
 GetCredentials gets the the cluster admin credentials
 */
@@ -404,6 +536,30 @@ func (a *Client) GetCredentials(ctx context.Context, params *GetCredentialsParam
 
 }
 
+/*
+GetDebugStep retrieves a debug step of the host with its status and output
+*/
+func (a *Client) GetDebugStep(ctx context.Context, params *GetDebugStepParams) (*GetDebugStepOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetDebugStep",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/debug-steps/{step_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetDebugStepReader{formats: a.formats},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetDebugStepOK), nil
+
+}
+
 /*
 GetFreeAddresses retrieves the free address list for a network
 */
@@ -452,6 +608,30 @@ func (a *Client) GetHost(ctx context.Context, params *GetHostParams) (*GetHostOK
 
 }
 
+/*
+GetHostPullSecret agents API to fetch the pull secret of the cluster for pulling the images the host needs
+*/
+func (a *Client) GetHostPullSecret(ctx context.Context, params *GetHostPullSecretParams) (*GetHostPullSecretOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetHostPullSecret",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/pull-secret",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetHostPullSecretReader{formats: a.formats},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetHostPullSecretOK), nil
+
+}
+
 /*
 GetNextSteps retrieves the next operations that the host agent needs to perform
 */
@@ -524,6 +704,54 @@ func (a *Client) ListClusters(ctx context.Context, params *ListClustersParams) (
 
 }
 
+/*
+ListDebugSteps retrieves the debug steps of the host with their status and output
+*/
+func (a *Client) ListDebugSteps(ctx context.Context, params *ListDebugStepsParams) (*ListDebugStepsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListDebugSteps",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/debug-steps",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListDebugStepsReader{formats: a.formats},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListDebugStepsOK), nil
+
+}
+
+/*
+ListHostSteps retrieves the latest steps that were sent to the host agent with their replies
+*/
+func (a *Client) ListHostSteps(ctx context.Context, params *ListHostStepsParams) (*ListHostStepsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListHostSteps",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/steps",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListHostStepsReader{formats: a.formats},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListHostStepsOK), nil
+
+}
+
 /*
 ListHosts retrieves the list of open shift bare metal hosts
 */
@@ -572,6 +800,30 @@ func (a *Client) PostStepReply(ctx context.Context, params *PostStepReplyParams)
 
 }
 
+/*
+RebindHost moves a host that was not installed yet to another cluster of the same owner
+*/
+func (a *Client) RebindHost(ctx context.Context, params *RebindHostParams) (*RebindHostOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RebindHost",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/actions/rebind",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RebindHostReader{formats: a.formats},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RebindHostOK), nil
+
+}
+
 /*
 RegisterCluster creates a new open shift bare metal cluster definition
 */
@@ -645,7 +897,31 @@ func (a *Client) ResetCluster(ctx context.Context, params *ResetClusterParams) (
 }
 
 /*
-SetDebugStep sets a single shot debug step that will be sent next time the host agent will ask for a command
+SearchHosts searches the hosts of all the accessible clusters
+*/
+func (a *Client) SearchHosts(ctx context.Context, params *SearchHostsParams) (*SearchHostsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SearchHosts",
+		Method:             "GET",
+		PathPattern:        "/hosts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SearchHostsReader{formats: a.formats},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SearchHostsOK), nil
+
+}
+
+/*
+SetDebugStep queues a single shot debug step that will be sent to the host agent when the previously queued debug steps are sent
 */
 func (a *Client) SetDebugStep(ctx context.Context, params *SetDebugStepParams) (*SetDebugStepNoContent, error) {
 
@@ -692,6 +968,30 @@ func (a *Client) UpdateCluster(ctx context.Context, params *UpdateClusterParams)
 
 }
 
+/*
+UpdateClusterInstallConfig overrides values in the install config
+*/
+func (a *Client) UpdateClusterInstallConfig(ctx context.Context, params *UpdateClusterInstallConfigParams) (*UpdateClusterInstallConfigCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateClusterInstallConfig",
+		Method:             "PATCH",
+		PathPattern:        "/clusters/{cluster_id}/install-config",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateClusterInstallConfigReader{formats: a.formats},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateClusterInstallConfigCreated), nil
+
+}
+
 /*
 UpdateHostInstallProgress updates installation progress
 */
@@ -739,3 +1039,27 @@ func (a *Client) UploadClusterIngressCert(ctx context.Context, params *UploadClu
 	return result.(*UploadClusterIngressCertCreated), nil
 
 }
+
+/*
+UploadHostLogs agents API to upload a tarball with the agent installer and journal logs of the host
+*/
+func (a *Client) UploadHostLogs(ctx context.Context, params *UploadHostLogsParams) (*UploadHostLogsNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UploadHostLogs",
+		Method:             "POST",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/logs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"multipart/form-data"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UploadHostLogsReader{formats: a.formats},
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UploadHostLogsNoContent), nil
+
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"net/http"
+	"regexp"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -50,6 +51,9 @@ func init() {
 	strfmt.MarshalFormat = strfmt.ISO8601LocalTime
 }
 
+// Path of the API that hosts upload their logs tarball to, its size is limited
+var hostLogsUploadPath = regexp.MustCompile(`^/api/assisted-install/v1/clusters/[^/]+/hosts/[^/]+/logs$`)
+
 var Options struct {
 	BMConfig                    bminventory.Config
 	DBConfig                    db.Config
@@ -75,6 +79,9 @@ func main() {
 	if err != nil {
 		log.Fatal(err.Error())
 	}
+	if err = Options.BMConfig.Validate(); err != nil {
+		log.Fatal(err.Error())
+	}
 
 	port := flag.String("port", "8090", "define port that the service will listen to")
 	flag.Parse()
@@ -117,7 +124,7 @@ func main() {
 	db.DB().SetMaxOpenConns(0)
 	db.DB().SetConnMaxLifetime(0)
 
-	if err = db.AutoMigrate(&models.Host{}, &common.Cluster{}, &events.Event{}).Error; err != nil {
+	if err = db.AutoMigrate(&models.Host{}, &common.Cluster{}, &events.Event{}, &models.DebugStepResult{}, &models.HostStep{}).Error; err != nil {
 		log.Fatal("failed to auto migrate, ", err)
 	}
 
@@ -129,7 +136,8 @@ func main() {
 	instructionApi := host.NewInstructionManager(log.WithField("pkg", "instructions"), db, hwValidator, Options.InstructionConfig, connectivityValidator)
 	prometheusRegistry := prometheus.DefaultRegisterer
 	metricsManager := metrics.NewMetricsManager(prometheusRegistry)
-	hostApi := host.NewManager(log.WithField("pkg", "host-state"), db, eventsHandler, hwValidator, instructionApi, &Options.HWValidatorConfig, metricsManager)
+	hostApi := host.NewManager(log.WithField("pkg", "host-state"), db, eventsHandler, hwValidator, instructionApi, &Options.HWValidatorConfig,
+		&Options.InstructionConfig.AgentVersionConfig, metricsManager)
 	clusterApi := cluster.NewManager(Options.ClusterConfig, log.WithField("pkg", "cluster-state"), db,
 		eventsHandler, hostApi, metricsManager)
 
@@ -176,6 +184,7 @@ func main() {
 		ManagedDomainsAPI: domainHandler,
 		InnerMiddleware:   metrics.WithMatchedRoute(log.WithField("pkg", "matched-h"), prometheusRegistry),
 	})
+	h = app.WithRequestSizeLimitMiddleware(http.MethodPost, hostLogsUploadPath, Options.BMConfig.MaxHostLogsSize, h)
 	h = app.WithMetricsResponderMiddleware(h)
 	h = app.WithHealthMiddleware(h)
 	// TODO: replace this with real auth
@@ -3,13 +3,17 @@ package bminventory
 import (
 	"bytes"
 	"context"
+	"crypto/subtle"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
+	"path"
 	"regexp"
 	"sort"
 	"strconv"
@@ -26,6 +30,7 @@ import (
 	"github.com/filanov/bm-inventory/internal/cluster"
 	"github.com/filanov/bm-inventory/internal/cluster/validations"
 	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/internal/connectivity"
 	"github.com/filanov/bm-inventory/internal/events"
 	"github.com/filanov/bm-inventory/internal/host"
 	"github.com/filanov/bm-inventory/internal/installcfg"
@@ -64,17 +69,39 @@ const (
 )
 
 const DefaultUser = "kubeadmin"
+
+// Agents that are not aware of the cluster_id in the steps reply keep polling the previous cluster of a moved host
+const movedHostNextInstructionSeconds = int64(60)
+
+const (
+	defaultDebugStepTimeout = int64(600)
+	// Exit code of the timeout command when the debug command did not complete in time
+	debugStepTimeoutExitCode = 124
+	// Exit code recorded for a check whose reply could not be parsed
+	invalidReplyExitCode = -1
+)
 const ConsoleUrlPrefix = "https://console-openshift-console.apps"
 
+const (
+	DuplicateHostPolicyFlag    = "flag"
+	DuplicateHostPolicyMerge   = "merge"
+	DuplicateHostPolicyReplace = "replace"
+)
+
+var duplicateHostPolicies = []string{DuplicateHostPolicyFlag, DuplicateHostPolicyMerge, DuplicateHostPolicyReplace}
+
+// Statuses of the hosts that may be merged into or replaced by a new registration of their machine
+var staleHostStatuses = []string{models.HostStatusDiscovering, models.HostStatusKnown, models.HostStatusDisconnected,
+	models.HostStatusInsufficient, models.HostStatusPendingForInput, models.HostStatusDisabled}
+
 var (
-	DefaultClusterNetworkCidr       = "10.128.0.0/14"
-	DefaultClusterNetworkHostPrefix = int64(23)
-	DefaultServiceNetworkCidr       = "172.30.0.0/16"
+	DefaultClusterNetworkCidr = "10.128.0.0/14"
+	DefaultServiceNetworkCidr = "172.30.0.0/16"
 )
 
 type Config struct {
+	host.AgentVersionConfig
 	ImageBuilder        string `envconfig:"IMAGE_BUILDER" default:"quay.io/ocpmetal/installer-image-build:latest"`
-	AgentDockerImg      string `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/ocpmetal/agent:latest"`
 	KubeconfigGenerator string `envconfig:"KUBECONFIG_GENERATE_IMAGE" default:"quay.io/ocpmetal/ignition-manifests-and-kubeconfig-generate:latest"` // TODO: update the latest once the repository has git workflow
 	//[TODO] -  change the default of Releae image to "", once everyine wll update their environment
 	ReleaseImage       string            `envconfig:"OPENSHIFT_INSTALL_RELEASE_IMAGE" default:"quay.io/openshift-release-dev/ocp-release@sha256:eab93b4591699a5a4ff50ad3517892653f04fb840127895bb3609b3cc68f98f3"`
@@ -91,6 +118,22 @@ type Config struct {
 	JobMemoryLimit     string            `envconfig:"JOB_MEMORY_LIMIT" default:"1000Mi"`
 	JobCPURequests     string            `envconfig:"JOB_CPU_REQUESTS" default:"300m"`
 	JobMemoryRequests  string            `envconfig:"JOB_MEMORY_REQUESTS" default:"400Mi"`
+	// Interval at which a held next steps request reads its host again, to notice changes made by other replicas
+	StepsLongPollInterval time.Duration `envconfig:"STEPS_LONG_POLL_INTERVAL" default:"2s"`
+	// What to do with the stale host of a machine that registered again under a new id: flag the new host, merge
+	// the stale host into it or replace the stale host
+	DuplicateHostPolicy string `envconfig:"DUPLICATE_HOST_POLICY" default:"flag"`
+	// Largest logs tarball, in bytes, that a host may upload
+	MaxHostLogsSize int64 `envconfig:"MAX_HOST_LOGS_SIZE" default:"104857600"`
+}
+
+// Validate returns an error when the configuration has a value that the service does not support
+func (c *Config) Validate() error {
+	if !funk.ContainsString(duplicateHostPolicies, c.DuplicateHostPolicy) {
+		return errors.Errorf("unknown duplicate host policy %q, expected one of %s", c.DuplicateHostPolicy,
+			strings.Join(duplicateHostPolicies, ", "))
+	}
+	return nil
 }
 
 const agentMessageOfTheDay = `
@@ -113,8 +156,8 @@ const ignitionConfigFormat = `{
 "units": [{
 "name": "agent.service",
 "enabled": true,
-"contents": "[Service]\nType=simple\nRestart=always\nRestartSec=3\nStartLimitIntervalSec=0\nEnvironment=HTTPS_PROXY={{.ProxyURL}}\nEnvironment=HTTP_PROXY={{.ProxyURL}}\nEnvironment=http_proxy={{.ProxyURL}}\nEnvironment=https_proxy={{.ProxyURL}}\nEnvironment=PULL_SECRET_TOKEN={{.PullSecretToken}}\nExecStartPre=podman run --privileged --rm -v /usr/local/bin:/hostbin {{.AgentDockerImg}} cp /usr/bin/agent /hostbin\nExecStart=/usr/local/bin/agent --host {{.InventoryURL}} --port {{.InventoryPort}} --cluster-id {{.clusterId}} --agent-version {{.AgentDockerImg}}\n\n[Install]\nWantedBy=multi-user.target"
-}]
+"contents": "[Service]\nType=simple\nRestart=always\nRestartSec=3\nStartLimitIntervalSec=0\n{{if .HTTPProxy}}Environment=HTTP_PROXY={{.HTTPProxy}}\nEnvironment=http_proxy={{.HTTPProxy}}\n{{end}}{{if .HTTPSProxy}}Environment=HTTPS_PROXY={{.HTTPSProxy}}\nEnvironment=https_proxy={{.HTTPSProxy}}\n{{end}}{{if .NoProxy}}Environment=NO_PROXY={{.NoProxy}}\nEnvironment=no_proxy={{.NoProxy}}\n{{end}}Environment=PULL_SECRET_TOKEN={{.PullSecretToken}}\nExecStartPre=podman run --privileged --rm -v /usr/local/bin:/hostbin {{.AgentDockerImg}} cp /usr/bin/agent /hostbin\nExecStart=/usr/local/bin/agent --host {{.InventoryURL}} --port {{.InventoryPort}} --cluster-id {{.clusterId}} --agent-version {{.AgentDockerImg}}\n\n[Install]\nWantedBy=multi-user.target"
+}{{.ExtraUnits}}]
 },
 "storage": {
     "files": [{
@@ -122,10 +165,19 @@ const ignitionConfigFormat = `{
       "path": "/etc/motd",
       "mode": 644,
       "contents": { "source": "data:,{{.AGENT_MOTD}}" }
-    }]
+    }{{.ExtraFiles}}]
   }
 }`
 
+// Ignitions of the installed nodes that carry the static network configuration of the hosts
+var staticNetworkIgnitionFileNames = []string{
+	"master.ign",
+	"worker.ign",
+}
+
+// Directory of the bootstrap node from which the installer applies the OpenShift manifests
+const bootstrapOpenshiftManifestsDir = "/opt/openshift/openshift"
+
 var clusterFileNames = []string{
 	"kubeconfig",
 	"bootstrap.ign",
@@ -137,16 +189,9 @@ var clusterFileNames = []string{
 	"install-config.yaml",
 }
 
-type debugCmd struct {
-	cmd    string
-	stepID string
-}
-
 type bareMetalInventory struct {
 	Config
 	db            *gorm.DB
-	debugCmdMap   map[strfmt.UUID]debugCmd
-	debugCmdMux   sync.Mutex
 	log           logrus.FieldLogger
 	job           job.API
 	hostApi       host.API
@@ -154,6 +199,7 @@ type bareMetalInventory struct {
 	eventsHandler events.Handler
 	s3Client      awsS3CLient.S3Client
 	metricApi     metrics.API
+	stepsNotifier *stepsNotifier
 }
 
 var _ restapi.InstallerAPI = &bareMetalInventory{}
@@ -174,13 +220,13 @@ func NewBareMetalInventory(
 		db:            db,
 		log:           log,
 		Config:        cfg,
-		debugCmdMap:   make(map[strfmt.UUID]debugCmd),
 		hostApi:       hostApi,
 		clusterApi:    clusterApi,
 		job:           jobApi,
 		eventsHandler: eventsHandler,
 		s3Client:      s3Client,
 		metricApi:     metricApi,
+		stepsNotifier: newStepsNotifier(),
 	}
 
 	if b.Config.UseK8s {
@@ -294,15 +340,41 @@ func (b *bareMetalInventory) formatIgnitionFile(cluster *common.Cluster, params
 		return "", fmt.Errorf("Pull secret does not contain auth for cloud.openshift.com")
 	}
 
+	files, units, err := staticNetworkIgnition(params.ImageCreateParams.StaticNetworkConfig)
+	if err != nil {
+		return "", err
+	}
+	files = append(files, ntpIgnitionFiles(network.ParseNtpServers(cluster.NtpServers))...)
+	var extraFiles, extraUnits string
+	for _, f := range files {
+		data, err := json.Marshal(f)
+		if err != nil {
+			return "", err
+		}
+		extraFiles += "," + string(data)
+	}
+	for _, u := range units {
+		data, err := json.Marshal(u)
+		if err != nil {
+			return "", err
+		}
+		extraUnits += "," + string(data)
+	}
+
+	httpProxy, httpsProxy, noProxy := agentProxy(cluster, params)
 	var ignitionParams = map[string]string{
 		"userSshKey":      b.getUserSshKey(params),
 		"AgentDockerImg":  b.AgentDockerImg,
 		"InventoryURL":    strings.TrimSpace(b.InventoryURL),
 		"InventoryPort":   strings.TrimSpace(b.InventoryPort),
 		"clusterId":       cluster.ID.String(),
-		"ProxyURL":        params.ImageCreateParams.ProxyURL,
+		"HTTPProxy":       httpProxy,
+		"HTTPSProxy":      httpsProxy,
+		"NoProxy":         noProxy,
 		"PullSecretToken": r.AuthRaw,
 		"AGENT_MOTD":      url.PathEscape(agentMessageOfTheDay),
+		"ExtraFiles":      extraFiles,
+		"ExtraUnits":      extraUnits,
 	}
 	tmpl, err := template.New("ignitionConfig").Parse(ignitionConfigFormat)
 	if err != nil {
@@ -315,6 +387,170 @@ func (b *bareMetalInventory) formatIgnitionFile(cluster *common.Cluster, params
 	return buf.String(), nil
 }
 
+// agentProxy returns the proxy settings of the discovery agent, the ones of the cluster or, when the cluster has no
+// proxy, the deprecated proxy URL of the image for both HTTP and HTTPS
+func agentProxy(cluster *common.Cluster, params installer.GenerateClusterISOParams) (string, string, string) {
+	if network.IsProxySet(&cluster.Cluster) || params.ImageCreateParams.ProxyURL == "" {
+		return cluster.HTTPProxy, cluster.HTTPSProxy, cluster.NoProxy
+	}
+	return params.ImageCreateParams.ProxyURL, params.ImageCreateParams.ProxyURL, cluster.NoProxy
+}
+
+type ignitionFileContents struct {
+	Source string `json:"source"`
+}
+
+type ignitionFile struct {
+	Filesystem string               `json:"filesystem"`
+	Path       string               `json:"path"`
+	Mode       int                  `json:"mode"`
+	Contents   ignitionFileContents `json:"contents"`
+}
+
+type ignitionUnit struct {
+	Name     string `json:"name"`
+	Enabled  bool   `json:"enabled"`
+	Contents string `json:"contents"`
+}
+
+func newIgnitionFile(path string, mode int, contents string) ignitionFile {
+	return ignitionFile{
+		Filesystem: "root",
+		Path:       path,
+		Mode:       mode,
+		Contents: ignitionFileContents{
+			Source: "data:text/plain;charset=utf-8;base64," + base64.StdEncoding.EncodeToString([]byte(contents)),
+		},
+	}
+}
+
+// staticNetworkIgnition returns the ignition files and units that apply the static network configuration of the
+// hosts, nothing if no host has static network configuration
+func staticNetworkIgnition(configs []*models.HostStaticNetworkConfig) ([]ignitionFile, []ignitionUnit, error) {
+	networkFiles, err := network.GenerateStaticNetworkFiles(configs)
+	if err != nil || len(networkFiles) == 0 {
+		return nil, nil, err
+	}
+	files := make([]ignitionFile, 0, len(networkFiles))
+	for _, f := range networkFiles {
+		files = append(files, newIgnitionFile(f.Path, f.Mode, f.Contents))
+	}
+	units := []ignitionUnit{{Name: network.StaticNetworkUnitName, Enabled: true, Contents: network.StaticNetworkUnitContents}}
+	return files, units, nil
+}
+
+// ntpIgnitionFiles returns the chrony configuration of the discovery image, nothing if the cluster has no NTP servers
+func ntpIgnitionFiles(servers []string) []ignitionFile {
+	if len(servers) == 0 {
+		return nil
+	}
+	return []ignitionFile{newIgnitionFile(network.ChronyConfPath, 420, network.ChronyConf(servers))}
+}
+
+// addToIgnition appends files and units to an ignition config generated by the installer, keeping everything else in
+// it as is
+func addToIgnition(ignition []byte, files []ignitionFile, units []ignitionUnit) ([]byte, error) {
+	var config map[string]interface{}
+	if err := json.Unmarshal(ignition, &config); err != nil {
+		return nil, errors.Wrap(err, "failed to parse ignition")
+	}
+	storage, ok := config["storage"].(map[string]interface{})
+	if !ok {
+		storage = map[string]interface{}{}
+	}
+	configFiles, _ := storage["files"].([]interface{})
+	for _, f := range files {
+		configFiles = append(configFiles, f)
+	}
+	storage["files"] = configFiles
+	config["storage"] = storage
+
+	systemd, ok := config["systemd"].(map[string]interface{})
+	if !ok {
+		systemd = map[string]interface{}{}
+	}
+	configUnits, _ := systemd["units"].([]interface{})
+	for _, u := range units {
+		configUnits = append(configUnits, u)
+	}
+	systemd["units"] = configUnits
+	config["systemd"] = systemd
+	return json.Marshal(config)
+}
+
+func getStaticNetworkConfig(cluster *common.Cluster) ([]*models.HostStaticNetworkConfig, error) {
+	var configs []*models.HostStaticNetworkConfig
+	if cluster.ImageInfo == nil || cluster.ImageInfo.StaticNetworkConfig == "" {
+		return configs, nil
+	}
+	if err := json.Unmarshal([]byte(cluster.ImageInfo.StaticNetworkConfig), &configs); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse static network configuration of cluster %s", cluster.ID)
+	}
+	return configs, nil
+}
+
+// The hosts keep the static network configuration of the discovery image after they are installed
+func (b *bareMetalInventory) addStaticNetworkToInstallIgnitions(ctx context.Context, cluster *common.Cluster) error {
+	configs, err := getStaticNetworkConfig(cluster)
+	if err != nil {
+		return err
+	}
+	files, units, err := staticNetworkIgnition(configs)
+	if err != nil || len(files) == 0 {
+		return err
+	}
+	for _, name := range staticNetworkIgnitionFileNames {
+		if err = b.patchInstallIgnition(ctx, cluster, name, files, units); err != nil {
+			return errors.Wrap(err, "failed to add static network configuration")
+		}
+	}
+	return nil
+}
+
+// The installed nodes get the chrony configuration from machine configs, that are applied by the bootstrap node
+func (b *bareMetalInventory) addNtpToInstallIgnitions(ctx context.Context, cluster *common.Cluster) error {
+	manifests := network.ChronyMachineConfigs(network.ParseNtpServers(cluster.NtpServers))
+	if len(manifests) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(manifests))
+	for name := range manifests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	files := make([]ignitionFile, 0, len(manifests))
+	for _, name := range names {
+		files = append(files, newIgnitionFile(path.Join(bootstrapOpenshiftManifestsDir, name), 420, manifests[name]))
+	}
+	if err := b.patchInstallIgnition(ctx, cluster, "bootstrap.ign", files, nil); err != nil {
+		return errors.Wrap(err, "failed to add NTP configuration")
+	}
+	return nil
+}
+
+// patchInstallIgnition adds files and units to an ignition that was generated by the installer and stored in S3
+func (b *bareMetalInventory) patchInstallIgnition(ctx context.Context, cluster *common.Cluster, name string,
+	files []ignitionFile, units []ignitionUnit) error {
+	fileName := fmt.Sprintf("%s/%s", cluster.ID, name)
+	resp, _, err := b.s3Client.DownloadFileFromS3(ctx, fileName, b.S3Bucket)
+	if err != nil {
+		return errors.Wrapf(err, "failed to download %s", fileName)
+	}
+	ignition, err := ioutil.ReadAll(resp)
+	resp.Close()
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s", fileName)
+	}
+	ignition, err = addToIgnition(ignition, files, units)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update %s", fileName)
+	}
+	if err = b.s3Client.PushDataToS3(ctx, ignition, fileName, b.S3Bucket); err != nil {
+		return errors.Wrapf(err, "failed to upload %s", fileName)
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) getUserSshKey(params installer.GenerateClusterISOParams) string {
 	sshKey := params.ImageCreateParams.SSHPublicKey
 	if sshKey == "" {
@@ -338,11 +574,16 @@ func (b *bareMetalInventory) RegisterCluster(ctx context.Context, params install
 		params.NewClusterParams.ClusterNetworkCidr = &DefaultClusterNetworkCidr
 	}
 	if params.NewClusterParams.ClusterNetworkHostPrefix == 0 {
-		params.NewClusterParams.ClusterNetworkHostPrefix = DefaultClusterNetworkHostPrefix
+		params.NewClusterParams.ClusterNetworkHostPrefix =
+			network.DefaultHostPrefix(swag.StringValue(params.NewClusterParams.ClusterNetworkCidr))
 	}
 	if params.NewClusterParams.ServiceNetworkCidr == nil {
 		params.NewClusterParams.ServiceNetworkCidr = &DefaultServiceNetworkCidr
 	}
+	if params.NewClusterParams.SecondaryClusterNetworkCidr != "" && params.NewClusterParams.SecondaryClusterNetworkHostPrefix == 0 {
+		params.NewClusterParams.SecondaryClusterNetworkHostPrefix =
+			network.DefaultHostPrefix(params.NewClusterParams.SecondaryClusterNetworkCidr)
+	}
 
 	cluster := common.Cluster{Cluster: models.Cluster{
 		ID:                       &id,
@@ -359,7 +600,46 @@ func (b *bareMetalInventory) RegisterCluster(ctx context.Context, params install
 		UpdatedAt:                strfmt.DateTime{},
 		UserID:                   auth.UserIDFromContext(ctx),
 		OrgID:                    auth.OrgIDFromContext(ctx),
+
+		SecondaryClusterNetworkCidr:       params.NewClusterParams.SecondaryClusterNetworkCidr,
+		SecondaryClusterNetworkHostPrefix: params.NewClusterParams.SecondaryClusterNetworkHostPrefix,
+		SecondaryServiceNetworkCidr:       params.NewClusterParams.SecondaryServiceNetworkCidr,
+		VipAllocation:                     params.NewClusterParams.VipAllocation,
+		VipExclusionRanges:                params.NewClusterParams.VipExclusionRanges,
+		AdditionalMachineNetworkCidrs: strings.Join(
+			network.ParseMachineNetworkCidrs(params.NewClusterParams.AdditionalMachineNetworkCidrs), ","),
+		HTTPProxy:   params.NewClusterParams.HTTPProxy,
+		HTTPSProxy:  params.NewClusterParams.HTTPSProxy,
+		NoProxy:     strings.Join(network.ParseNoProxy(params.NewClusterParams.NoProxy), ","),
+		NetworkType: params.NewClusterParams.NetworkType,
 	}}
+	if err := validateProxySettings(&params.NewClusterParams.HTTPProxy, &params.NewClusterParams.HTTPSProxy,
+		&params.NewClusterParams.NoProxy); err != nil {
+		log.WithError(err).Errorf("proxy settings of new cluster are invalid")
+		return installer.NewRegisterClusterBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+	if err := verifyVipAllocationParams(cluster.VipAllocation, cluster.VipExclusionRanges,
+		cluster.IngressVip != ""); err != nil {
+		log.WithError(err).Errorf("VIP allocation of new cluster is invalid")
+		return installer.NewRegisterClusterBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+	if err := network.VerifyNetworkFamilies(&cluster.Cluster); err != nil {
+		log.WithError(err).Errorf("networks of new cluster are invalid")
+		return installer.NewRegisterClusterBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+	if err := network.VerifyClusterNetworks(&cluster.Cluster, nil, log); err != nil {
+		log.WithError(err).Errorf("networks of new cluster are invalid")
+		return installer.NewRegisterClusterBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+	if err := network.VerifyNetworkType(&cluster.Cluster); err != nil {
+		log.WithError(err).Errorf("network type of new cluster is invalid")
+		return installer.NewRegisterClusterBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
 	if params.NewClusterParams.PullSecret != "" {
 		err := validations.ValidatePullSecret(params.NewClusterParams.PullSecret)
 		if err != nil {
@@ -372,6 +652,12 @@ func (b *bareMetalInventory) RegisterCluster(ctx context.Context, params install
 	if err := validations.ValidateClusterNameFormat(swag.StringValue(params.NewClusterParams.Name)); err != nil {
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
+	if err := network.ValidateNtpServers(params.NewClusterParams.NtpServers); err != nil {
+		log.WithError(err).Errorf("NTP servers of new cluster are invalid")
+		return installer.NewRegisterClusterBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+	cluster.NtpServers = strings.Join(network.ParseNtpServers(params.NewClusterParams.NtpServers), ",")
 
 	err := b.clusterApi.RegisterCluster(ctx, &cluster)
 	if err != nil {
@@ -504,12 +790,32 @@ func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params inst
 			WithPayload(common.GenerateError(http.StatusBadRequest, errors.New(errMsg)))
 	}
 
+	var staticNetworkConfig string
+	if len(params.ImageCreateParams.StaticNetworkConfig) > 0 {
+		if err := network.ValidateStaticNetworkConfig(params.ImageCreateParams.StaticNetworkConfig); err != nil {
+			log.WithError(err).Errorf("invalid static network configuration for cluster %s", params.ClusterID)
+			return installer.NewGenerateClusterISOBadRequest().
+				WithPayload(common.GenerateError(http.StatusBadRequest, err))
+		}
+		data, err := json.Marshal(params.ImageCreateParams.StaticNetworkConfig)
+		if err != nil {
+			return installer.NewGenerateClusterISOInternalServerError().
+				WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		}
+		staticNetworkConfig = string(data)
+	}
+
 	/* If the request has the same parameters as the previous request and the image is still in S3,
 	just refresh the timestamp.
 	*/
 	var imageExists bool
 	if cluster.ImageInfo.ProxyURL == params.ImageCreateParams.ProxyURL &&
+		cluster.ImageInfo.HTTPProxy == cluster.HTTPProxy &&
+		cluster.ImageInfo.HTTPSProxy == cluster.HTTPSProxy &&
+		cluster.ImageInfo.NoProxy == cluster.NoProxy &&
 		cluster.ImageInfo.SSHPublicKey == params.ImageCreateParams.SSHPublicKey &&
+		cluster.ImageInfo.StaticNetworkConfig == staticNetworkConfig &&
+		cluster.ImageInfo.NtpServers == cluster.NtpServers &&
 		cluster.ImageInfo.GeneratorVersion == b.Config.ImageBuilder {
 		var err error
 		imgName := getImageName(params.ClusterID)
@@ -526,6 +832,11 @@ func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params inst
 	updates := map[string]interface{}{}
 	updates["image_proxy_url"] = params.ImageCreateParams.ProxyURL
 	updates["image_ssh_public_key"] = params.ImageCreateParams.SSHPublicKey
+	updates["image_static_network_config"] = staticNetworkConfig
+	updates["image_ntp_servers"] = cluster.NtpServers
+	updates["image_http_proxy"] = cluster.HTTPProxy
+	updates["image_https_proxy"] = cluster.HTTPSProxy
+	updates["image_no_proxy"] = cluster.NoProxy
 	updates["image_created_at"] = strfmt.DateTime(now)
 	updates["image_generator_version"] = b.Config.ImageBuilder
 	dbReply := tx.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Updates(updates)
@@ -597,13 +908,19 @@ func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params inst
 			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
 
-	log.Infof("Generated cluster <%s> image with ignition config %s", params.ClusterID, ignitionConfig)
-	msg := fmt.Sprintf("Generated image (proxy URL is \"%s\", ", params.ImageCreateParams.ProxyURL)
+	// The ignition config holds the pull secret token and the proxy credentials, so it is not logged
+	log.Infof("Generated cluster <%s> image", params.ClusterID)
+	httpProxy, httpsProxy, _ := agentProxy(&cluster, params)
+	msg := fmt.Sprintf("Generated image (HTTP proxy is \"%s\", HTTPS proxy is \"%s\", ",
+		network.RedactProxyURL(httpProxy), network.RedactProxyURL(httpsProxy))
 	if params.ImageCreateParams.SSHPublicKey != "" {
 		msg += "SSH public key is set)"
 	} else {
 		msg += "SSH public key is not set)"
 	}
+	if len(params.ImageCreateParams.StaticNetworkConfig) > 0 {
+		msg += fmt.Sprintf(", static network configuration is set for %d hosts", len(params.ImageCreateParams.StaticNetworkConfig))
+	}
 	b.eventsHandler.AddEvent(ctx, cluster.ID.String(), models.EventSeverityInfo, msg, time.Now())
 	return installer.NewGenerateClusterISOCreated().WithPayload(&cluster.Cluster)
 }
@@ -628,37 +945,80 @@ func (b *bareMetalInventory) verifyClusterNetworkConfig(ctx context.Context, clu
 		return common.NewApiError(http.StatusBadRequest,
 			fmt.Errorf("Cluster machine CIDR %s is different than the calculated CIDR %s", cluster.MachineNetworkCidr, cidr))
 	}
+	if network.IsDualStack(&cluster.Cluster) {
+		cidr, err = network.CalculateSecondaryMachineNetworkCIDR(cluster.MachineNetworkCidr, cluster.Hosts)
+		if err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		if cidr != cluster.SecondaryMachineNetworkCidr {
+			return common.NewApiError(http.StatusBadRequest,
+				fmt.Errorf("Cluster secondary machine CIDR %s is different than the calculated CIDR %s",
+					cluster.SecondaryMachineNetworkCidr, cidr))
+		}
+	}
+	if err = network.VerifyNetworkFamilies(&cluster.Cluster); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = network.VerifyClusterNetworks(&cluster.Cluster, cluster.Hosts, b.log); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = network.VerifyNetworkType(&cluster.Cluster); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	if err = network.VerifyNoProxy(&cluster.Cluster); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
 	if err = network.VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip,
 		true, b.log); err != nil {
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
+	if cluster.VipAllocation {
+		if err = verifyAllocatedVips(b.db, cluster, b.log); err != nil {
+			return err
+		}
+	}
 	machineCidrHosts, err := network.GetMachineCIDRHosts(b.log, cluster)
 	if err != nil {
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
+	for _, cidr := range network.ParseMachineNetworkCidrs(cluster.AdditionalMachineNetworkCidrs) {
+		if !network.IsHostNetwork(cluster.Hosts, cidr, b.log) {
+			return common.NewApiError(http.StatusBadRequest,
+				fmt.Errorf("Additional machine network %s is not the network of any host", cidr))
+		}
+	}
 	masterNodesIds, err := b.clusterApi.GetMasterNodesIds(ctx, cluster, b.db)
 	if err != nil {
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
-	hostIDInCidrHosts := func(id strfmt.UUID, hosts []*models.Host) bool {
+	// The masters hold the VIPs, so they must be in the machine network of the VIPs rather than in an additional one
+	hostIDInVipNetwork := func(id strfmt.UUID, hosts []*models.Host) bool {
 		for _, h := range hosts {
 			if *h.ID == id {
-				return true
+				return network.IsHostInVipNetwork(b.log, cluster, h)
 			}
 		}
 		return false
 	}
 
 	for _, id := range masterNodesIds {
-		if !hostIDInCidrHosts(*id, machineCidrHosts) {
+		if !hostIDInVipNetwork(*id, machineCidrHosts) {
 			return common.NewApiError(http.StatusBadRequest,
 				fmt.Errorf("Master id %s does not have an interface with IP belonging to machine CIDR %s",
-					*id, cluster.MachineNetworkCidr))
+					*id, strings.Join(machineNetworkCidrs(cluster), ",")))
 		}
 	}
 	return nil
 }
 
+// machineNetworkCidrs returns the machine network CIDR of the cluster, and the secondary one of a dual-stack cluster
+func machineNetworkCidrs(cluster *common.Cluster) []string {
+	if cluster.SecondaryMachineNetworkCidr == "" {
+		return []string{cluster.MachineNetworkCidr}
+	}
+	return []string{cluster.MachineNetworkCidr, cluster.SecondaryMachineNetworkCidr}
+}
+
 func (c *clusterInstaller) installHosts(cluster *common.Cluster, tx *gorm.DB) error {
 	success := true
 	err := errors.Errorf("Failed to install cluster <%s>", cluster.ID.String())
@@ -808,6 +1168,7 @@ func (b *bareMetalInventory) InstallCluster(ctx context.Context, params installe
 		}
 		err = b.db.Transaction(cInstaller.install)
 		if err == nil {
+			b.stepsNotifier.notify(params.ClusterID)
 			//send metric when the installation process has been started
 			b.metricApi.InstallationStarted(cluster.OpenshiftVersion)
 		}
@@ -872,6 +1233,16 @@ func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, c
 		return errors.Wrapf(err, "Generating kubeconfig files %s failed for cluster %s", jobName, cluster.ID)
 	}
 
+	if err := b.addStaticNetworkToInstallIgnitions(ctx, &cluster); err != nil {
+		log.WithError(err).Errorf("failed to add static network configuration to the ignitions of cluster %s", cluster.ID)
+		return errors.Wrapf(err, "failed to add static network configuration to the ignitions of cluster %s", cluster.ID)
+	}
+
+	if err := b.addNtpToInstallIgnitions(ctx, &cluster); err != nil {
+		log.WithError(err).Errorf("failed to add NTP configuration to the ignitions of cluster %s", cluster.ID)
+		return errors.Wrapf(err, "failed to add NTP configuration to the ignitions of cluster %s", cluster.ID)
+	}
+
 	return b.clusterApi.SetGeneratorVersion(&cluster, b.Config.KubeconfigGenerator, b.db)
 }
 
@@ -912,6 +1283,19 @@ func (b *bareMetalInventory) UpdateCluster(ctx context.Context, params installer
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
 	}
+	if params.ClusterUpdateParams.NtpServers != nil {
+		if err = network.ValidateNtpServers(*params.ClusterUpdateParams.NtpServers); err != nil {
+			log.WithError(err).Errorf("NTP servers of cluster %s are invalid", params.ClusterID)
+			return installer.NewUpdateClusterBadRequest().
+				WithPayload(common.GenerateError(http.StatusBadRequest, err))
+		}
+	}
+	if err = validateProxySettings(params.ClusterUpdateParams.HTTPProxy, params.ClusterUpdateParams.HTTPSProxy,
+		params.ClusterUpdateParams.NoProxy); err != nil {
+		log.WithError(err).Errorf("proxy settings of cluster %s are invalid", params.ClusterID)
+		return installer.NewUpdateClusterBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
 
 	txSuccess := false
 	tx := b.db.Begin()
@@ -975,20 +1359,94 @@ func (b *bareMetalInventory) UpdateCluster(ctx context.Context, params installer
 		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
 	}
 
+	b.stepsNotifier.notify(params.ClusterID)
 	cluster.HostNetworks = calculateHostNetworks(log, &cluster)
 	for _, host := range cluster.Hosts {
 		if err := b.customizeHost(host); err != nil {
 			return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
 		}
+		host.MachineNetworkCidr = network.GetHostMachineNetwork(log, &cluster, host)
 	}
 
 	return installer.NewUpdateClusterCreated().WithPayload(&cluster.Cluster)
 }
 
+func (b *bareMetalInventory) GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	if err := b.db.Preload("Hosts", "status <> ?", host.HostStatusDisabled).First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewGetClusterInstallConfigNotFound().
+				WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return installer.NewGetClusterInstallConfigInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	// The installation verifies the network configuration of the cluster before it generates the install config
+	preview := &models.InstallConfigPreview{Errors: make([]string, 0)}
+	if err := b.verifyClusterNetworkConfig(ctx, &cluster); err != nil {
+		preview.Errors = append(preview.Errors, err.Error())
+	}
+	cfg, err := installcfg.GetRedactedInstallConfig(log, &cluster)
+	if err != nil {
+		preview.Errors = append(preview.Errors, err.Error())
+	} else {
+		preview.InstallConfig = string(cfg)
+	}
+	return installer.NewGetClusterInstallConfigOK().WithPayload(preview)
+}
+
+func (b *bareMetalInventory) UpdateClusterInstallConfig(ctx context.Context, params installer.UpdateClusterInstallConfigParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	log.Infof("update install config overrides of cluster %s", params.ClusterID)
+
+	overrides, err := installcfg.ParseInstallConfigOverrides(string(params.InstallConfigParams))
+	if err != nil {
+		log.WithError(err).Errorf("install config overrides of cluster %s are invalid", params.ClusterID)
+		return installer.NewUpdateClusterInstallConfigBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+
+	if err = b.db.Preload("Hosts").First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewUpdateClusterInstallConfigNotFound().
+				WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		return installer.NewUpdateClusterInstallConfigInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	if err = b.clusterApi.VerifyClusterUpdatability(&cluster); err != nil {
+		log.WithError(err).Errorf("cluster %s can't be updated in current state", params.ClusterID)
+		return installer.NewUpdateClusterInstallConfigConflict().
+			WithPayload(common.GenerateError(http.StatusConflict, err))
+	}
+
+	if err = installcfg.ValidateInstallConfigOverrides(log, &cluster, overrides); err != nil {
+		log.WithError(err).Errorf("install config overrides of cluster %s are invalid", params.ClusterID)
+		return installer.NewUpdateClusterInstallConfigBadRequest().
+			WithPayload(common.GenerateError(http.StatusBadRequest, err))
+	}
+
+	if err = b.db.Model(&common.Cluster{}).Where("id = ?", params.ClusterID).
+		Update("install_config_overrides", overrides).Error; err != nil {
+		log.WithError(err).Errorf("failed to update install config overrides of cluster %s", params.ClusterID)
+		return installer.NewUpdateClusterInstallConfigInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	return installer.NewUpdateClusterInstallConfigCreated()
+}
+
 func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *common.Cluster, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
 	updates := map[string]interface{}{}
 	apiVip := cluster.APIVip
 	ingressVip := cluster.IngressVip
+	// The networks of the cluster once updated, they are verified together
+	networks := cluster.Cluster
 	if params.ClusterUpdateParams.Name != nil {
 		updates["name"] = *params.ClusterUpdateParams.Name
 	}
@@ -1001,12 +1459,39 @@ func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *com
 	}
 	if params.ClusterUpdateParams.ClusterNetworkCidr != nil {
 		updates["cluster_network_cidr"] = *params.ClusterUpdateParams.ClusterNetworkCidr
+		networks.ClusterNetworkCidr = *params.ClusterUpdateParams.ClusterNetworkCidr
 	}
 	if params.ClusterUpdateParams.ClusterNetworkHostPrefix != nil {
 		updates["cluster_network_host_prefix"] = *params.ClusterUpdateParams.ClusterNetworkHostPrefix
+		networks.ClusterNetworkHostPrefix = *params.ClusterUpdateParams.ClusterNetworkHostPrefix
+	} else if networks.ClusterNetworkCidr != "" && (networks.ClusterNetworkHostPrefix == 0 ||
+		!network.SameFamily(networks.ClusterNetworkCidr, cluster.ClusterNetworkCidr)) {
+		// The host prefix of the other IP family does not fit the new cluster network
+		networks.ClusterNetworkHostPrefix = network.DefaultHostPrefix(networks.ClusterNetworkCidr)
+		updates["cluster_network_host_prefix"] = networks.ClusterNetworkHostPrefix
 	}
 	if params.ClusterUpdateParams.ServiceNetworkCidr != nil {
 		updates["service_network_cidr"] = *params.ClusterUpdateParams.ServiceNetworkCidr
+		networks.ServiceNetworkCidr = *params.ClusterUpdateParams.ServiceNetworkCidr
+	}
+	if params.ClusterUpdateParams.SecondaryClusterNetworkCidr != nil {
+		networks.SecondaryClusterNetworkCidr = *params.ClusterUpdateParams.SecondaryClusterNetworkCidr
+		updates["secondary_cluster_network_cidr"] = networks.SecondaryClusterNetworkCidr
+	}
+	if params.ClusterUpdateParams.SecondaryClusterNetworkHostPrefix != nil {
+		networks.SecondaryClusterNetworkHostPrefix = *params.ClusterUpdateParams.SecondaryClusterNetworkHostPrefix
+	}
+	if networks.SecondaryClusterNetworkCidr != "" && networks.SecondaryClusterNetworkHostPrefix == 0 {
+		networks.SecondaryClusterNetworkHostPrefix = network.DefaultHostPrefix(networks.SecondaryClusterNetworkCidr)
+	}
+	updates["secondary_cluster_network_host_prefix"] = networks.SecondaryClusterNetworkHostPrefix
+	if params.ClusterUpdateParams.SecondaryServiceNetworkCidr != nil {
+		networks.SecondaryServiceNetworkCidr = *params.ClusterUpdateParams.SecondaryServiceNetworkCidr
+		updates["secondary_service_network_cidr"] = networks.SecondaryServiceNetworkCidr
+	}
+	if params.ClusterUpdateParams.NetworkType != nil {
+		networks.NetworkType = *params.ClusterUpdateParams.NetworkType
+		updates["network_type"] = networks.NetworkType
 	}
 	if params.ClusterUpdateParams.IngressVip != nil {
 		updates["ingress_vip"] = *params.ClusterUpdateParams.IngressVip
@@ -1015,15 +1500,90 @@ func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *com
 	if params.ClusterUpdateParams.SSHPublicKey != nil {
 		updates["ssh_public_key"] = *params.ClusterUpdateParams.SSHPublicKey
 	}
+	if params.ClusterUpdateParams.NtpServers != nil {
+		updates["ntp_servers"] = strings.Join(network.ParseNtpServers(*params.ClusterUpdateParams.NtpServers), ",")
+	}
+	if params.ClusterUpdateParams.HTTPProxy != nil {
+		updates["http_proxy"] = *params.ClusterUpdateParams.HTTPProxy
+	}
+	if params.ClusterUpdateParams.HTTPSProxy != nil {
+		updates["https_proxy"] = *params.ClusterUpdateParams.HTTPSProxy
+	}
+	if params.ClusterUpdateParams.NoProxy != nil {
+		updates["no_proxy"] = strings.Join(network.ParseNoProxy(*params.ClusterUpdateParams.NoProxy), ",")
+	}
 
-	var machineCidr string
+	if params.ClusterUpdateParams.AdditionalMachineNetworkCidrs != nil {
+		networks.AdditionalMachineNetworkCidrs = strings.Join(
+			network.ParseMachineNetworkCidrs(*params.ClusterUpdateParams.AdditionalMachineNetworkCidrs), ",")
+		updates["additional_machine_network_cidrs"] = networks.AdditionalMachineNetworkCidrs
+	}
 
-	machineCidr, err := network.CalculateMachineNetworkCIDR(apiVip, ingressVip, cluster.Hosts)
-	if err != nil {
-		log.WithError(err).Errorf("failed to calculate machine network cidr for cluster: %s", params.ClusterID)
+	if params.ClusterUpdateParams.VipAllocation != nil {
+		networks.VipAllocation = *params.ClusterUpdateParams.VipAllocation
+		updates["vip_allocation"] = networks.VipAllocation
+	}
+	if params.ClusterUpdateParams.VipExclusionRanges != nil {
+		networks.VipExclusionRanges = *params.ClusterUpdateParams.VipExclusionRanges
+		updates["vip_exclusion_ranges"] = networks.VipExclusionRanges
+	}
+	if err := verifyVipAllocationParams(networks.VipAllocation, networks.VipExclusionRanges,
+		params.ClusterUpdateParams.APIVip != nil || params.ClusterUpdateParams.IngressVip != nil); err != nil {
+		log.WithError(err).Errorf("VIP allocation verification failed for cluster: %s", params.ClusterID)
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
+
+	var (
+		machineCidr string
+		err         error
+	)
+	if networks.VipAllocation {
+		if machineCidr, apiVip, ingressVip, err = allocateClusterVips(cluster, &networks, params, db, log); err != nil {
+			return err
+		}
+		updates["api_vip"] = apiVip
+		updates["ingress_vip"] = ingressVip
+	} else {
+		if params.ClusterUpdateParams.MachineNetworkCidr != nil {
+			return common.NewApiError(http.StatusBadRequest,
+				errors.New("machine-network-cidr is calculated from the VIPs unless vip-allocation is set"))
+		}
+		machineCidr, err = network.CalculateMachineNetworkCIDR(apiVip, ingressVip, cluster.Hosts)
+		if err != nil {
+			log.WithError(err).Errorf("failed to calculate machine network cidr for cluster: %s", params.ClusterID)
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
 	updates["machine_network_cidr"] = machineCidr
+	networks.MachineNetworkCidr = machineCidr
+
+	var secondaryMachineCidr string
+	if network.IsDualStack(&networks) && machineCidr != "" {
+		secondaryMachineCidr, err = network.CalculateSecondaryMachineNetworkCIDR(machineCidr, cluster.Hosts)
+		if err != nil {
+			log.WithError(err).Errorf("failed to calculate secondary machine network cidr for cluster: %s", params.ClusterID)
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+	updates["secondary_machine_network_cidr"] = secondaryMachineCidr
+
+	if err = network.VerifyNetworkFamilies(&networks); err != nil {
+		log.WithError(err).Errorf("network verification failed for cluster: %s", params.ClusterID)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	networks.SecondaryMachineNetworkCidr = secondaryMachineCidr
+	// The networks of the hosts change after the update, overlaps with them are reported by the cluster validations
+	if networksUpdated(params.ClusterUpdateParams) {
+		if err = network.VerifyClusterNetworks(&networks, nil, log); err != nil {
+			log.WithError(err).Errorf("network verification failed for cluster: %s", params.ClusterID)
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
+	if err = network.VerifyNetworkType(&networks); err != nil {
+		log.WithError(err).Errorf("network type verification failed for cluster: %s", params.ClusterID)
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
 
 	err = network.VerifyVips(cluster.Hosts, machineCidr, apiVip, ingressVip, false, log)
 	if err != nil {
@@ -1050,37 +1610,133 @@ func (b *bareMetalInventory) updateClusterData(ctx context.Context, cluster *com
 	return nil
 }
 
-func (b *bareMetalInventory) updateHostsData(ctx context.Context, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
-	for i := range params.ClusterUpdateParams.HostsRoles {
-		log.Infof("Update host %s to role: %s", params.ClusterUpdateParams.HostsRoles[i].ID,
-			params.ClusterUpdateParams.HostsRoles[i].Role)
-		var host models.Host
-		err := db.First(&host, "id = ? and cluster_id = ?",
-			params.ClusterUpdateParams.HostsRoles[i].ID, params.ClusterID).Error
-		if err != nil {
-			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
-				params.ClusterUpdateParams.HostsRoles[i].ID, params.ClusterID)
-			return common.NewApiError(http.StatusNotFound, err)
+// validateProxySettings validates the format of the proxy settings of a cluster that are set
+func validateProxySettings(httpProxy, httpsProxy, noProxy *string) error {
+	if swag.StringValue(httpProxy) != "" {
+		if err := validations.ValidateHTTPProxyFormat(*httpProxy); err != nil {
+			return errors.Wrap(err, "invalid http-proxy")
 		}
-		err = b.hostApi.UpdateRole(ctx, &host, models.HostRole(params.ClusterUpdateParams.HostsRoles[i].Role), db)
-		if err != nil {
-			log.WithError(err).Errorf("failed to set role <%s> host <%s> in cluster <%s>",
-				params.ClusterUpdateParams.HostsRoles[i].Role, params.ClusterUpdateParams.HostsRoles[i].ID,
-				params.ClusterID)
-			return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if swag.StringValue(httpsProxy) != "" {
+		if err := validations.ValidateHTTPProxyFormat(*httpsProxy); err != nil {
+			return errors.Wrap(err, "invalid https-proxy")
 		}
 	}
-
-	for i := range params.ClusterUpdateParams.HostsNames {
-		log.Infof("Update host %s to request hostname %s", params.ClusterUpdateParams.HostsNames[i].ID,
-			params.ClusterUpdateParams.HostsNames[i].Hostname)
-		var host models.Host
-		err := db.First(&host, "id = ? and cluster_id = ?",
-			params.ClusterUpdateParams.HostsNames[i].ID, params.ClusterID).Error
-		if err != nil {
-			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
-				params.ClusterUpdateParams.HostsRoles[i].ID, params.ClusterID)
-			return common.NewApiError(http.StatusNotFound, err)
+	if noProxy != nil {
+		if err := validations.ValidateNoProxyFormat(*noProxy); err != nil {
+			return errors.Wrap(err, "invalid no-proxy")
+		}
+	}
+	return nil
+}
+
+// verifyVipAllocationParams verifies the exclusion ranges of the VIP allocation, and that the user does not set the
+// VIPs when they are allocated by the service
+func verifyVipAllocationParams(vipAllocation bool, exclusionRanges string, vipsSet bool) error {
+	if _, err := network.ParseIPRanges(exclusionRanges); err != nil {
+		return errors.Wrap(err, "invalid vip-exclusion-ranges")
+	}
+	if vipAllocation && vipsSet {
+		return errors.New("api-vip and ingress-vip cannot be set when vip-allocation is set")
+	}
+	return nil
+}
+
+// networksUpdated returns whether the update sets any of the machine, cluster or service networks of the cluster
+func networksUpdated(params *models.ClusterUpdateParams) bool {
+	return params.ClusterNetworkCidr != nil || params.ClusterNetworkHostPrefix != nil ||
+		params.ServiceNetworkCidr != nil || params.SecondaryClusterNetworkCidr != nil ||
+		params.SecondaryClusterNetworkHostPrefix != nil || params.SecondaryServiceNetworkCidr != nil ||
+		params.APIVip != nil || params.IngressVip != nil || params.MachineNetworkCidr != nil ||
+		params.AdditionalMachineNetworkCidrs != nil || params.VipAllocation != nil
+}
+
+// allocateClusterVips returns the machine network of a cluster whose VIPs are allocated by the service, and the VIPs
+// allocated in it. The machine network is set by the user, out of the networks of the hosts. VIPs that are already
+// allocated are kept unless the update changes the allocation parameters.
+func allocateClusterVips(c *common.Cluster, networks *models.Cluster, params installer.UpdateClusterParams,
+	db *gorm.DB, log logrus.FieldLogger) (string, string, string, error) {
+	if params.ClusterUpdateParams.VipAllocation == nil && params.ClusterUpdateParams.MachineNetworkCidr == nil &&
+		params.ClusterUpdateParams.VipExclusionRanges == nil && c.APIVip != "" && c.IngressVip != "" {
+		return c.MachineNetworkCidr, c.APIVip, c.IngressVip, nil
+	}
+	machineCidr := c.MachineNetworkCidr
+	if params.ClusterUpdateParams.MachineNetworkCidr != nil && *params.ClusterUpdateParams.MachineNetworkCidr != "" {
+		_, ipnet, err := net.ParseCIDR(*params.ClusterUpdateParams.MachineNetworkCidr)
+		if err != nil {
+			return "", "", "", common.NewApiError(http.StatusBadRequest, err)
+		}
+		if machineCidr = ipnet.String(); !network.IsHostNetwork(c.Hosts, machineCidr, log) {
+			return "", "", "", common.NewApiError(http.StatusBadRequest,
+				errors.Errorf("machine-network-cidr <%s> is not the network of any host", machineCidr))
+		}
+	} else if params.ClusterUpdateParams.MachineNetworkCidr != nil {
+		machineCidr = ""
+	}
+	if machineCidr == "" {
+		return "", "", "", nil
+	}
+
+	allocated := *c
+	allocated.MachineNetworkCidr = machineCidr
+	allocated.VipExclusionRanges = networks.VipExclusionRanges
+	if machineCidr != c.MachineNetworkCidr {
+		allocated.APIVip = ""
+		allocated.IngressVip = ""
+	}
+	apiVip, ingressVip, err := cluster.SelectVips(log, db, &allocated)
+	if err != nil {
+		log.WithError(err).Errorf("failed to allocate the VIPs of cluster %s", c.ID)
+		return "", "", "", common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return machineCidr, apiVip, ingressVip, nil
+}
+
+// verifyAllocatedVips verifies, before the installation, that the VIPs that were allocated to the cluster are still
+// free
+func verifyAllocatedVips(db *gorm.DB, c *common.Cluster, log logrus.FieldLogger) error {
+	reserved, err := cluster.GetReservedVips(db, c)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = network.VerifyAllocatedVips(c.Hosts, c.MachineNetworkCidr, c.VipExclusionRanges, reserved,
+		c.APIVip, c.IngressVip, log); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateHostsData(ctx context.Context, params installer.UpdateClusterParams, db *gorm.DB, log logrus.FieldLogger) error {
+	for i := range params.ClusterUpdateParams.HostsRoles {
+		log.Infof("Update host %s to role: %s", params.ClusterUpdateParams.HostsRoles[i].ID,
+			params.ClusterUpdateParams.HostsRoles[i].Role)
+		var host models.Host
+		err := db.First(&host, "id = ? and cluster_id = ?",
+			params.ClusterUpdateParams.HostsRoles[i].ID, params.ClusterID).Error
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.HostsRoles[i].ID, params.ClusterID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		err = b.hostApi.UpdateRole(ctx, &host, models.HostRole(params.ClusterUpdateParams.HostsRoles[i].Role), db)
+		if err != nil {
+			log.WithError(err).Errorf("failed to set role <%s> host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.HostsRoles[i].Role, params.ClusterUpdateParams.HostsRoles[i].ID,
+				params.ClusterID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	for i := range params.ClusterUpdateParams.HostsNames {
+		log.Infof("Update host %s to request hostname %s", params.ClusterUpdateParams.HostsNames[i].ID,
+			params.ClusterUpdateParams.HostsNames[i].Hostname)
+		var host models.Host
+		err := db.First(&host, "id = ? and cluster_id = ?",
+			params.ClusterUpdateParams.HostsNames[i].ID, params.ClusterID).Error
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.HostsRoles[i].ID, params.ClusterID)
+			return common.NewApiError(http.StatusNotFound, err)
 		}
 		err = b.hostApi.UpdateHostname(ctx, &host, params.ClusterUpdateParams.HostsNames[i].Hostname, db)
 		if err != nil {
@@ -1091,6 +1747,63 @@ func (b *bareMetalInventory) updateHostsData(ctx context.Context, params install
 		}
 	}
 
+	for i := range params.ClusterUpdateParams.HostsLabels {
+		log.Infof("Update host %s labels", params.ClusterUpdateParams.HostsLabels[i].ID)
+		var host models.Host
+		err := db.First(&host, "id = ? and cluster_id = ?",
+			params.ClusterUpdateParams.HostsLabels[i].ID, params.ClusterID).Error
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.HostsLabels[i].ID, params.ClusterID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		err = b.hostApi.UpdateLabels(ctx, &host, params.ClusterUpdateParams.HostsLabels[i].Labels, db)
+		if err != nil {
+			log.WithError(err).Errorf("failed to set labels of host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.HostsLabels[i].ID, params.ClusterID)
+			return err
+		}
+	}
+
+	for i := range params.ClusterUpdateParams.HostsNotes {
+		log.Infof("Update host %s notes", params.ClusterUpdateParams.HostsNotes[i].ID)
+		var host models.Host
+		err := db.First(&host, "id = ? and cluster_id = ?",
+			params.ClusterUpdateParams.HostsNotes[i].ID, params.ClusterID).Error
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.HostsNotes[i].ID, params.ClusterID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		err = b.hostApi.UpdateNotes(ctx, &host, params.ClusterUpdateParams.HostsNotes[i].Notes, db)
+		if err != nil {
+			log.WithError(err).Errorf("failed to set notes of host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.HostsNotes[i].ID, params.ClusterID)
+			return err
+		}
+	}
+
+	for i := range params.ClusterUpdateParams.HostsInstallationDisks {
+		log.Infof("Update host %s to installation disk %s", params.ClusterUpdateParams.HostsInstallationDisks[i].ID,
+			params.ClusterUpdateParams.HostsInstallationDisks[i].InstallationDiskID)
+		var host models.Host
+		err := db.First(&host, "id = ? and cluster_id = ?",
+			params.ClusterUpdateParams.HostsInstallationDisks[i].ID, params.ClusterID).Error
+		if err != nil {
+			log.WithError(err).Errorf("failed to find host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.HostsInstallationDisks[i].ID, params.ClusterID)
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		err = b.hostApi.UpdateInstallationDisk(ctx, &host,
+			params.ClusterUpdateParams.HostsInstallationDisks[i].InstallationDiskID, db)
+		if err != nil {
+			log.WithError(err).Errorf("failed to set installation disk <%s> host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.HostsInstallationDisks[i].InstallationDiskID,
+				params.ClusterUpdateParams.HostsInstallationDisks[i].ID, params.ClusterID)
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1121,10 +1834,14 @@ func calculateHostNetworks(log logrus.FieldLogger, cluster *common.Cluster) []*m
 			continue
 		}
 		for _, intf := range inventory.Interfaces {
-			for _, ipv4Address := range intf.IPV4Addresses {
-				_, ipnet, err := net.ParseCIDR(ipv4Address)
+			for _, address := range append(intf.IPV4Addresses, intf.IPV6Addresses...) {
+				ip, ipnet, err := net.ParseCIDR(address)
 				if err != nil {
-					log.WithError(err).Warnf("Could not parse CIDR %s", ipv4Address)
+					log.WithError(err).Warnf("Could not parse CIDR %s", address)
+					continue
+				}
+				// Every host has the same IPv6 link-local network on all its interfaces
+				if ip.To4() == nil && ip.IsLinkLocalUnicast() {
 					continue
 				}
 				cidr := ipnet.String()
@@ -1173,7 +1890,9 @@ func (b *bareMetalInventory) GetCluster(ctx context.Context, params installer.Ge
 		if err := b.customizeHost(host); err != nil {
 			return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
 		}
+		host.MachineNetworkCidr = network.GetHostMachineNetwork(log, &cluster, host)
 	}
+	cluster.AgentVersionDrift = b.getAgentVersionDrift(&cluster)
 
 	return installer.NewGetClusterOK().WithPayload(&cluster.Cluster)
 }
@@ -1246,8 +1965,7 @@ func (b *bareMetalInventory) DeregisterHost(ctx context.Context, params installe
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Deregister host: %s cluster %s", params.HostID, params.ClusterID)
 
-	if err := b.db.Where("id = ? and cluster_id = ?", params.HostID, params.ClusterID).
-		Delete(&models.Host{}).Error; err != nil {
+	if err := b.deleteHost(ctx, b.db, params.HostID, params.ClusterID); err != nil {
 		// TODO: check error type
 		return installer.NewDeregisterHostBadRequest().
 			WithPayload(common.GenerateError(http.StatusBadRequest, err))
@@ -1259,6 +1977,23 @@ func (b *bareMetalInventory) DeregisterHost(ctx context.Context, params installe
 	return installer.NewDeregisterHostNoContent()
 }
 
+// deleteHost deletes the host with its debug steps and step history
+func (b *bareMetalInventory) deleteHost(ctx context.Context, db *gorm.DB, hostID, clusterID strfmt.UUID) error {
+	log := logutil.FromContext(ctx, b.log)
+	if err := db.Where("id = ? and cluster_id = ?", hostID, clusterID).Delete(&models.Host{}).Error; err != nil {
+		return err
+	}
+	if err := db.Where("host_id = ? and cluster_id = ?", hostID.String(), clusterID.String()).
+		Delete(&models.DebugStepResult{}).Error; err != nil {
+		log.WithError(err).Warnf("failed to delete debug steps of host %s in cluster %s", hostID, clusterID)
+	}
+	if err := db.Where("host_id = ? and cluster_id = ?", hostID.String(), clusterID.String()).
+		Delete(&models.HostStep{}).Error; err != nil {
+		log.WithError(err).Warnf("failed to delete step history of host %s in cluster %s", hostID, clusterID)
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) GetHost(ctx context.Context, params installer.GetHostParams) middleware.Responder {
 	var host models.Host
 	// TODO: validate what is the error
@@ -1320,8 +2055,17 @@ func (b *bareMetalInventory) GetNextSteps(ctx context.Context, params installer.
 			WithPayload(common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to start transaction")))
 	}
 
+	readAt := time.Now()
 	//TODO check the error type
 	if err := tx.First(&host, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
+		// The host may have been moved to another cluster, in which case the agent is told to use the new cluster
+		var movedHost models.Host
+		if tx.First(&movedHost, "id = ? and previous_cluster_id = ?", params.HostID, params.ClusterID).Error == nil {
+			log.Infof("host %s was moved from cluster %s to cluster %s", params.HostID, params.ClusterID, movedHost.ClusterID)
+			tx.Rollback()
+			txSuccess = true
+			return movedHostSteps(movedHost.ClusterID)
+		}
 		log.WithError(err).Errorf("failed to find host: %s", params.HostID)
 		return installer.NewGetNextStepsNotFound().
 			WithPayload(common.GenerateError(http.StatusNotFound, err))
@@ -1339,27 +2083,185 @@ func (b *bareMetalInventory) GetNextSteps(ctx context.Context, params installer.
 	}
 	txSuccess = true
 
+	// Agents that do not wait get the steps right away, as before
+	wait := time.Duration(swag.Int64Value(params.WaitSeconds)) * time.Second
+	if wait > 0 {
+		var due bool
+		var err error
+		readAt, due, err = b.waitForNextSteps(ctx, &host, readAt, wait)
+		if err != nil {
+			log.WithError(err).Errorf("failed to wait for the steps of host %s cluster %s", params.HostID, params.ClusterID)
+			if gorm.IsRecordNotFoundError(err) {
+				return installer.NewGetNextStepsNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+			}
+			return installer.NewGetNextStepsInternalServerError().
+				WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+		}
+		if host.ClusterID != params.ClusterID {
+			log.Infof("host %s was moved from cluster %s to cluster %s", params.HostID, params.ClusterID, host.ClusterID)
+			return movedHostSteps(host.ClusterID)
+		}
+		if !due {
+			return installer.NewGetNextStepsOK().WithPayload(&models.Steps{
+				ClusterID:    host.ClusterID,
+				Instructions: []*models.Step{},
+			})
+		}
+	}
+
 	var err error
 	steps, err = b.hostApi.GetNextSteps(ctx, &host)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get steps for host %s cluster %s", params.HostID, params.ClusterID)
+	} else if wait > 0 {
+		// Only the held requests look at the steps time, agents that do not wait are not charged with another write
+		if err = b.updateStepsIssuedAt(&host, readAt, steps.NextInstructionSeconds); err != nil {
+			log.WithError(err).Errorf("failed to update the steps time of host %s cluster %s", params.HostID, params.ClusterID)
+		}
+	}
+	steps.ClusterID = host.ClusterID
+	// A waiting agent asks again right away, the next request is held until there is something to do
+	if wait > 0 {
+		steps.NextInstructionSeconds = 0
 	}
 
-	b.debugCmdMux.Lock()
-	if cmd, ok := b.debugCmdMap[params.HostID]; ok {
-		step := &models.Step{}
-		step.StepType = models.StepTypeExecute
-		step.StepID = cmd.stepID
-		step.Command = "bash"
-		step.Args = []string{"-c", cmd.cmd}
+	step, err := b.popDebugStep(&host)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get debug step for host %s cluster %s", params.HostID, params.ClusterID)
+	} else if step != nil {
 		steps.Instructions = append(steps.Instructions, step)
-		delete(b.debugCmdMap, params.HostID)
 	}
-	b.debugCmdMux.Unlock()
 
+	b.recordSteps(ctx, &host, steps.Instructions)
 	return installer.NewGetNextStepsOK().WithPayload(&steps)
 }
 
+// movedHostSteps tells the agent of a host that was moved to another cluster to use the new cluster
+func movedHostSteps(clusterID strfmt.UUID) middleware.Responder {
+	return installer.NewGetNextStepsOK().WithPayload(&models.Steps{
+		ClusterID:              clusterID,
+		NextInstructionSeconds: movedHostNextInstructionSeconds,
+		Instructions:           []*models.Step{},
+	})
+}
+
+// stepsNotifier wakes the held next steps requests of the hosts of a cluster when something that may change their
+// steps happens in this replica
+type stepsNotifier struct {
+	lock    sync.Mutex
+	waiters map[strfmt.UUID]map[chan struct{}]bool
+}
+
+func newStepsNotifier() *stepsNotifier {
+	return &stepsNotifier{waiters: make(map[strfmt.UUID]map[chan struct{}]bool)}
+}
+
+// subscribe returns a channel that is signaled on every notification of the cluster, and a function that cancels the
+// subscription
+func (n *stepsNotifier) subscribe(clusterID strfmt.UUID) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.waiters[clusterID] == nil {
+		n.waiters[clusterID] = make(map[chan struct{}]bool)
+	}
+	n.waiters[clusterID][ch] = true
+	return ch, func() {
+		n.lock.Lock()
+		defer n.lock.Unlock()
+		delete(n.waiters[clusterID], ch)
+		if len(n.waiters[clusterID]) == 0 {
+			delete(n.waiters, clusterID)
+		}
+	}
+}
+
+func (n *stepsNotifier) notify(clusterID strfmt.UUID) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for ch := range n.waiters[clusterID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// nextStepsDue returns whether there is something to send to the agent of the host: its periodic steps are due again,
+// the host changed its status or cluster since they were sent, or a debug step is queued for it
+func (b *bareMetalInventory) nextStepsDue(host *models.Host, clusterID strfmt.UUID) (bool, error) {
+	issuedAt := time.Time(host.StepsIssuedAt)
+	if issuedAt.IsZero() || !time.Now().Before(time.Time(host.NextStepsAt)) ||
+		time.Time(host.StatusUpdatedAt).After(issuedAt) || host.ClusterID != clusterID {
+		return true, nil
+	}
+	var count int
+	if err := b.db.Model(&models.DebugStepResult{}).Where("host_id = ? and cluster_id = ? and status = ?",
+		host.ID.String(), host.ClusterID.String(), models.DebugStepResultStatusQueued).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// waitForNextSteps holds a next steps request until there is something to send to the agent of the host or the wait
+// passed. The host is read again on every notification of its cluster and every long poll interval, the time of the
+// last read is returned with whether the steps are due.
+func (b *bareMetalInventory) waitForNextSteps(ctx context.Context, host *models.Host, readAt time.Time,
+	wait time.Duration) (time.Time, bool, error) {
+	clusterID := host.ClusterID
+	wake, unsubscribe := b.stepsNotifier.subscribe(clusterID)
+	defer unsubscribe()
+	deadline := time.Now().Add(wait)
+	for {
+		due, err := b.nextStepsDue(host, clusterID)
+		if err != nil || due {
+			return readAt, due, err
+		}
+		sleep := time.Until(deadline)
+		if sleep <= 0 {
+			return readAt, false, nil
+		}
+		if untilDue := time.Until(time.Time(host.NextStepsAt)); untilDue < sleep {
+			sleep = untilDue
+		}
+		if b.StepsLongPollInterval > 0 && b.StepsLongPollInterval < sleep {
+			sleep = b.StepsLongPollInterval
+		}
+		timer := time.NewTimer(sleep)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return readAt, false, nil
+		case <-wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+		// The host may have been moved to another cluster in the meantime, in which case it is found by its previous
+		// cluster and the steps are due so the agent is told about the new cluster
+		var h models.Host
+		readAt = time.Now()
+		err = b.db.First(&h, "id = ? and cluster_id = ?", host.ID.String(), clusterID.String()).Error
+		if gorm.IsRecordNotFoundError(err) {
+			err = b.db.First(&h, "id = ? and previous_cluster_id = ?", host.ID.String(), clusterID.String()).Error
+		}
+		if err != nil {
+			return readAt, false, err
+		}
+		*host = h
+	}
+}
+
+// updateStepsIssuedAt records when the periodic steps of the host were sent and when they are due again
+func (b *bareMetalInventory) updateStepsIssuedAt(host *models.Host, issuedAt time.Time, nextInstructionSeconds int64) error {
+	host.StepsIssuedAt = strfmt.DateTime(issuedAt)
+	host.NextStepsAt = strfmt.DateTime(issuedAt.Add(time.Duration(nextInstructionSeconds) * time.Second))
+	return b.db.Model(&models.Host{}).Where("id = ? and cluster_id = ?", host.ID.String(), host.ClusterID.String()).
+		Updates(map[string]interface{}{
+			"steps_issued_at": host.StepsIssuedAt,
+			"next_steps_at":   host.NextStepsAt,
+		}).Error
+}
+
 func (b *bareMetalInventory) PostStepReply(ctx context.Context, params installer.PostStepReplyParams) middleware.Responder {
 	var err error
 	log := logutil.FromContext(ctx, b.log)
@@ -1374,6 +2276,15 @@ func (b *bareMetalInventory) PostStepReply(ctx context.Context, params installer
 			WithPayload(common.GenerateError(http.StatusNotFound, err))
 	}
 
+	b.recordStepReply(ctx, &host, params.Reply)
+
+	if err = b.updateDebugStepReply(&host, params.Reply); err != nil {
+		log.WithError(err).Errorf("Failed to store reply of debug step <%s> for host <%s> cluster <%s>",
+			params.Reply.StepID, params.HostID, params.ClusterID)
+		return installer.NewPostStepReplyInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
 	//check the output exit code
 	if params.Reply.ExitCode != 0 {
 		err = fmt.Errorf(msg)
@@ -1393,6 +2304,9 @@ func (b *bareMetalInventory) PostStepReply(ctx context.Context, params installer
 	if err != nil {
 		log.WithError(err).Errorf("Failed decode <%s> reply for host <%s> cluster <%s>",
 			params.Reply.StepID, params.HostID, params.ClusterID)
+		if params.Reply.StepType == models.StepTypeDiskSpeedCheck {
+			_ = b.updateDiskSpeed(ctx, &host, params.Reply.StepID, "", invalidReplyExitCode)
+		}
 		return installer.NewPostStepReplyBadRequest().
 			WithPayload(common.GenerateError(http.StatusBadRequest, err))
 	}
@@ -1408,12 +2322,142 @@ func (b *bareMetalInventory) PostStepReply(ctx context.Context, params installer
 	return installer.NewPostStepReplyNoContent()
 }
 
+// Failing to record the step history does not fail the communication with the agent
+func (b *bareMetalInventory) recordSteps(ctx context.Context, h *models.Host, steps []*models.Step) {
+	if err := host.RecordSteps(b.db, h, steps); err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Warnf("failed to record steps of host %s", h.ID)
+	}
+}
+
+func (b *bareMetalInventory) recordStepReply(ctx context.Context, h *models.Host, reply *models.StepReply) {
+	if err := host.RecordStepReply(b.db, h, reply); err != nil {
+		logutil.FromContext(ctx, b.log).WithError(err).Warnf("failed to record reply of step %s of host %s", reply.StepID, h.ID)
+	}
+}
+
+func (b *bareMetalInventory) ListHostSteps(ctx context.Context, params installer.ListHostStepsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var h models.Host
+	if err := b.db.First(&h, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewListHostStepsNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+	}
+
+	steps := models.HostStepList{}
+	// The history of a host moves with it to another cluster, the history of the same host ID in other clusters is
+	// not shown
+	if err := b.db.Order("issued_at").Find(&steps, "host_id = ? and cluster_id = ?", params.HostID.String(),
+		params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get steps of host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewListHostStepsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	return installer.NewListHostStepsOK().WithPayload(steps)
+}
+
+func hostLogsFileName(clusterID, hostID strfmt.UUID) string {
+	return fmt.Sprintf("%s/logs/%s.tar.gz", clusterID, hostID)
+}
+
+func (b *bareMetalInventory) UploadHostLogs(ctx context.Context, params installer.UploadHostLogsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	defer params.Upfile.Close()
+	var h models.Host
+	if err := b.db.First(&h, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewUploadHostLogsNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(params.Upfile, b.MaxHostLogsSize+1))
+	if err != nil {
+		log.WithError(err).Errorf("failed to read logs of host %s", params.HostID)
+		return installer.NewUploadHostLogsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	if int64(len(data)) > b.MaxHostLogsSize {
+		err = errors.Errorf("logs of host %s are larger than %d bytes", params.HostID, b.MaxHostLogsSize)
+		log.WithError(err).Errorf("failed to upload logs of host %s", params.HostID)
+		return installer.NewUploadHostLogsRequestEntityTooLarge().
+			WithPayload(common.GenerateError(http.StatusRequestEntityTooLarge, err))
+	}
+	if err = b.s3Client.PushDataToS3(ctx, data, hostLogsFileName(params.ClusterID, params.HostID), b.S3Bucket); err != nil {
+		log.WithError(err).Errorf("failed to upload logs of host %s to s3", params.HostID)
+		return installer.NewUploadHostLogsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	if err = b.db.Model(&h).Update("logs_collected_at", strfmt.DateTime(time.Now())).Error; err != nil {
+		log.WithError(err).Errorf("failed to update logs collection time of host %s", params.HostID)
+		return installer.NewUploadHostLogsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	b.eventsHandler.AddEvent(ctx, params.HostID.String(), models.EventSeverityInfo,
+		fmt.Sprintf("Host %s: uploaded logs", common.GetHostnameForMsg(&h)), time.Now(), params.ClusterID.String())
+	return installer.NewUploadHostLogsNoContent()
+}
+
+func (b *bareMetalInventory) GetHostPullSecret(ctx context.Context, params installer.GetHostPullSecretParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var h models.Host
+	if err := b.db.First(&h, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewGetHostPullSecretNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+	}
+	var cluster common.Cluster
+	if err := b.db.Select("id, pull_secret").First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		return installer.NewGetHostPullSecretInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+
+	// The agent proves that it was started from an image of the cluster with the token it got in the ignition
+	creds, err := validations.ParsePullSecret(cluster.PullSecret)
+	if err != nil {
+		log.WithError(err).Errorf("failed to parse the pull secret of cluster %s", params.ClusterID)
+		return installer.NewGetHostPullSecretInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	r, ok := creds["cloud.openshift.com"]
+	if !ok || subtle.ConstantTimeCompare([]byte(r.AuthRaw), []byte(params.XSecretKey)) != 1 {
+		err = fmt.Errorf("the key of host %s does not match the pull secret of cluster %s", params.HostID, params.ClusterID)
+		log.WithError(err).Warn("refusing to send the pull secret")
+		return installer.NewGetHostPullSecretUnauthorized().WithPayload(common.GenerateError(http.StatusUnauthorized, err))
+	}
+	return installer.NewGetHostPullSecretOK().WithPayload(json.RawMessage(cluster.PullSecret))
+}
+
+func (b *bareMetalInventory) DownloadHostLogs(ctx context.Context, params installer.DownloadHostLogsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var h models.Host
+	if err := b.db.First(&h, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewDownloadHostLogsNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+	}
+	if time.Time(h.LogsCollectedAt).IsZero() {
+		err := fmt.Errorf("logs of host %s were not collected", params.HostID)
+		log.WithError(err).Errorf("failed to download logs of host %s", params.HostID)
+		return installer.NewDownloadHostLogsNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+	}
+
+	respBody, contentLength, err := b.s3Client.DownloadFileFromS3(ctx, hostLogsFileName(params.ClusterID, params.HostID), b.S3Bucket)
+	if err != nil {
+		log.WithError(err).Errorf("failed to download logs of host %s from s3", params.HostID)
+		return installer.NewDownloadHostLogsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	return filemiddleware.NewResponder(installer.NewDownloadHostLogsOK().WithPayload(respBody),
+		fmt.Sprintf("logs_%s.tar.gz", params.HostID), contentLength)
+}
+
 func handleReplyError(params installer.PostStepReplyParams, b *bareMetalInventory, ctx context.Context, h *models.Host) error {
 
 	if params.Reply.StepType == models.StepTypeInstall {
 		//if it's install step - need to move host to error
 		return b.hostApi.HandleInstallationFailure(ctx, h)
 	}
+	if params.Reply.StepType == models.StepTypeDiskSpeedCheck {
+		// A failed check is recorded as well, so that it is repeated only a few times
+		return b.updateDiskSpeed(ctx, h, params.Reply.StepID, params.Reply.Output, params.Reply.ExitCode)
+	}
 	return nil
 }
 
@@ -1442,19 +2486,237 @@ func (b *bareMetalInventory) updateFreeAddressesReport(ctx context.Context, host
 	return nil
 }
 
+func (b *bareMetalInventory) updateImagesStatus(ctx context.Context, host *models.Host, imagesReport string) error {
+	log := logutil.FromContext(ctx, b.log)
+	var response models.ContainerImageAvailabilityResponse
+	if err := json.Unmarshal([]byte(imagesReport), &response); err != nil {
+		log.WithError(err).Warnf("Json unmarshal images status of host %s", host.ID.String())
+		return err
+	}
+	imagesStatus, err := json.Marshal(response.Images)
+	if err != nil {
+		log.WithError(err).Warnf("Json marshal images status of host %s", host.ID.String())
+		return err
+	}
+	if err = b.db.Model(&models.Host{}).Where("id = ? and cluster_id = ?", host.ID.String(),
+		host.ClusterID.String()).Update("images_status", string(imagesStatus)).Error; err != nil {
+		log.WithError(err).Warnf("Update images status of host %s", host.ID.String())
+		return err
+	}
+	return nil
+}
+
+// updateDiskSpeed records the disk speed check reply of the host. A reply without the measurement, e.g. of a check
+// that failed or timed out, is recorded as a failure of the disk that the step checks.
+func (b *bareMetalInventory) updateDiskSpeed(ctx context.Context, h *models.Host, stepID string, diskSpeedReport string,
+	exitCode int64) error {
+	log := logutil.FromContext(ctx, b.log)
+	var response models.DiskSpeedCheckResponse
+	if err := json.Unmarshal([]byte(diskSpeedReport), &response); err != nil || response.Path == "" {
+		log.WithError(err).Warnf("Disk speed report of host %s is missing the disk path", h.ID.String())
+		path, pathErr := host.DiskSpeedCheckPath(b.db, h, stepID)
+		if pathErr != nil {
+			log.WithError(pathErr).Warnf("Failed to find the disk checked by step %s of host %s", stepID, h.ID.String())
+			return pathErr
+		}
+		response = models.DiskSpeedCheckResponse{Path: path}
+		if exitCode == 0 {
+			exitCode = invalidReplyExitCode
+		}
+	}
+	disksSpeed, err := host.MergeDiskSpeed(h.DisksSpeed, &models.DiskSpeed{
+		Path:           response.Path,
+		IoSyncDuration: response.IoSyncDuration,
+		ExitCode:       exitCode,
+	})
+	if err != nil {
+		log.WithError(err).Warnf("Merge disk speed of host %s", h.ID.String())
+		return err
+	}
+	if err = b.db.Model(&models.Host{}).Where("id = ? and cluster_id = ?", h.ID.String(),
+		h.ClusterID.String()).Update("disks_speed", disksSpeed).Error; err != nil {
+		log.WithError(err).Warnf("Update disk speed of host %s", h.ID.String())
+		return err
+	}
+	return nil
+}
+
+// The host clock is read at some point between sending the step, which is the reference time of the report, and
+// receiving its reply. The earlier steps of the same batch and the network delays in both directions are part of that
+// window, so only the part of the host time that falls outside the window is counted as the offset of the host clock.
+func (b *bareMetalInventory) updateTimeSync(ctx context.Context, h *models.Host, timeSyncReport string) error {
+	now := time.Now()
+	log := logutil.FromContext(ctx, b.log)
+	var response models.TimeSyncResponse
+	if err := json.Unmarshal([]byte(timeSyncReport), &response); err != nil {
+		log.WithError(err).Warnf("Json unmarshal time sync of host %s", h.ID.String())
+		return err
+	}
+	if response.Time <= 0 || response.ReferenceTime <= 0 {
+		err := fmt.Errorf("Time sync report of host %s is missing the host time or the reference time", h.ID.String())
+		log.WithError(err).Warn("Update time sync")
+		return err
+	}
+	hostTime := time.Unix(0, int64(response.Time*float64(time.Second)))
+	referenceTime := time.Unix(0, int64(response.ReferenceTime*float64(time.Second)))
+	var offset time.Duration
+	switch {
+	case hostTime.Before(referenceTime):
+		offset = hostTime.Sub(referenceTime)
+	case hostTime.After(now):
+		offset = hostTime.Sub(now)
+	}
+	timeSync, err := json.Marshal(&models.HostTimeSync{
+		ClockOffsetMs: offset.Milliseconds(),
+		SourceOffset:  response.Offset,
+		Sources:       response.Sources,
+		UpdatedAt:     strfmt.DateTime(now),
+	})
+	if err != nil {
+		log.WithError(err).Warnf("Json marshal time sync of host %s", h.ID.String())
+		return err
+	}
+	if err = b.db.Model(&models.Host{}).Where("id = ? and cluster_id = ?", h.ID.String(),
+		h.ClusterID.String()).Update("time_sync", string(timeSync)).Error; err != nil {
+		log.WithError(err).Warnf("Update time sync of host %s", h.ID.String())
+		return err
+	}
+	return nil
+}
+
 func handleReplyByType(params installer.PostStepReplyParams, b *bareMetalInventory, ctx context.Context, host models.Host, stepReply string) error {
 	var err error
 	switch params.Reply.StepType {
 	case models.StepTypeInventory:
-		err = b.hostApi.UpdateInventory(ctx, &host, stepReply)
+		if err = b.hostApi.UpdateInventory(ctx, &host, stepReply); err == nil {
+			if dupErr := b.handleDuplicateHost(ctx, &host, stepReply); dupErr != nil {
+				logutil.FromContext(ctx, b.log).WithError(dupErr).Warnf("failed to look for a duplicate of host %s", host.ID)
+			}
+		}
 	case models.StepTypeConnectivityCheck:
 		err = b.hostApi.UpdateConnectivityReport(ctx, &host, stepReply)
 	case models.StepTypeFreeNetworkAddresses:
 		err = b.updateFreeAddressesReport(ctx, &host, stepReply)
+	case models.StepTypeContainerImageAvailability:
+		err = b.updateImagesStatus(ctx, &host, stepReply)
+	case models.StepTypeDiskSpeedCheck:
+		err = b.updateDiskSpeed(ctx, &host, params.Reply.StepID, stepReply, 0)
+	case models.StepTypeTimeSync:
+		err = b.updateTimeSync(ctx, &host, stepReply)
 	}
 	return err
 }
 
+// handleDuplicateHost looks for an older host of the cluster that was registered by the same machine, as happens when
+// the machine boots with a new machine id or a fresh discovery image. According to the duplicate host policy, a stale
+// older host is merged into the new one or replaced by it, otherwise the new host is flagged as its duplicate.
+func (b *bareMetalInventory) handleDuplicateHost(ctx context.Context, h *models.Host, inventory string) error {
+	log := logutil.FromContext(ctx, b.log)
+	fingerprint, err := host.NewFingerprint(inventory)
+	if err != nil {
+		return err
+	}
+	var duplicate *models.Host
+	if fingerprint.CanMatch() {
+		var olderHosts []*models.Host
+		if err = b.db.Where("cluster_id = ? and id <> ? and created_at < ?", h.ClusterID.String(), h.ID.String(), h.CreatedAt).
+			Order("created_at desc").Find(&olderHosts).Error; err != nil {
+			return err
+		}
+		for _, older := range olderHosts {
+			if older.Inventory == "" {
+				continue
+			}
+			olderFingerprint, fpErr := host.NewFingerprint(older.Inventory)
+			if fpErr != nil {
+				log.WithError(fpErr).Warnf("failed to get the fingerprint of host %s", older.ID)
+				continue
+			}
+			if fingerprint.Matches(olderFingerprint) {
+				duplicate = older
+				break
+			}
+		}
+	}
+	if duplicate == nil {
+		if h.DuplicateOf != "" {
+			h.DuplicateOf = ""
+			return b.db.Model(h).Update("duplicate_of", h.DuplicateOf).Error
+		}
+		return nil
+	}
+
+	policy := b.DuplicateHostPolicy
+	// A host that is still in use is never removed, its agent checked in after the new registration
+	if !funk.ContainsString(staleHostStatuses, swag.StringValue(duplicate.Status)) ||
+		!time.Time(duplicate.CheckedInAt).Before(time.Time(h.CreatedAt)) {
+		policy = DuplicateHostPolicyFlag
+	}
+	switch policy {
+	case DuplicateHostPolicyMerge, DuplicateHostPolicyReplace:
+		h.DuplicateOf = ""
+		err = b.db.Transaction(func(tx *gorm.DB) error {
+			updates := map[string]interface{}{"duplicate_of": h.DuplicateOf}
+			if policy == DuplicateHostPolicyMerge {
+				mergeHostUserProperties(h, duplicate, updates)
+			}
+			if err := tx.Model(h).Updates(updates).Error; err != nil {
+				return err
+			}
+			return b.deleteHost(ctx, tx, *duplicate.ID, duplicate.ClusterID)
+		})
+		if err != nil {
+			return err
+		}
+		action := "removed"
+		if policy == DuplicateHostPolicyMerge {
+			action = "merged into it"
+		}
+		b.eventsHandler.AddEvent(ctx, h.ID.String(), models.EventSeverityInfo,
+			fmt.Sprintf("Host %s: registered again by the machine of host %s, which was %s",
+				common.GetHostnameForMsg(h), common.GetHostnameForMsg(duplicate), action),
+			time.Now(), h.ClusterID.String())
+	default:
+		if h.DuplicateOf == *duplicate.ID {
+			return nil
+		}
+		h.DuplicateOf = *duplicate.ID
+		if err = b.db.Model(h).Update("duplicate_of", h.DuplicateOf).Error; err != nil {
+			return err
+		}
+		b.eventsHandler.AddEvent(ctx, h.ID.String(), models.EventSeverityWarning,
+			fmt.Sprintf("Host %s: registered by the same machine as host %s (%s)",
+				common.GetHostnameForMsg(h), common.GetHostnameForMsg(duplicate), duplicate.ID),
+			time.Now(), h.ClusterID.String())
+	}
+	return nil
+}
+
+// mergeHostUserProperties takes the properties that the user set on the stale host of a machine that the new host
+// of the machine does not have yet
+func mergeHostUserProperties(h *models.Host, stale *models.Host, updates map[string]interface{}) {
+	if h.Role == "" && stale.Role != "" {
+		h.Role = stale.Role
+		updates["role"] = h.Role
+	}
+	if h.RequestedHostname == "" && stale.RequestedHostname != "" {
+		h.RequestedHostname = stale.RequestedHostname
+		updates["requested_hostname"] = h.RequestedHostname
+	}
+	if h.InstallationDiskID == "" && stale.InstallationDiskID != "" {
+		h.InstallationDiskID = stale.InstallationDiskID
+		updates["installation_disk_id"] = h.InstallationDiskID
+	}
+	if h.Labels == "" && stale.Labels != "" {
+		h.Labels = stale.Labels
+		updates["labels"] = h.Labels
+	}
+	if h.Notes == "" && stale.Notes != "" {
+		h.Notes = stale.Notes
+		updates["notes"] = h.Notes
+	}
+}
+
 func filterReplyByType(params installer.PostStepReplyParams) (string, error) {
 	var stepReply string
 	var err error
@@ -1467,6 +2729,12 @@ func filterReplyByType(params installer.PostStepReplyParams) (string, error) {
 		stepReply, err = filterReply(&models.ConnectivityReport{}, params.Reply.Output)
 	case models.StepTypeFreeNetworkAddresses:
 		stepReply, err = filterReply(&models.FreeNetworksAddresses{}, params.Reply.Output)
+	case models.StepTypeContainerImageAvailability:
+		stepReply, err = filterReply(&models.ContainerImageAvailabilityResponse{}, params.Reply.Output)
+	case models.StepTypeDiskSpeedCheck:
+		stepReply, err = filterReply(&models.DiskSpeedCheckResponse{}, params.Reply.Output)
+	case models.StepTypeTimeSync:
+		stepReply, err = filterReply(&models.TimeSyncResponse{}, params.Reply.Output)
 	}
 	return stepReply, err
 }
@@ -1485,19 +2753,126 @@ func filterReply(expected interface{}, input string) (string, error) {
 
 func (b *bareMetalInventory) SetDebugStep(ctx context.Context, params installer.SetDebugStepParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	stepID := createStepID(models.StepTypeExecute)
-	b.debugCmdMux.Lock()
-	b.debugCmdMap[params.HostID] = debugCmd{
-		cmd:    swag.StringValue(params.Step.Command),
-		stepID: stepID,
+	var host models.Host
+	if err := b.db.First(&host, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewSetDebugStepNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+	}
+
+	timeout := params.Step.Timeout
+	if timeout == 0 {
+		timeout = defaultDebugStepTimeout
+	}
+	debugStep := models.DebugStepResult{
+		StepID:    swag.String(createStepID(models.StepTypeExecute)),
+		HostID:    host.ID,
+		ClusterID: &host.ClusterID,
+		Command:   params.Step.Command,
+		Timeout:   timeout,
+		Status:    swag.String(models.DebugStepResultStatusQueued),
+		CreatedAt: strfmt.DateTime(time.Now()),
+	}
+	if err := b.db.Create(&debugStep).Error; err != nil {
+		log.WithError(err).Errorf("failed to queue debug step for host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewSetDebugStepInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
 	}
-	b.debugCmdMux.Unlock()
 	log.Infof("Added new debug command <%s> for cluster <%s> host <%s>: <%s>",
-		stepID, params.ClusterID, params.HostID, swag.StringValue(params.Step.Command))
+		*debugStep.StepID, params.ClusterID, params.HostID, swag.StringValue(params.Step.Command))
 	b.eventsHandler.AddEvent(ctx, params.ClusterID.String(), models.EventSeverityInfo, "Added debug command", time.Now(), params.HostID.String())
+	b.stepsNotifier.notify(params.ClusterID)
 	return installer.NewSetDebugStepNoContent()
 }
 
+// popDebugStep moves the oldest queued debug step of the host to running and returns it as a step for the agent.
+// The command is wrapped with timeout so the agent stops it once its timeout passes.
+func (b *bareMetalInventory) popDebugStep(host *models.Host) (*models.Step, error) {
+	var debugStep models.DebugStepResult
+	err := b.db.Where("host_id = ? and cluster_id = ? and status = ?", host.ID.String(), host.ClusterID.String(),
+		models.DebugStepResultStatusQueued).Order("created_at").First(&debugStep).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the replica that takes the step out of the queue sends it to the agent
+	reply := b.db.Model(&models.DebugStepResult{}).
+		Where("step_id = ? and status = ?", *debugStep.StepID, models.DebugStepResultStatusQueued).
+		Updates(map[string]interface{}{
+			"status":     models.DebugStepResultStatusRunning,
+			"started_at": strfmt.DateTime(time.Now()),
+		})
+	if reply.Error != nil {
+		return nil, reply.Error
+	}
+	if reply.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &models.Step{
+		StepType: models.StepTypeExecute,
+		StepID:   *debugStep.StepID,
+		Command:  "timeout",
+		Args:     []string{strconv.FormatInt(debugStep.Timeout, 10), "bash", "-c", swag.StringValue(debugStep.Command)},
+	}, nil
+}
+
+func (b *bareMetalInventory) updateDebugStepReply(host *models.Host, reply *models.StepReply) error {
+	status := models.DebugStepResultStatusCompleted
+	switch reply.ExitCode {
+	case 0:
+	case debugStepTimeoutExitCode:
+		status = models.DebugStepResultStatusTimedOut
+	default:
+		status = models.DebugStepResultStatusFailed
+	}
+	// A reply that arrives after the step was considered lost still holds the real result
+	return b.db.Model(&models.DebugStepResult{}).
+		Where("step_id = ? and host_id = ? and cluster_id = ? and status in (?)", reply.StepID, host.ID.String(),
+			host.ClusterID.String(), []string{models.DebugStepResultStatusRunning, models.DebugStepResultStatusTimedOut}).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"exit_code":    reply.ExitCode,
+			"stdout":       reply.Output,
+			"stderr":       reply.Error,
+			"completed_at": strfmt.DateTime(time.Now()),
+		}).Error
+}
+
+func (b *bareMetalInventory) ListDebugSteps(ctx context.Context, params installer.ListDebugStepsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var host models.Host
+	if err := b.db.First(&host, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to find host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewListDebugStepsNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+	}
+
+	debugSteps := models.DebugStepResultList{}
+	if err := b.db.Order("created_at").Find(&debugSteps, "host_id = ? and cluster_id = ?",
+		params.HostID.String(), params.ClusterID.String()).Error; err != nil {
+		log.WithError(err).Errorf("failed to get debug steps of host %s in cluster %s", params.HostID, params.ClusterID)
+		return installer.NewListDebugStepsInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	return installer.NewListDebugStepsOK().WithPayload(debugSteps)
+}
+
+func (b *bareMetalInventory) GetDebugStep(ctx context.Context, params installer.GetDebugStepParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var debugStep models.DebugStepResult
+	if err := b.db.First(&debugStep, "step_id = ? and host_id = ? and cluster_id = ?", params.StepID,
+		params.HostID.String(), params.ClusterID.String()).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewGetDebugStepNotFound().WithPayload(common.GenerateError(http.StatusNotFound, err))
+		}
+		log.WithError(err).Errorf("failed to get debug step %s of host %s in cluster %s", params.StepID, params.HostID, params.ClusterID)
+		return installer.NewGetDebugStepInternalServerError().
+			WithPayload(common.GenerateError(http.StatusInternalServerError, err))
+	}
+	return installer.NewGetDebugStepOK().WithPayload(&debugStep)
+}
+
 func (b *bareMetalInventory) DisableHost(ctx context.Context, params installer.DisableHostParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	var host models.Host
@@ -1529,6 +2904,7 @@ func (b *bareMetalInventory) DisableHost(ctx context.Context, params installer.D
 
 	msg := "Host disabled by user"
 	b.eventsHandler.AddEvent(ctx, params.HostID.String(), models.EventSeverityInfo, msg, time.Now(), params.ClusterID.String())
+	b.stepsNotifier.notify(params.ClusterID)
 	return installer.NewDisableHostOK().WithPayload(&host)
 }
 
@@ -1563,9 +2939,111 @@ func (b *bareMetalInventory) EnableHost(ctx context.Context, params installer.En
 
 	msg := "Host enabled by user"
 	b.eventsHandler.AddEvent(ctx, params.HostID.String(), models.EventSeverityInfo, msg, time.Now(), params.ClusterID.String())
+	b.stepsNotifier.notify(params.ClusterID)
 	return installer.NewEnableHostOK().WithPayload(&host)
 }
 
+func (b *bareMetalInventory) RebindHost(ctx context.Context, params installer.RebindHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var host models.Host
+	var cluster, newCluster common.Cluster
+	newClusterID := *params.RebindHostParams.NewClusterID
+	log.Infof("rebind host %s from cluster %s to cluster %s", params.HostID, params.ClusterID, newClusterID)
+
+	if newClusterID == params.ClusterID {
+		return common.NewApiError(http.StatusBadRequest, errors.Errorf("Host %s is already bound to cluster %s",
+			params.HostID, params.ClusterID))
+	}
+
+	txSuccess := false
+	tx := b.db.Begin()
+	defer func() {
+		if !txSuccess {
+			log.Error("rebind host failed")
+			tx.Rollback()
+		}
+		if r := recover(); r != nil {
+			log.Error("rebind host failed")
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.First(&host, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get host %s in cluster %s", params.HostID, params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	// The user must have access to both clusters, the clusters of other users are not found
+	clusterQuery := tx
+	if query := identity.GetUserIDFilter(ctx); query != "" {
+		clusterQuery = clusterQuery.Where(query)
+	}
+	if err := clusterQuery.First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err := clusterQuery.First(&newCluster, "id = ?", newClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", newClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	if cluster.UserID != newCluster.UserID {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Host can be moved only between clusters of the same owner"))
+	}
+	if err := b.clusterApi.AcceptRegistration(&newCluster); err != nil {
+		log.WithError(err).Errorf("cluster %s cannot accept host %s", newClusterID, params.HostID)
+		return common.NewApiError(http.StatusConflict, err)
+	}
+
+	if err := b.hostApi.RebindHost(ctx, &host, newClusterID, tx); err != nil {
+		log.WithError(err).Errorf("failed to move host %s from cluster %s to cluster %s",
+			params.HostID, params.ClusterID, newClusterID)
+		return common.GenerateErrorResponderWithDefault(err, http.StatusConflict)
+	}
+
+	// Both clusters lost or gained a host, therefore the validations of all their hosts are re-evaluated
+	for _, id := range []strfmt.UUID{params.ClusterID, newClusterID} {
+		var c common.Cluster
+		if err := tx.Preload("Hosts").First(&c, "id = ?", id).Error; err != nil {
+			log.WithError(err).Errorf("failed to get cluster %s", id)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if err := b.updateHostsAndClusterStatus(ctx, &c, tx, log); err != nil {
+			return common.GenerateErrorResponder(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		log.Error(err)
+		return common.NewApiError(http.StatusInternalServerError, errors.New("DB error, failed to commit"))
+	}
+	txSuccess = true
+
+	if err := b.db.First(&host, "id = ? and cluster_id = ?", params.HostID, newClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get host %s after rebind", params.HostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	b.eventsHandler.AddEvent(ctx, params.HostID.String(), models.EventSeverityInfo,
+		fmt.Sprintf("Host %s: moved from cluster %s to cluster %s", common.GetHostnameForMsg(&host),
+			params.ClusterID, newClusterID),
+		time.Now(), newClusterID.String(), params.ClusterID.String())
+	b.stepsNotifier.notify(params.ClusterID)
+
+	if err := b.customizeHost(&host); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return installer.NewRebindHostOK().WithPayload(&host)
+}
+
 func (b *bareMetalInventory) createKubeconfigJob(cluster *common.Cluster, jobName string, cfg []byte) *batch.Job {
 	id := cluster.ID
 	// [TODO]  make sure that we use openshift-installer from the release image, otherwise the KubeconfigGenerator image must be updated here per opnshift version
@@ -1952,6 +3430,7 @@ func (b *bareMetalInventory) CancelInstallation(ctx context.Context, params inst
 			common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction")))
 	}
 	txSuccess = true
+	b.stepsNotifier.notify(params.ClusterID)
 
 	return installer.NewCancelInstallationAccepted().WithPayload(&c.Cluster)
 }
@@ -2023,6 +3502,7 @@ func (b *bareMetalInventory) ResetCluster(ctx context.Context, params installer.
 			common.GenerateError(http.StatusInternalServerError, errors.New("DB error, failed to commit transaction")))
 	}
 	txSuccess = true
+	b.stepsNotifier.notify(params.ClusterID)
 
 	return installer.NewResetClusterAccepted().WithPayload(&c.Cluster)
 }
@@ -2180,25 +3660,6 @@ func (b *bareMetalInventory) validateDNSRecords(domain *dnsDomain) error {
 	return validations.CheckDNSRecordsExistence(vipAddresses, domain.ID, domain.Provider)
 }
 
-func ipAsUint(ipStr string, log logrus.FieldLogger) uint64 {
-	parts := strings.Split(ipStr, ".")
-	if len(parts) != 4 {
-		log.Warnf("Invalid ip %s", ipStr)
-		return 0
-	}
-	var result uint64 = 0
-	for _, p := range parts {
-		result = result << 8
-		converted, err := strconv.ParseUint(p, 10, 64)
-		if err != nil {
-			log.WithError(err).Warnf("Conversion of %s to uint", p)
-			return 0
-		}
-		result += converted
-	}
-	return result
-}
-
 func applyLimit(ret models.FreeAddressesList, limitParam *int64) models.FreeAddressesList {
 	if limitParam != nil && *limitParam >= 0 && *limitParam < int64(len(ret)) {
 		return ret[:*limitParam]
@@ -2223,9 +3684,7 @@ func (b *bareMetalInventory) getFreeAddresses(params installer.GetFreeAddressesP
 	}
 
 	// Sort addresses
-	sort.Slice(ret, func(i, j int) bool {
-		return ipAsUint(ret[i].String(), log) < ipAsUint(ret[j].String(), log)
-	})
+	network.SortIPs(ret)
 
 	ret = applyLimit(ret, params.Limit)
 
@@ -2243,12 +3702,111 @@ func (b *bareMetalInventory) GetFreeAddresses(ctx context.Context, params instal
 	return installer.NewGetFreeAddressesOK().WithPayload(results)
 }
 
+func (b *bareMetalInventory) GetClusterConnectivity(ctx context.Context, params installer.GetClusterConnectivityParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	if err := b.db.Preload("Hosts", "status <> ?", host.HostStatusDisabled).First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return installer.NewGetClusterConnectivityOK().WithPayload(connectivity.BuildConnectivityMatrix(log, &cluster))
+}
+
+func (b *bareMetalInventory) GetClusterNetworkTopology(ctx context.Context, params installer.GetClusterNetworkTopologyParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster common.Cluster
+	if err := b.db.Preload("Hosts", "status <> ?", host.HostStatusDisabled).First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return installer.NewGetClusterNetworkTopologyOK().WithPayload(connectivity.BuildNetworkTopology(log, &cluster))
+}
+
+func (b *bareMetalInventory) SearchHosts(ctx context.Context, params installer.SearchHostsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	filter := host.SearchFilter{
+		MacAddress:   swag.StringValue(params.MacAddress),
+		SerialNumber: swag.StringValue(params.SerialNumber),
+		BmcAddress:   swag.StringValue(params.BmcAddress),
+		Vendor:       swag.StringValue(params.Vendor),
+		Product:      swag.StringValue(params.Product),
+	}
+	var err error
+	if filter.LabelSelectors, err = host.ParseLabelSelectors(swag.StringValue(params.Labels)); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	db := b.db
+	if query := identity.GetUserIDFilter(ctx); query != "" {
+		db = db.Where("cluster_id in (?)", b.db.Model(&common.Cluster{}).Select("id").Where(query).QueryExpr())
+	}
+	if params.Status != nil {
+		db = db.Where("status = ?", *params.Status)
+	}
+	if params.Role != nil {
+		db = db.Where("role = ?", *params.Role)
+	}
+	db = filter.Apply(db)
+
+	var total int64
+	if err = db.Model(&models.Host{}).Count(&total).Error; err != nil {
+		log.WithError(err).Error("failed to count the searched hosts")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	page := make([]*models.Host, 0)
+	if err = db.Order("cluster_id").Order("id").Offset(swag.Int64Value(params.Offset)).
+		Limit(swag.Int64Value(params.Limit)).Find(&page).Error; err != nil {
+		log.WithError(err).Error("failed to search hosts")
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	for _, h := range page {
+		if err = b.customizeHost(h); err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	return installer.NewSearchHostsOK().WithPayload(&models.HostSearchResult{
+		TotalCount: total,
+		Hosts:      page,
+	})
+}
+
 func (b *bareMetalInventory) customizeHost(host *models.Host) error {
 	b.customizeHostStages(host)
 	b.customizeHostname(host)
+	b.customizeHostAgentVersion(host)
 	return nil
 }
 
+func (b *bareMetalInventory) customizeHostAgentVersion(host *models.Host) {
+	host.AgentVersionStatus = b.GetAgentVersionStatus(host.DiscoveryAgentVersion)
+}
+
+// getAgentVersionDrift returns the hosts of the cluster whose agent is not the current one
+func (b *bareMetalInventory) getAgentVersionDrift(cluster *common.Cluster) *models.AgentVersionDrift {
+	drift := &models.AgentVersionDrift{
+		ExpectedVersion:    b.AgentDockerImg,
+		MinimumVersion:     b.MinimumAgentVersion,
+		CompatibleHostIds:  []strfmt.UUID{},
+		UnsupportedHostIds: []strfmt.UUID{},
+	}
+	for _, h := range cluster.Hosts {
+		switch b.GetAgentVersionStatus(h.DiscoveryAgentVersion) {
+		case models.AgentVersionStatusCompatible:
+			drift.CompatibleHostIds = append(drift.CompatibleHostIds, *h.ID)
+		case models.AgentVersionStatusUnsupported:
+			drift.UnsupportedHostIds = append(drift.UnsupportedHostIds, *h.ID)
+		}
+	}
+	return drift
+}
+
 func (b *bareMetalInventory) customizeHostStages(host *models.Host) {
 	host.ProgressStages = b.hostApi.GetStagesByRole(host.Role, host.Bootstrap)
 }
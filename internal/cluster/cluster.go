@@ -66,7 +66,8 @@ type API interface {
 }
 
 type Config struct {
-	PrepareConfig PrepareConfig
+	PrepareConfig    PrepareConfig
+	MaxHostClockSkew time.Duration `envconfig:"MAX_HOST_CLOCK_SKEW" default:"5s"`
 }
 
 type Manager struct {
@@ -92,11 +93,12 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler e
 		log: log,
 		db:  db,
 	}
+	preprocessor := newRefreshPreprocessor(log, cfg)
 	return &Manager{
 		log:             log,
 		db:              db,
-		insufficient:    NewInsufficientState(log, db, hostAPI),
-		ready:           NewReadyState(log, db),
+		insufficient:    NewInsufficientState(log, db, hostAPI, preprocessor),
+		ready:           NewReadyState(log, db, preprocessor),
 		installing:      NewInstallingState(log, db),
 		finalizing:      NewFinalizingState(log, db),
 		installed:       NewInstalledState(log, db),
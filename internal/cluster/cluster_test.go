@@ -2,6 +2,7 @@ package cluster
 
 import (
 	"context"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"time"
@@ -29,6 +30,7 @@ var defaultTestConfig = Config{
 	PrepareConfig: PrepareConfig{
 		InstallationTimeout: 10 * time.Minute,
 	},
+	MaxHostClockSkew: 5 * time.Second,
 }
 
 var _ = Describe("stateMachine", func() {
@@ -197,8 +199,11 @@ var _ = Describe("cluster monitor", func() {
 			BeforeEach(func() {
 
 				c = common.Cluster{Cluster: models.Cluster{
-					ID:     &id,
-					Status: swag.String("insufficient"),
+					ID:                       &id,
+					Status:                   swag.String("insufficient"),
+					ClusterNetworkCidr:       "10.128.0.0/14",
+					ClusterNetworkHostPrefix: 23,
+					ServiceNetworkCidr:       "172.30.0.0/16",
 				}}
 
 				Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
@@ -230,6 +235,29 @@ var _ = Describe("cluster monitor", func() {
 				expectedState = "ready"
 				Expect(db.Model(&c).Updates(map[string]interface{}{"api_vip": "1.2.3.5", "ingress_vip": "1.2.3.5"}).Error).To(Not(HaveOccurred()))
 			})
+			It("insufficient -> insufficient host clock is not synced", func() {
+				createHost(id, "known", db)
+				createHost(id, "known", db)
+				createHost(id, "known", db)
+				Expect(db.Model(geCluster(id, db).Hosts[0]).Update("time_sync", timeSync(-6000)).Error).
+					ShouldNot(HaveOccurred())
+				mockHostAPIIsRequireUserActionResetFalse(3)
+
+				shouldHaveUpdated = false
+				expectedState = "insufficient"
+				Expect(db.Model(&c).Updates(map[string]interface{}{"api_vip": "1.2.3.5", "ingress_vip": "1.2.3.5"}).Error).To(Not(HaveOccurred()))
+			})
+			It("insufficient -> insufficient service network overlaps cluster network", func() {
+				createHost(id, "known", db)
+				createHost(id, "known", db)
+				createHost(id, "known", db)
+				mockHostAPIIsRequireUserActionResetFalse(3)
+
+				shouldHaveUpdated = false
+				expectedState = "insufficient"
+				Expect(db.Model(&c).Updates(map[string]interface{}{"api_vip": "1.2.3.5", "ingress_vip": "1.2.3.5",
+					"service_network_cidr": "10.130.0.0/16"}).Error).To(Not(HaveOccurred()))
+			})
 			It("insufficient -> insufficient including hosts in discovering", func() {
 				createHost(id, "known", db)
 				createHost(id, "known", db)
@@ -262,8 +290,11 @@ var _ = Describe("cluster monitor", func() {
 			BeforeEach(func() {
 
 				c = common.Cluster{Cluster: models.Cluster{
-					ID:     &id,
-					Status: swag.String("ready"),
+					ID:                       &id,
+					Status:                   swag.String("ready"),
+					ClusterNetworkCidr:       "10.128.0.0/14",
+					ClusterNetworkHostPrefix: 23,
+					ServiceNetworkCidr:       "172.30.0.0/16",
 				}}
 
 				Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
@@ -285,6 +316,25 @@ var _ = Describe("cluster monitor", func() {
 				shouldHaveUpdated = true
 				expectedState = "insufficient"
 			})
+			It("ready -> insufficient host clock is not synced", func() {
+				createHost(id, "known", db)
+				createHost(id, "known", db)
+				createHost(id, "known", db)
+				Expect(db.Model(&models.Host{}).Where("cluster_id = ?", id.String()).
+					Update("time_sync", timeSync(6000)).Error).ShouldNot(HaveOccurred())
+
+				shouldHaveUpdated = true
+				expectedState = "insufficient"
+			})
+			It("ready -> insufficient host prefix too small for the cluster network", func() {
+				createHost(id, "known", db)
+				createHost(id, "known", db)
+				createHost(id, "known", db)
+				Expect(db.Model(&c).Update("cluster_network_host_prefix", 15).Error).ShouldNot(HaveOccurred())
+
+				shouldHaveUpdated = true
+				expectedState = "insufficient"
+			})
 			It("ready -> insufficient one host is discovering", func() {
 				createHost(id, "known", db)
 				createHost(id, "known", db)
@@ -599,10 +649,17 @@ func createHost(clusterId strfmt.UUID, state string, db *gorm.DB) {
 		ClusterID: clusterId,
 		Role:      models.HostRoleMaster,
 		Status:    swag.String(state),
+		TimeSync:  timeSync(100),
 	}
 	Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
 }
 
+func timeSync(clockOffsetMs int64) string {
+	b, err := json.Marshal(&models.HostTimeSync{ClockOffsetMs: clockOffsetMs, UpdatedAt: strfmt.DateTime(time.Now())})
+	Expect(err).ShouldNot(HaveOccurred())
+	return string(b)
+}
+
 func getTestLog() logrus.FieldLogger {
 	l := logrus.New()
 	l.SetOutput(ioutil.Discard)
@@ -624,11 +681,14 @@ func addInstallationRequirements(clusterId strfmt.UUID, db *gorm.DB) {
 			ClusterID: clusterId,
 			Role:      models.HostRoleMaster,
 			Status:    swag.String("known"),
+			TimeSync:  timeSync(100),
 		}
 		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
 
 	}
-	Expect(db.Model(&common.Cluster{Cluster: models.Cluster{ID: &clusterId}}).Updates(map[string]interface{}{"api_vip": "1.2.3.5", "ingress_vip": "1.2.3.5"}).Error).To(Not(HaveOccurred()))
+	Expect(db.Model(&common.Cluster{Cluster: models.Cluster{ID: &clusterId}}).Updates(map[string]interface{}{"api_vip": "1.2.3.5", "ingress_vip": "1.2.3.5",
+		"cluster_network_cidr": "10.128.0.0/14", "cluster_network_host_prefix": 23, "service_network_cidr": "172.30.0.0/16"}).Error).
+		To(Not(HaveOccurred()))
 
 }
 
@@ -25,6 +25,7 @@ const (
 const (
 	statusInfoReady                           = "Cluster ready to be installed"
 	statusInfoInsufficient                    = "cluster is insufficient, exactly 3 known master hosts are needed for installation"
+	statusInfoValidationsFailed               = "cluster is insufficient, not all the cluster validations succeeded"
 	statusInfoInstalling                      = "Installation in progress"
 	statusInfoFinalizing                      = "Finalizing cluster installation"
 	statusInfoInstalled                       = "installed"
@@ -14,19 +14,21 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func NewInsufficientState(log logrus.FieldLogger, db *gorm.DB, hostAPI host.API) *insufficientState {
+func NewInsufficientState(log logrus.FieldLogger, db *gorm.DB, hostAPI host.API, preprocessor *refreshPreprocessor) *insufficientState {
 	return &insufficientState{
 		baseState: baseState{
 			log: log,
 			db:  db,
 		},
-		hostAPI: hostAPI,
+		hostAPI:      hostAPI,
+		preprocessor: preprocessor,
 	}
 }
 
 type insufficientState struct {
 	baseState
-	hostAPI host.API
+	hostAPI      host.API
+	preprocessor *refreshPreprocessor
 }
 
 func (i *insufficientState) RefreshStatus(ctx context.Context, c *common.Cluster, db *gorm.DB) (*common.Cluster, error) {
@@ -44,9 +46,19 @@ func (i *insufficientState) RefreshStatus(ctx context.Context, c *common.Cluster
 		return c, nil
 	}
 
+	if err := refreshVips(ctx, i.log, c, db); err != nil {
+		return nil, errors.Wrapf(err, "failed to refresh the VIPs of cluster %s", c.ID)
+	}
+
+	validationsSucceeded, err := i.preprocessor.refreshValidations(ctx, c, db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to refresh validations of cluster %s", c.ID)
+	}
+
 	// Cluster is ready
 	mastersInKnown, ok := mappedMastersByRole[models.HostStatusKnown]
-	if ok && len(mastersInKnown) == minHostsNeededForInstallation && c.APIVip != "" && c.IngressVip != "" {
+	if ok && len(mastersInKnown) == minHostsNeededForInstallation && c.APIVip != "" && c.IngressVip != "" &&
+		validationsSucceeded {
 		log.Infof("Cluster %s has %d known master hosts, cluster is ready.", c.ID, minHostsNeededForInstallation)
 		return updateClusterStatus(log, db, *c.ID, swag.StringValue(c.Status), clusterStatusReady, statusInfoReady)
 
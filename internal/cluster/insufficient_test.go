@@ -36,7 +36,7 @@ var _ = Describe("insufficient_state", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		manager = &Manager{
 			log:             getTestLog(),
-			insufficient:    NewInsufficientState(getTestLog(), db, mockHostAPI),
+			insufficient:    NewInsufficientState(getTestLog(), db, mockHostAPI, newRefreshPreprocessor(getTestLog(), defaultTestConfig)),
 			registrationAPI: NewRegistrar(getTestLog(), db),
 			eventsHandler:   mockEvents,
 		}
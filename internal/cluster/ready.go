@@ -8,17 +8,24 @@ import (
 	logutil "github.com/filanov/bm-inventory/pkg/log"
 	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
-func NewReadyState(log logrus.FieldLogger, db *gorm.DB) *readyState {
+func NewReadyState(log logrus.FieldLogger, db *gorm.DB, preprocessor *refreshPreprocessor) *readyState {
 	return &readyState{
-		log: log,
-		db:  db,
+		baseState: baseState{
+			log: log,
+			db:  db,
+		},
+		preprocessor: preprocessor,
 	}
 }
 
-type readyState baseState
+type readyState struct {
+	baseState
+	preprocessor *refreshPreprocessor
+}
 
 var _ StateAPI = (*Manager)(nil)
 
@@ -34,14 +41,26 @@ func (r *readyState) RefreshStatus(ctx context.Context, c *common.Cluster, db *g
 		return c, nil
 	}
 
+	if err := refreshVips(ctx, r.log, c, db); err != nil {
+		return nil, errors.Wrapf(err, "failed to refresh the VIPs of cluster %s", c.ID)
+	}
+
+	validationsSucceeded, err := r.preprocessor.refreshValidations(ctx, c, db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to refresh validations of cluster %s", c.ID)
+	}
+
 	// Cluster is insufficient
 	mastersInKnown := mappedMastersByRole[intenralhost.HostStatusKnown]
 	if len(mastersInKnown) != minHostsNeededForInstallation {
 		log.Infof("Cluster %s dos not have exactly %d known master hosts, cluster is insufficient.", c.ID, minHostsNeededForInstallation)
 		return updateClusterStatus(log, db, *c.ID, swag.StringValue(c.Status), clusterStatusInsufficient, statusInfoInsufficient)
-
-		//cluster is still ready
-	} else {
-		return c, nil
 	}
+	if !validationsSucceeded {
+		log.Infof("Cluster %s validations did not succeed, cluster is insufficient.", c.ID)
+		return updateClusterStatus(log, db, *c.ID, swag.StringValue(c.Status), clusterStatusInsufficient, statusInfoValidationsFailed)
+	}
+
+	//cluster is still ready
+	return c, nil
 }
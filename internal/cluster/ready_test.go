@@ -25,7 +25,8 @@ var _ = Describe("ready_state", func() {
 
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName)
-		state = &Manager{log: getTestLog(), ready: NewReadyState(getTestLog(), db)}
+		state = &Manager{log: getTestLog(), ready: NewReadyState(getTestLog(), db,
+			newRefreshPreprocessor(getTestLog(), defaultTestConfig))}
 
 		id = strfmt.UUID(uuid.New().String())
 		cluster = common.Cluster{Cluster: models.Cluster{
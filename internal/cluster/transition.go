@@ -65,8 +65,13 @@ func (th *transitionHandler) PostResetCluster(sw stateswitch.StateSwitch, args s
 		return errors.New("PostResetCluster invalid argument")
 	}
 
+	// The allocated VIPs are released, they are allocated again when the cluster is refreshed
+	extra := make([]interface{}, 0)
+	if sCluster.cluster.VipAllocation {
+		extra = append(extra, "api_vip", "", "ingress_vip", "")
+	}
 	return th.updateTransitionCluster(logutil.FromContext(params.ctx, th.log), params.db, sCluster,
-		params.reason)
+		params.reason, extra...)
 }
 
 ////////////////////////////////////////////////////////////////////////////
@@ -5,6 +5,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net"
+	"net/url"
 	"regexp"
 	"strings"
 
@@ -13,6 +15,8 @@ import (
 
 const clusterNameRegex = "^([a-z]([-a-z0-9]*[a-z0-9])?)*$"
 
+const noProxyDomainRegex = `^\.?([a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`
+
 type imagePullSecret struct {
 	Auths map[string]map[string]interface{} `json:"auths"`
 }
@@ -167,3 +171,40 @@ func ValidateClusterNameFormat(name string) error {
 	}
 	return nil
 }
+
+// ValidateHTTPProxyFormat validates the format of the URL of an HTTP or HTTPS proxy
+func ValidateHTTPProxyFormat(proxyURL string) error {
+	u, err := url.ParseRequestURI(proxyURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("Proxy URL format is not valid: '%s'. "+
+			"The URL must be of the form http://<user>:<password>@<server>:<port>/", proxyURL)
+	}
+	return nil
+}
+
+// ValidateNoProxyFormat validates that every entry of a comma-separated no-proxy list is a domain name, optionally
+// starting with a dot, an IP address or a CIDR, or that the list is '*' alone
+func ValidateNoProxyFormat(noProxy string) error {
+	entries := make([]string, 0)
+	for _, entry := range strings.Split(noProxy, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	for _, entry := range entries {
+		switch {
+		case entry == "*" && len(entries) == 1:
+		case net.ParseIP(entry) != nil:
+		case strings.Contains(entry, "/"):
+			if _, _, err := net.ParseCIDR(entry); err != nil {
+				return fmt.Errorf("No-proxy entry '%s' is not a valid CIDR", entry)
+			}
+		default:
+			if matched, _ := regexp.MatchString(noProxyDomainRegex, entry); !matched {
+				return fmt.Errorf("No-proxy entry '%s' is neither a domain name, an IP address nor a CIDR. "+
+					"'*' bypasses the proxy for all destinations and must be the only entry.", entry)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,413 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/internal/network"
+	"github.com/filanov/bm-inventory/models"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type validationID models.ClusterValidationID
+
+const (
+	AreHostClocksSynced    = validationID(models.ClusterValidationIDHostClocksSynced)
+	AreVipsAllocated       = validationID(models.ClusterValidationIDVipsAllocated)
+	IsNetworkPrefixValid   = validationID(models.ClusterValidationIDNetworkPrefixValid)
+	AreCidrsNotOverlapping = validationID(models.ClusterValidationIDNoCidrsOverlapping)
+	IsProxyValid           = validationID(models.ClusterValidationIDProxyValid)
+)
+
+func (v validationID) category() (string, error) {
+	switch v {
+	case AreHostClocksSynced:
+		return "hosts-data", nil
+	case AreVipsAllocated, IsNetworkPrefixValid, AreCidrsNotOverlapping, IsProxyValid:
+		return "network", nil
+	}
+	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected validation id %s", string(v)))
+}
+
+func (v validationID) String() string {
+	return string(v)
+}
+
+type validationStatus string
+
+const (
+	ValidationSuccess validationStatus = "success"
+	ValidationFailure validationStatus = "failure"
+	ValidationPending validationStatus = "pending"
+	ValidationError   validationStatus = "error"
+)
+
+func (v validationStatus) String() string {
+	return string(v)
+}
+
+type validationContext struct {
+	cluster *common.Cluster
+	db      *gorm.DB
+}
+
+type validationConditon func(context *validationContext) validationStatus
+type validationStringFormatter func(context *validationContext, status validationStatus) string
+
+type validation struct {
+	id        validationID
+	condition validationConditon
+	formatter validationStringFormatter
+}
+
+type validationResult struct {
+	ID      validationID     `json:"id"`
+	Status  validationStatus `json:"status"`
+	Message string           `json:"message"`
+}
+
+type validator struct {
+	log          logrus.FieldLogger
+	maxClockSkew time.Duration
+}
+
+// Hosts that take part in the installation, the disabled hosts are ignored by the validations
+func activeHosts(c *common.Cluster) []*models.Host {
+	hosts := make([]*models.Host, 0, len(c.Hosts))
+	for _, h := range c.Hosts {
+		if swag.StringValue(h.Status) != models.HostStatusDisabled {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
+
+// A clock report older than this is not trusted, the clock of the host may have drifted since
+const maxTimeSyncReportAge = 10 * time.Minute
+
+// Hosts that will be installed, only these hosts are asked for their clock
+func installableHosts(c *common.Cluster) []*models.Host {
+	hosts := make([]*models.Host, 0, len(c.Hosts))
+	for _, h := range c.Hosts {
+		status := swag.StringValue(h.Status)
+		if (status == models.HostStatusKnown || status == models.HostStatusInsufficient) && h.Role != "" {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
+
+// timeSyncUnsupported returns whether the agent of the host replied to its latest clock report step with an error,
+// as agents that don't know the step do
+func timeSyncUnsupported(db *gorm.DB, h *models.Host) (bool, error) {
+	var last models.HostStep
+	err := db.Where("host_id = ? and cluster_id = ? and step_type = ?", h.ID.String(), h.ClusterID.String(),
+		models.StepTypeTimeSync).Order("issued_at desc").First(&last).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return !time.Time(last.RepliedAt).IsZero() && last.ExitCode != 0, nil
+}
+
+// hostClocks returns the names of the hosts to install whose clock is too far from the service clock, of the hosts to
+// install that did not report their clock recently and of the hosts to install whose agent can't report its clock
+func (v *validator) hostClocks(c *validationContext) (unsynced []string, missing []string, skipped []string) {
+	for _, h := range installableHosts(c.cluster) {
+		if h.TimeSync == "" {
+			unsupported, err := timeSyncUnsupported(c.db, h)
+			if err != nil {
+				v.log.WithError(err).Warnf("failed to get the clock report steps of host %s", h.ID)
+			}
+			if unsupported {
+				skipped = append(skipped, common.GetHostnameForMsg(h))
+			} else {
+				missing = append(missing, common.GetHostnameForMsg(h))
+			}
+			continue
+		}
+		var timeSync models.HostTimeSync
+		if err := json.Unmarshal([]byte(h.TimeSync), &timeSync); err != nil {
+			v.log.WithError(err).Warnf("failed to parse time sync of host %s", h.ID)
+			missing = append(missing, common.GetHostnameForMsg(h))
+			continue
+		}
+		if time.Since(time.Time(timeSync.UpdatedAt)) > maxTimeSyncReportAge {
+			missing = append(missing, common.GetHostnameForMsg(h))
+			continue
+		}
+		skew := time.Duration(timeSync.ClockOffsetMs) * time.Millisecond
+		if skew > v.maxClockSkew || -skew > v.maxClockSkew {
+			unsynced = append(unsynced, common.GetHostnameForMsg(h))
+		}
+	}
+	sort.Strings(unsynced)
+	sort.Strings(missing)
+	sort.Strings(skipped)
+	return unsynced, missing, skipped
+}
+
+// The clocks of hosts whose agent can't report them are not checked rather than blocking the installation
+func (v *validator) areHostClocksSynced(c *validationContext) validationStatus {
+	unsynced, missing, _ := v.hostClocks(c)
+	if len(unsynced) > 0 {
+		return ValidationFailure
+	}
+	if len(missing) > 0 || len(installableHosts(c.cluster)) == 0 {
+		return ValidationPending
+	}
+	return ValidationSuccess
+}
+
+func (v *validator) printHostClocksSynced(c *validationContext, status validationStatus) string {
+	unsynced, missing, skipped := v.hostClocks(c)
+	switch status {
+	case ValidationSuccess:
+		if len(skipped) > 0 {
+			return fmt.Sprintf("The clocks of the other hosts are within %s of the service clock, the agents of hosts %s can't report their clock",
+				v.maxClockSkew, strings.Join(skipped, ", "))
+		}
+		return fmt.Sprintf("The clocks of all hosts are within %s of the service clock", v.maxClockSkew)
+	case ValidationFailure:
+		return fmt.Sprintf("The clocks of hosts %s are more than %s away from the service clock",
+			strings.Join(unsynced, ", "), v.maxClockSkew)
+	case ValidationPending:
+		if len(missing) == 0 {
+			return "No known or insufficient hosts with a role are registered to the cluster"
+		}
+		return fmt.Sprintf("Missing recent clock report of hosts %s", strings.Join(missing, ", "))
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) areVipsAllocated(c *validationContext) validationStatus {
+	if !c.cluster.VipAllocation {
+		return ValidationSuccess
+	}
+	if c.cluster.MachineNetworkCidr == "" {
+		return ValidationPending
+	}
+	if c.cluster.APIVip == "" || c.cluster.IngressVip == "" || v.verifyAllocatedVips(c) != nil {
+		return ValidationFailure
+	}
+	return ValidationSuccess
+}
+
+// verifyAllocatedVips returns why the VIPs that were allocated to the cluster are no longer free
+func (v *validator) verifyAllocatedVips(c *validationContext) error {
+	reserved, err := GetReservedVips(c.db, c.cluster)
+	if err != nil {
+		v.log.WithError(err).Errorf("failed to get the VIPs reserved for the other clusters of cluster %s", c.cluster.ID)
+		return err
+	}
+	return network.VerifyAllocatedVips(c.cluster.Hosts, c.cluster.MachineNetworkCidr, c.cluster.VipExclusionRanges,
+		reserved, c.cluster.APIVip, c.cluster.IngressVip, v.log)
+}
+
+func (v *validator) printVipsAllocated(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if !c.cluster.VipAllocation {
+			return "The VIPs are set by the user"
+		}
+		return fmt.Sprintf("API VIP %s and Ingress VIP %s are allocated in machine network %s",
+			c.cluster.APIVip, c.cluster.IngressVip, c.cluster.MachineNetworkCidr)
+	case ValidationFailure:
+		if c.cluster.APIVip == "" || c.cluster.IngressVip == "" {
+			return fmt.Sprintf("Not enough free addresses outside of the exclusion ranges to allocate the VIPs in machine network %s",
+				c.cluster.MachineNetworkCidr)
+		}
+		if err := v.verifyAllocatedVips(c); err != nil {
+			return fmt.Sprintf("%s, update the cluster to allocate new VIPs", err.Error())
+		}
+		return "The allocated VIPs are no longer free, update the cluster to allocate new VIPs"
+	case ValidationPending:
+		return "The machine network CIDR to allocate the VIPs in is not set"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) isNetworkPrefixValid(c *validationContext) validationStatus {
+	if c.cluster.ClusterNetworkCidr == "" {
+		return ValidationPending
+	}
+	if network.VerifyClusterNetworkPrefixes(&c.cluster.Cluster, activeHosts(c.cluster)) != nil {
+		return ValidationFailure
+	}
+	return ValidationSuccess
+}
+
+func (v *validator) printNetworkPrefixValid(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return fmt.Sprintf("The cluster network %s with host prefix %d has room for the %d nodes of the cluster",
+			c.cluster.ClusterNetworkCidr, c.cluster.ClusterNetworkHostPrefix, network.PlannedHosts(activeHosts(c.cluster)))
+	case ValidationFailure:
+		return network.VerifyClusterNetworkPrefixes(&c.cluster.Cluster, activeHosts(c.cluster)).Error()
+	case ValidationPending:
+		return "The cluster network CIDR is not set"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) areCidrsNotOverlapping(c *validationContext) validationStatus {
+	if c.cluster.ClusterNetworkCidr == "" || c.cluster.ServiceNetworkCidr == "" {
+		return ValidationPending
+	}
+	if network.VerifyNetworksNotOverlapping(&c.cluster.Cluster, activeHosts(c.cluster), v.log) != nil {
+		return ValidationFailure
+	}
+	return ValidationSuccess
+}
+
+func (v *validator) printCidrsNotOverlapping(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "The cluster and service networks overlap neither each other nor the networks of the hosts"
+	case ValidationFailure:
+		return network.VerifyNetworksNotOverlapping(&c.cluster.Cluster, activeHosts(c.cluster), v.log).Error()
+	case ValidationPending:
+		return "The cluster network CIDR or the service network CIDR is not set"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) isProxyValid(c *validationContext) validationStatus {
+	if !network.IsProxySet(&c.cluster.Cluster) {
+		return ValidationSuccess
+	}
+	if c.cluster.MachineNetworkCidr == "" {
+		return ValidationPending
+	}
+	if network.VerifyNoProxy(&c.cluster.Cluster) != nil {
+		return ValidationFailure
+	}
+	return ValidationSuccess
+}
+
+func (v *validator) printProxyValid(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if !network.IsProxySet(&c.cluster.Cluster) {
+			return "No proxy is set"
+		}
+		return "The no-proxy list contains the networks and the API domains of the cluster"
+	case ValidationFailure:
+		return network.VerifyNoProxy(&c.cluster.Cluster).Error()
+	case ValidationPending:
+		return "The machine network CIDR that must be in the no-proxy list is not set"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+type refreshPreprocessor struct {
+	log         logrus.FieldLogger
+	validations []validation
+}
+
+func newRefreshPreprocessor(log logrus.FieldLogger, cfg Config) *refreshPreprocessor {
+	return &refreshPreprocessor{
+		log:         log,
+		validations: newValidations(log, cfg),
+	}
+}
+
+func newValidations(log logrus.FieldLogger, cfg Config) []validation {
+	v := validator{
+		log:          log,
+		maxClockSkew: cfg.MaxHostClockSkew,
+	}
+	return []validation{
+		{
+			id:        AreHostClocksSynced,
+			condition: v.areHostClocksSynced,
+			formatter: v.printHostClocksSynced,
+		},
+		{
+			id:        AreVipsAllocated,
+			condition: v.areVipsAllocated,
+			formatter: v.printVipsAllocated,
+		},
+		{
+			id:        IsNetworkPrefixValid,
+			condition: v.isNetworkPrefixValid,
+			formatter: v.printNetworkPrefixValid,
+		},
+		{
+			id:        AreCidrsNotOverlapping,
+			condition: v.areCidrsNotOverlapping,
+			formatter: v.printCidrsNotOverlapping,
+		},
+		{
+			id:        IsProxyValid,
+			condition: v.isProxyValid,
+			formatter: v.printProxyValid,
+		},
+	}
+}
+
+func (r *refreshPreprocessor) preprocess(c *validationContext) (map[validationID]bool, map[string][]validationResult, error) {
+	stateMachineInput := make(map[validationID]bool)
+	validationsOutput := make(map[string][]validationResult)
+	for _, v := range r.validations {
+		st := v.condition(c)
+		stateMachineInput[v.id] = st == ValidationSuccess
+		message := v.formatter(c, st)
+		category, err := v.id.category()
+		if err != nil {
+			r.log.WithError(err).Warn("id.category()")
+			return nil, nil, err
+		}
+		validationsOutput[category] = append(validationsOutput[category], validationResult{
+			ID:      v.id,
+			Status:  st,
+			Message: message,
+		})
+	}
+	return stateMachineInput, validationsOutput, nil
+}
+
+// refreshValidations runs the validations of the cluster, stores their results when they changed and returns
+// whether all of them succeeded
+func (r *refreshPreprocessor) refreshValidations(ctx context.Context, c *common.Cluster, db *gorm.DB) (bool, error) {
+	log := logutil.FromContext(ctx, r.log)
+	conditions, validationsOutput, err := r.preprocess(&validationContext{cluster: c, db: db})
+	if err != nil {
+		return false, err
+	}
+	b, err := json.Marshal(validationsOutput)
+	if err != nil {
+		return false, err
+	}
+	if string(b) != c.ValidationsInfo {
+		if err = db.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).
+			Update("validations_info", string(b)).Error; err != nil {
+			log.WithError(err).Errorf("failed to update validations info of cluster %s", c.ID)
+			return false, err
+		}
+		c.ValidationsInfo = string(b)
+	}
+	for _, succeeded := range conditions {
+		if !succeeded {
+			return false, nil
+		}
+	}
+	return true, nil
+}
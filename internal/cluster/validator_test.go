@@ -0,0 +1,374 @@
+package cluster
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("cluster validations", func() {
+	var (
+		preprocessor *refreshPreprocessor
+		db           *gorm.DB
+		dbName       = "cluster_validations"
+		clusterID    strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		preprocessor = newRefreshPreprocessor(getTestLog(), Config{MaxHostClockSkew: 5 * time.Second})
+		db = common.PrepareTestDB(dbName)
+		clusterID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	newHost := func(hostname, status, hostTimeSync string) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		inventory, err := json.Marshal(&models.Inventory{Hostname: hostname})
+		Expect(err).ShouldNot(HaveOccurred())
+		return &models.Host{ID: &id, ClusterID: clusterID, Status: swag.String(status), Inventory: string(inventory),
+			TimeSync: hostTimeSync, Role: models.HostRoleMaster}
+	}
+
+	getResult := func(hosts ...*models.Host) (bool, validationResult) {
+		conditions, output, err := preprocessor.preprocess(&validationContext{
+			cluster: &common.Cluster{Cluster: models.Cluster{ID: &clusterID, Hosts: hosts}},
+			db:      db,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(output["hosts-data"]).To(HaveLen(1))
+		Expect(output["hosts-data"][0].ID).To(Equal(AreHostClocksSynced))
+		return conditions[AreHostClocksSynced], output["hosts-data"][0]
+	}
+
+	It("host clocks are synced", func() {
+		succeeded, result := getResult(newHost("h1", models.HostStatusKnown, timeSync(4000)),
+			newHost("h2", models.HostStatusKnown, timeSync(-4000)))
+		Expect(succeeded).To(BeTrue())
+		Expect(result.Status).To(Equal(ValidationSuccess))
+		Expect(result.Message).To(Equal("The clocks of all hosts are within 5s of the service clock"))
+	})
+
+	It("host clock is too far", func() {
+		succeeded, result := getResult(newHost("h1", models.HostStatusKnown, timeSync(100)),
+			newHost("h2", models.HostStatusKnown, timeSync(-6000)), newHost("h3", models.HostStatusKnown, ""))
+		Expect(succeeded).To(BeFalse())
+		Expect(result.Status).To(Equal(ValidationFailure))
+		Expect(result.Message).To(Equal("The clocks of hosts h2 are more than 5s away from the service clock"))
+	})
+
+	It("missing clock report", func() {
+		succeeded, result := getResult(newHost("h1", models.HostStatusKnown, timeSync(100)),
+			newHost("h2", models.HostStatusInsufficient, ""))
+		Expect(succeeded).To(BeFalse())
+		Expect(result.Status).To(Equal(ValidationPending))
+		Expect(result.Message).To(Equal("Missing recent clock report of hosts h2"))
+	})
+
+	recordTimeSyncStep := func(h *models.Host, repliedAt time.Time, exitCode int64) {
+		Expect(db.Create(&models.HostStep{
+			StepID:    swag.String("time-sync-1"),
+			HostID:    h.ID,
+			ClusterID: &h.ClusterID,
+			StepType:  models.StepTypeTimeSync,
+			IssuedAt:  strfmt.DateTime(time.Now().Add(-time.Minute)),
+			RepliedAt: strfmt.DateTime(repliedAt),
+			ExitCode:  exitCode,
+		}).Error).ShouldNot(HaveOccurred())
+	}
+
+	It("clock report step is not replied yet", func() {
+		h2 := newHost("h2", models.HostStatusKnown, "")
+		recordTimeSyncStep(h2, time.Time{}, 0)
+		succeeded, result := getResult(newHost("h1", models.HostStatusKnown, timeSync(100)), h2)
+		Expect(succeeded).To(BeFalse())
+		Expect(result.Status).To(Equal(ValidationPending))
+		Expect(result.Message).To(Equal("Missing recent clock report of hosts h2"))
+	})
+
+	It("agent can't report its clock", func() {
+		h2 := newHost("h2", models.HostStatusKnown, "")
+		recordTimeSyncStep(h2, time.Now(), 255)
+		succeeded, result := getResult(newHost("h1", models.HostStatusKnown, timeSync(100)), h2)
+		Expect(succeeded).To(BeTrue())
+		Expect(result.Status).To(Equal(ValidationSuccess))
+		Expect(result.Message).To(Equal(
+			"The clocks of the other hosts are within 5s of the service clock, the agents of hosts h2 can't report their clock"))
+	})
+
+	It("clock is checked when the agent reported it before", func() {
+		h2 := newHost("h2", models.HostStatusKnown, timeSync(-6000))
+		recordTimeSyncStep(h2, time.Now(), 255)
+		succeeded, result := getResult(newHost("h1", models.HostStatusKnown, timeSync(100)), h2)
+		Expect(succeeded).To(BeFalse())
+		Expect(result.Status).To(Equal(ValidationFailure))
+	})
+
+	It("disabled hosts are ignored", func() {
+		succeeded, result := getResult(newHost("h1", models.HostStatusKnown, timeSync(100)),
+			newHost("h2", models.HostStatusDisabled, timeSync(60000)))
+		Expect(succeeded).To(BeTrue())
+		Expect(result.Status).To(Equal(ValidationSuccess))
+	})
+
+	It("stale clock report", func() {
+		b, err := json.Marshal(&models.HostTimeSync{ClockOffsetMs: 100,
+			UpdatedAt: strfmt.DateTime(time.Now().Add(-maxTimeSyncReportAge - time.Minute))})
+		Expect(err).ShouldNot(HaveOccurred())
+		succeeded, result := getResult(newHost("h1", models.HostStatusKnown, timeSync(100)),
+			newHost("h2", models.HostStatusKnown, string(b)))
+		Expect(succeeded).To(BeFalse())
+		Expect(result.Status).To(Equal(ValidationPending))
+		Expect(result.Message).To(Equal("Missing recent clock report of hosts h2"))
+	})
+
+	It("hosts that are not installed are ignored", func() {
+		noRole := newHost("h3", models.HostStatusKnown, "")
+		noRole.Role = ""
+		succeeded, result := getResult(newHost("h1", models.HostStatusKnown, timeSync(100)),
+			newHost("h2", models.HostStatusDiscovering, ""), noRole,
+			newHost("h4", models.HostStatusDisconnected, timeSync(60000)))
+		Expect(succeeded).To(BeTrue())
+		Expect(result.Status).To(Equal(ValidationSuccess))
+	})
+
+	It("no hosts to install", func() {
+		succeeded, result := getResult(newHost("h1", models.HostStatusDiscovering, ""))
+		Expect(succeeded).To(BeFalse())
+		Expect(result.Status).To(Equal(ValidationPending))
+		Expect(result.Message).To(Equal("No known or insufficient hosts with a role are registered to the cluster"))
+	})
+
+	It("no hosts", func() {
+		succeeded, result := getResult()
+		Expect(succeeded).To(BeFalse())
+		Expect(result.Status).To(Equal(ValidationPending))
+	})
+})
+
+func networkResult(output map[string][]validationResult, id validationID) validationResult {
+	for _, r := range output["network"] {
+		if r.ID == id {
+			return r
+		}
+	}
+	Fail("validation " + id.String() + " is missing")
+	return validationResult{}
+}
+
+var _ = Describe("VIPs allocated validation", func() {
+	var (
+		preprocessor *refreshPreprocessor
+		db           *gorm.DB
+		dbName       = "vips_allocated_validation"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		preprocessor = newRefreshPreprocessor(getTestLog(), Config{MaxHostClockSkew: 5 * time.Second})
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	getResult := func(c *models.Cluster) (bool, validationResult) {
+		id := strfmt.UUID(uuid.New().String())
+		c.ID = &id
+		b, err := json.Marshal(models.FreeNetworksAddresses{{Network: "1.2.4.0/24",
+			FreeAddresses: []string{"1.2.4.5", "1.2.4.6", "1.2.4.7"}}})
+		Expect(err).ShouldNot(HaveOccurred())
+		c.Hosts = []*models.Host{{FreeAddresses: string(b)}}
+		conditions, output, err := preprocessor.preprocess(&validationContext{cluster: &common.Cluster{Cluster: *c}, db: db})
+		Expect(err).ShouldNot(HaveOccurred())
+		return conditions[AreVipsAllocated], networkResult(output, AreVipsAllocated)
+	}
+
+	It("set by the user", func() {
+		succeeded, result := getResult(&models.Cluster{APIVip: "1.2.4.5"})
+		Expect(succeeded).To(BeTrue())
+		Expect(result.Message).To(Equal("The VIPs are set by the user"))
+	})
+
+	It("allocated", func() {
+		succeeded, result := getResult(&models.Cluster{VipAllocation: true, MachineNetworkCidr: "1.2.4.0/24",
+			APIVip: "1.2.4.5", IngressVip: "1.2.4.6"})
+		Expect(succeeded).To(BeTrue())
+		Expect(result.Status).To(Equal(ValidationSuccess))
+		Expect(result.Message).To(Equal("API VIP 1.2.4.5 and Ingress VIP 1.2.4.6 are allocated in machine network 1.2.4.0/24"))
+	})
+
+	It("allocated VIP used by another cluster", func() {
+		otherID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &otherID, MachineNetworkCidr: "1.2.0.0/16",
+			APIVip: "1.2.4.6"}}).Error).ShouldNot(HaveOccurred())
+		succeeded, result := getResult(&models.Cluster{VipAllocation: true, MachineNetworkCidr: "1.2.4.0/24",
+			APIVip: "1.2.4.5", IngressVip: "1.2.4.6"})
+		Expect(succeeded).To(BeFalse())
+		Expect(result.Status).To(Equal(ValidationFailure))
+		Expect(result.Message).To(Equal("Allocated VIP <1.2.4.6> is excluded from the allocation or used by another cluster, " +
+			"update the cluster to allocate new VIPs"))
+	})
+
+	It("not enough free addresses", func() {
+		succeeded, result := getResult(&models.Cluster{VipAllocation: true, MachineNetworkCidr: "1.2.4.0/24"})
+		Expect(succeeded).To(BeFalse())
+		Expect(result.Status).To(Equal(ValidationFailure))
+		Expect(result.Message).To(HavePrefix("Not enough free addresses"))
+	})
+
+	It("no machine network", func() {
+		succeeded, result := getResult(&models.Cluster{VipAllocation: true})
+		Expect(succeeded).To(BeFalse())
+		Expect(result.Status).To(Equal(ValidationPending))
+	})
+})
+
+var _ = Describe("cluster network validations", func() {
+	var preprocessor *refreshPreprocessor
+
+	BeforeEach(func() {
+		preprocessor = newRefreshPreprocessor(getTestLog(), Config{MaxHostClockSkew: 5 * time.Second})
+	})
+
+	newHost := func(hostname string, addresses ...string) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		inventory, err := json.Marshal(&models.Inventory{
+			Hostname:   hostname,
+			Interfaces: []*models.Interface{{Name: "eth0", IPV4Addresses: addresses}},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		return &models.Host{ID: &id, Status: swag.String(models.HostStatusKnown), Inventory: string(inventory)}
+	}
+
+	getResult := func(c *models.Cluster, id validationID) (bool, validationResult) {
+		conditions, output, err := preprocessor.preprocess(&validationContext{cluster: &common.Cluster{Cluster: *c}})
+		Expect(err).ShouldNot(HaveOccurred())
+		return conditions[id], networkResult(output, id)
+	}
+
+	newCluster := func(hosts ...*models.Host) *models.Cluster {
+		return &models.Cluster{
+			ClusterNetworkCidr:       "10.128.0.0/14",
+			ClusterNetworkHostPrefix: 23,
+			ServiceNetworkCidr:       "172.30.0.0/16",
+			MachineNetworkCidr:       "1.2.3.0/24",
+			Hosts:                    hosts,
+		}
+	}
+
+	Context("network prefix valid", func() {
+		It("valid", func() {
+			succeeded, result := getResult(newCluster(), IsNetworkPrefixValid)
+			Expect(succeeded).To(BeTrue())
+			Expect(result.Status).To(Equal(ValidationSuccess))
+			Expect(result.Message).To(Equal("The cluster network 10.128.0.0/14 with host prefix 23 has room for the 3 nodes of the cluster"))
+		})
+
+		It("not enough nodes", func() {
+			c := newCluster(newHost("h1"), newHost("h2"), newHost("h3"), newHost("h4"), newHost("h5"))
+			c.ClusterNetworkCidr = "10.128.0.0/21"
+			succeeded, result := getResult(c, IsNetworkPrefixValid)
+			Expect(succeeded).To(BeFalse())
+			Expect(result.Status).To(Equal(ValidationFailure))
+			Expect(result.Message).To(Equal("cluster-network-cidr <10.128.0.0/21> with host prefix 23 has room for 4 nodes, fewer than the 5 nodes of the cluster"))
+		})
+
+		It("host prefix too large", func() {
+			c := newCluster()
+			c.ClusterNetworkHostPrefix = 26
+			succeeded, result := getResult(c, IsNetworkPrefixValid)
+			Expect(succeeded).To(BeFalse())
+			Expect(result.Status).To(Equal(ValidationFailure))
+		})
+
+		It("cluster network not set", func() {
+			succeeded, result := getResult(&models.Cluster{}, IsNetworkPrefixValid)
+			Expect(succeeded).To(BeFalse())
+			Expect(result.Status).To(Equal(ValidationPending))
+		})
+	})
+
+	Context("no cidrs overlapping", func() {
+		It("not overlapping", func() {
+			succeeded, result := getResult(newCluster(newHost("h1", "1.2.3.4/24", "10.0.0.4/16")), AreCidrsNotOverlapping)
+			Expect(succeeded).To(BeTrue())
+			Expect(result.Status).To(Equal(ValidationSuccess))
+		})
+
+		It("service network overlaps cluster network", func() {
+			c := newCluster()
+			c.ServiceNetworkCidr = "10.130.0.0/16"
+			succeeded, result := getResult(c, AreCidrsNotOverlapping)
+			Expect(succeeded).To(BeFalse())
+			Expect(result.Status).To(Equal(ValidationFailure))
+			Expect(result.Message).To(Equal("service-network-cidr <10.130.0.0/16> overlaps cluster-network-cidr <10.128.0.0/14>"))
+		})
+
+		It("service network overlaps machine network", func() {
+			c := newCluster()
+			c.ServiceNetworkCidr = "1.2.0.0/16"
+			succeeded, result := getResult(c, AreCidrsNotOverlapping)
+			Expect(succeeded).To(BeFalse())
+			Expect(result.Message).To(Equal("service-network-cidr <1.2.0.0/16> overlaps the machine-network-cidr <1.2.3.0/24>"))
+		})
+
+		It("cluster network overlaps another network of a host", func() {
+			succeeded, result := getResult(newCluster(newHost("h1", "1.2.3.4/24", "10.129.0.4/16")), AreCidrsNotOverlapping)
+			Expect(succeeded).To(BeFalse())
+			Expect(result.Message).To(Equal("cluster-network-cidr <10.128.0.0/14> overlaps the network of interface eth0 of host h1 <10.129.0.0/16>"))
+		})
+
+		It("networks not set", func() {
+			succeeded, result := getResult(&models.Cluster{}, AreCidrsNotOverlapping)
+			Expect(succeeded).To(BeFalse())
+			Expect(result.Status).To(Equal(ValidationPending))
+		})
+	})
+
+	Context("proxy valid", func() {
+		newProxyCluster := func(noProxy string) *models.Cluster {
+			c := newCluster()
+			c.HTTPProxy = "http://proxy.example.org:3128"
+			c.NoProxy = noProxy
+			return c
+		}
+
+		It("no proxy", func() {
+			succeeded, result := getResult(&models.Cluster{}, IsProxyValid)
+			Expect(succeeded).To(BeTrue())
+			Expect(result.Message).To(Equal("No proxy is set"))
+		})
+
+		It("networks in no-proxy", func() {
+			succeeded, result := getResult(newProxyCluster("10.128.0.0/14,172.30.0.0/16,1.2.3.0/24"), IsProxyValid)
+			Expect(succeeded).To(BeTrue())
+			Expect(result.Message).To(Equal("The no-proxy list contains the networks and the API domains of the cluster"))
+		})
+
+		It("network missing from no-proxy", func() {
+			succeeded, result := getResult(newProxyCluster("10.128.0.0/14,1.2.3.0/24"), IsProxyValid)
+			Expect(succeeded).To(BeFalse())
+			Expect(result.Status).To(Equal(ValidationFailure))
+			Expect(result.Message).To(Equal("no-proxy does not contain the service-network-cidr <172.30.0.0/16>"))
+		})
+
+		It("machine network not set", func() {
+			c := newProxyCluster("*")
+			c.MachineNetworkCidr = ""
+			succeeded, result := getResult(c, IsProxyValid)
+			Expect(succeeded).To(BeFalse())
+			Expect(result.Status).To(Equal(ValidationPending))
+		})
+	})
+})
@@ -0,0 +1,112 @@
+package cluster
+
+import (
+	"context"
+	"database/sql"
+	"net"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/internal/network"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Key of the advisory lock that serializes the allocation of VIPs between the replicas of the service
+const vipAllocationLockID = 4735
+
+// inTransaction runs fn in the transaction of db, or in a new transaction when db is not in one
+func inTransaction(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return fn(db)
+	}
+	return db.Transaction(fn)
+}
+
+// GetReservedVips returns the VIPs of the other clusters whose machine network overlaps the machine network of the
+// cluster, they are not allocated to it
+func GetReservedVips(db *gorm.DB, c *common.Cluster) ([]string, error) {
+	ret := make([]string, 0)
+	if c.MachineNetworkCidr == "" {
+		return ret, nil
+	}
+	if _, _, err := net.ParseCIDR(c.MachineNetworkCidr); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse machine network CIDR %s", c.MachineNetworkCidr)
+	}
+	var clusters []*common.Cluster
+	if err := db.Select("api_vip, ingress_vip").
+		Where("id <> ? and NULLIF(machine_network_cidr, '')::inet && ?::inet", c.ID.String(), c.MachineNetworkCidr).
+		Find(&clusters).Error; err != nil {
+		return nil, err
+	}
+	for _, other := range clusters {
+		for _, vip := range []string{other.APIVip, other.IngressVip} {
+			if vip != "" {
+				ret = append(ret, vip)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// SelectVips returns the VIPs of a cluster whose VIPs are allocated by the service: its current VIPs while they are
+// still free, new ones otherwise, and empty ones when there are not enough free addresses in its machine network.
+// It holds the VIP allocation lock until the end of the transaction of db, the selected VIPs are stored in the same
+// transaction so that no other cluster selects them in the meantime.
+func SelectVips(log logrus.FieldLogger, db *gorm.DB, c *common.Cluster) (string, string, error) {
+	if err := db.Exec("SELECT pg_advisory_xact_lock(?)", vipAllocationLockID).Error; err != nil {
+		return "", "", errors.Wrap(err, "failed to lock the VIP allocation")
+	}
+	reserved, err := GetReservedVips(db, c)
+	if err != nil {
+		return "", "", err
+	}
+	if c.APIVip != "" && c.IngressVip != "" && network.VerifyAllocatedVips(c.Hosts, c.MachineNetworkCidr,
+		c.VipExclusionRanges, reserved, c.APIVip, c.IngressVip, log) == nil {
+		return c.APIVip, c.IngressVip, nil
+	}
+	apiVip, ingressVip, err := network.AllocateVips(c.Hosts, c.MachineNetworkCidr, c.VipExclusionRanges, reserved, log)
+	if err != nil {
+		log.WithError(err).Debugf("failed to allocate the VIPs of cluster %s", c.ID)
+		return "", "", nil
+	}
+	return apiVip, ingressVip, nil
+}
+
+// refreshVips allocates the VIPs of a cluster that opted in for the allocation and has none yet. VIPs that were
+// allocated are never moved here, even when they are no longer free: the VIPs validation reports them, and the user
+// asks for new ones by updating the cluster.
+func refreshVips(ctx context.Context, log logrus.FieldLogger, c *common.Cluster, db *gorm.DB) error {
+	if !c.VipAllocation || c.MachineNetworkCidr == "" || (c.APIVip != "" && c.IngressVip != "") {
+		return nil
+	}
+	log = logutil.FromContext(ctx, log)
+	return inTransaction(db, func(tx *gorm.DB) error {
+		apiVip, ingressVip, err := SelectVips(log, tx, c)
+		if err != nil {
+			return err
+		}
+		// Another replica may have allocated the VIPs while the lock was taken
+		var current common.Cluster
+		if err = tx.Select("api_vip, ingress_vip").Take(&current, "id = ?", c.ID.String()).Error; err != nil {
+			return err
+		}
+		if current.APIVip != c.APIVip || current.IngressVip != c.IngressVip {
+			c.APIVip = current.APIVip
+			c.IngressVip = current.IngressVip
+			return nil
+		}
+		if apiVip == c.APIVip && ingressVip == c.IngressVip {
+			return nil
+		}
+		log.Infof("Allocating API VIP <%s> and Ingress VIP <%s> to cluster %s", apiVip, ingressVip, c.ID)
+		if err = tx.Model(&common.Cluster{}).Where("id = ?", c.ID.String()).
+			Updates(map[string]interface{}{"api_vip": apiVip, "ingress_vip": ingressVip}).Error; err != nil {
+			return err
+		}
+		c.APIVip = apiVip
+		c.IngressVip = ingressVip
+		return nil
+	})
+}
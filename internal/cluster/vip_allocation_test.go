@@ -0,0 +1,136 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/internal/events"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("VIP allocation", func() {
+	var (
+		ctx    = context.Background()
+		db     *gorm.DB
+		c      common.Cluster
+		dbName = "vip_allocation"
+	)
+
+	createCluster := func(machineCidr, apiVip, ingressVip string) common.Cluster {
+		id := strfmt.UUID(uuid.New().String())
+		ret := common.Cluster{Cluster: models.Cluster{
+			ID:                 &id,
+			Status:             swag.String(clusterStatusInsufficient),
+			VipAllocation:      true,
+			MachineNetworkCidr: machineCidr,
+			APIVip:             apiVip,
+			IngressVip:         ingressVip,
+		}}
+		Expect(db.Create(&ret).Error).ShouldNot(HaveOccurred())
+		return ret
+	}
+
+	freeAddresses := func(addresses ...string) string {
+		b, err := json.Marshal(models.FreeNetworksAddresses{{Network: "1.2.4.0/24", FreeAddresses: addresses}})
+		Expect(err).ShouldNot(HaveOccurred())
+		return string(b)
+	}
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName, &events.Event{})
+		c = createCluster("1.2.4.0/24", "", "")
+		c.Hosts = []*models.Host{{FreeAddresses: freeAddresses("1.2.4.5", "1.2.4.6", "1.2.4.7", "1.2.4.8")}}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("allocate", func() {
+		Expect(refreshVips(ctx, logrus.New(), &c, db)).To(Succeed())
+		Expect(c.APIVip).To(Equal("1.2.4.5"))
+		Expect(c.IngressVip).To(Equal("1.2.4.6"))
+		Expect(geCluster(*c.ID, db).APIVip).To(Equal("1.2.4.5"))
+	})
+
+	It("skip the VIPs of other clusters in overlapping networks", func() {
+		createCluster("1.2.4.0/24", "1.2.4.5", "")
+		createCluster("1.2.0.0/16", "1.2.4.7", "")
+		createCluster("1.2.5.0/24", "1.2.4.6", "1.2.4.8")
+		reserved, err := GetReservedVips(db, &c)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(reserved).To(ConsistOf("1.2.4.5", "1.2.4.7"))
+		Expect(refreshVips(ctx, logrus.New(), &c, db)).To(Succeed())
+		Expect(c.APIVip).To(Equal("1.2.4.6"))
+		Expect(c.IngressVip).To(Equal("1.2.4.8"))
+	})
+
+	It("keep the VIPs while they are free", func() {
+		c.APIVip = "1.2.4.7"
+		c.IngressVip = "1.2.4.8"
+		Expect(refreshVips(ctx, logrus.New(), &c, db)).To(Succeed())
+		Expect(c.APIVip).To(Equal("1.2.4.7"))
+		Expect(c.IngressVip).To(Equal("1.2.4.8"))
+	})
+
+	It("keep the allocated VIPs when they are no longer free", func() {
+		c.APIVip = "1.2.4.7"
+		c.IngressVip = "1.2.4.9"
+		Expect(refreshVips(ctx, logrus.New(), &c, db)).To(Succeed())
+		Expect(c.APIVip).To(Equal("1.2.4.7"))
+		Expect(c.IngressVip).To(Equal("1.2.4.9"))
+	})
+
+	It("keep the VIPs that another replica allocated", func() {
+		Expect(db.Model(&c).Updates(map[string]interface{}{"api_vip": "1.2.4.7", "ingress_vip": "1.2.4.8"}).Error).
+			ShouldNot(HaveOccurred())
+		Expect(refreshVips(ctx, logrus.New(), &c, db)).To(Succeed())
+		Expect(c.APIVip).To(Equal("1.2.4.7"))
+		Expect(c.IngressVip).To(Equal("1.2.4.8"))
+	})
+
+	It("select new VIPs when they are no longer free", func() {
+		c.APIVip = "1.2.4.7"
+		c.IngressVip = "1.2.4.9"
+		apiVip, ingressVip, err := SelectVips(logrus.New(), db, &c)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(apiVip).To(Equal("1.2.4.5"))
+		Expect(ingressVip).To(Equal("1.2.4.6"))
+	})
+
+	It("no VIPs when there are not enough free addresses", func() {
+		c.APIVip = "1.2.4.5"
+		c.IngressVip = "1.2.4.6"
+		c.VipExclusionRanges = "1.2.4.0/29"
+		apiVip, ingressVip, err := SelectVips(logrus.New(), db, &c)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(apiVip).To(BeEmpty())
+		Expect(ingressVip).To(BeEmpty())
+	})
+
+	It("VIPs set by the user", func() {
+		c.VipAllocation = false
+		Expect(refreshVips(ctx, logrus.New(), &c, db)).To(Succeed())
+		Expect(c.APIVip).To(BeEmpty())
+	})
+
+	It("release on reset", func() {
+		c = createCluster("1.2.4.0/24", "1.2.4.5", "1.2.4.6")
+		Expect(db.Model(&c).Update("status", clusterStatusError).Error).ShouldNot(HaveOccurred())
+		c.Status = swag.String(clusterStatusError)
+		state := NewManager(defaultTestConfig, getTestLog(), db, events.New(db, logrus.New()), nil, nil)
+		Expect(state.ResetCluster(ctx, &c, "some reason", db)).ShouldNot(HaveOccurred())
+		reset := geCluster(*c.ID, db)
+		Expect(reset.APIVip).To(BeEmpty())
+		Expect(reset.IngressVip).To(BeEmpty())
+		Expect(reset.MachineNetworkCidr).To(Equal("1.2.4.0/24"))
+	})
+})
@@ -0,0 +1,148 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"net"
+	"time"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/internal/network"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/sirupsen/logrus"
+)
+
+// ReportValidity is the period during which a connectivity report is considered up to date.
+// The connectivity check step is sent every 1-2 minutes, so older reports are treated as stale.
+const ReportValidity = 5 * time.Minute
+
+const (
+	StatusSuccess = models.ConnectivityMatrixEntryStatusSuccess
+	StatusFailure = models.ConnectivityMatrixEntryStatusFailure
+	StatusPending = models.ConnectivityMatrixEntryStatusPending
+)
+
+func isReportStale(host *models.Host) bool {
+	updatedAt := time.Time(host.ConnectivityUpdatedAt)
+	return host.Connectivity == "" || updatedAt.IsZero() || time.Since(updatedAt) > ReportValidity
+}
+
+func ipInNetworks(ipStr string, ipnets []*net.IPNet) bool {
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return false
+	}
+	for _, ipnet := range ipnets {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func newEntry(source, target *models.Host, status string) *models.ConnectivityMatrixEntry {
+	return &models.ConnectivityMatrixEntry{
+		SourceHostID: *source.ID,
+		TargetHostID: *target.ID,
+		Status:       status,
+	}
+}
+
+func findRemoteHost(report *models.ConnectivityReport, hostID strfmt.UUID) *models.ConnectivityRemoteHost {
+	for _, r := range report.RemoteHosts {
+		if r.HostID.String() == hostID.String() {
+			return r
+		}
+	}
+	return nil
+}
+
+func evaluateRemoteHost(entry *models.ConnectivityMatrixEntry, remote *models.ConnectivityRemoteHost, machineIpnets []*net.IPNet) {
+	for _, l2 := range remote.L2Connectivity {
+		if l2.Successful && ipInNetworks(l2.RemoteIPAddress, machineIpnets) {
+			entry.L2Connected = true
+		}
+	}
+	for _, l3 := range remote.L3Connectivity {
+		if l3.Successful && ipInNetworks(l3.RemoteIPAddress, machineIpnets) {
+			entry.L3Connected = true
+		}
+	}
+	if entry.L2Connected || entry.L3Connected {
+		entry.Status = StatusSuccess
+	} else {
+		entry.Status = StatusFailure
+	}
+}
+
+// isConnectivityTarget returns whether the host is connected and a candidate for installation. The other hosts, such
+// as disconnected hosts or hosts flagged as registered again by the machine of another host, do not block the
+// connectivity of the hosts that are installed.
+func isConnectivityTarget(h *models.Host) bool {
+	if h.DuplicateOf != "" {
+		return false
+	}
+	switch swag.StringValue(h.Status) {
+	case models.HostStatusKnown, models.HostStatusInsufficient, models.HostStatusPendingForInput:
+		return h.Inventory != ""
+	default:
+		return false
+	}
+}
+
+// GetHostConnectivity returns the connectivity of the given host to every other connected host of the cluster that is
+// a candidate for installation, over the machine networks, according to the last connectivity report of the host.
+// Remote hosts that are missing from the report, or covered only by a stale report, are marked as pending. All the
+// remote hosts are marked as failed when a machine network cannot be parsed.
+func GetHostConnectivity(log logrus.FieldLogger, cluster *common.Cluster, host *models.Host) []*models.ConnectivityMatrixEntry {
+	ret := make([]*models.ConnectivityMatrixEntry, 0)
+	machineIpnets := make([]*net.IPNet, 0)
+	invalidMachineNetwork := false
+	for _, cidr := range network.MachineNetworkCidrs(&cluster.Cluster) {
+		_, machineIpnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.WithError(err).Errorf("Could not parse machine network cidr %s", cidr)
+			invalidMachineNetwork = true
+			continue
+		}
+		machineIpnets = append(machineIpnets, machineIpnet)
+	}
+	var report *models.ConnectivityReport
+	if !isReportStale(host) {
+		var r models.ConnectivityReport
+		if err := json.Unmarshal([]byte(host.Connectivity), &r); err != nil {
+			log.WithError(err).Warnf("Failed to unmarshal connectivity report of host %s", host.ID.String())
+		} else {
+			report = &r
+		}
+	}
+	for _, h := range cluster.Hosts {
+		if h.ID.String() == host.ID.String() || !isConnectivityTarget(h) {
+			continue
+		}
+		entry := newEntry(host, h, StatusPending)
+		if invalidMachineNetwork {
+			entry.Status = StatusFailure
+		} else if report != nil && len(machineIpnets) > 0 {
+			if remote := findRemoteHost(report, *h.ID); remote != nil {
+				evaluateRemoteHost(entry, remote, machineIpnets)
+			}
+		}
+		ret = append(ret, entry)
+	}
+	return ret
+}
+
+// BuildConnectivityMatrix returns the connectivity between every ordered pair of connected hosts of the cluster that
+// are candidates for installation
+func BuildConnectivityMatrix(log logrus.FieldLogger, cluster *common.Cluster) *models.ConnectivityMatrix {
+	ret := &models.ConnectivityMatrix{Entries: make([]*models.ConnectivityMatrixEntry, 0)}
+	for _, h := range cluster.Hosts {
+		if !isConnectivityTarget(h) {
+			continue
+		}
+		ret.Entries = append(ret.Entries, GetHostConnectivity(log, cluster, h)...)
+	}
+	return ret
+}
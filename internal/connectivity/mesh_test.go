@@ -0,0 +1,165 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("connectivity mesh", func() {
+	var (
+		log     logrus.FieldLogger
+		cluster *common.Cluster
+		h1, h2  *models.Host
+		h3      *models.Host
+	)
+
+	newHost := func(status string) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		inventory, err := json.Marshal(&models.Inventory{Hostname: id.String()})
+		Expect(err).NotTo(HaveOccurred())
+		return &models.Host{ID: &id, Status: swag.String(status), Inventory: string(inventory)}
+	}
+
+	remoteHost := func(h *models.Host, remoteIP string, l2, l3 bool) *models.ConnectivityRemoteHost {
+		return &models.ConnectivityRemoteHost{
+			HostID: *h.ID,
+			L2Connectivity: []*models.L2Connectivity{
+				{RemoteIPAddress: remoteIP, Successful: l2},
+			},
+			L3Connectivity: []*models.L3Connectivity{
+				{RemoteIPAddress: remoteIP, Successful: l3},
+			},
+		}
+	}
+
+	setReport := func(h *models.Host, updatedAt time.Time, remoteHosts ...*models.ConnectivityRemoteHost) {
+		b, err := json.Marshal(&models.ConnectivityReport{RemoteHosts: remoteHosts})
+		Expect(err).NotTo(HaveOccurred())
+		h.Connectivity = string(b)
+		h.ConnectivityUpdatedAt = strfmt.DateTime(updatedAt)
+	}
+
+	statuses := func(entries []*models.ConnectivityMatrixEntry) map[strfmt.UUID]string {
+		ret := make(map[strfmt.UUID]string)
+		for _, e := range entries {
+			ret[e.TargetHostID] = e.Status
+		}
+		return ret
+	}
+
+	BeforeEach(func() {
+		log = logrus.New()
+		h1 = newHost(models.HostStatusKnown)
+		h2 = newHost(models.HostStatusKnown)
+		h3 = newHost(models.HostStatusKnown)
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			MachineNetworkCidr: "1.2.3.0/24",
+			Hosts:              []*models.Host{h1, h2, h3},
+		}}
+	})
+
+	It("all reachable", func() {
+		setReport(h1, time.Now(), remoteHost(h2, "1.2.3.5", true, true), remoteHost(h3, "1.2.3.6", false, true))
+		entries := GetHostConnectivity(log, cluster, h1)
+		Expect(entries).To(HaveLen(2))
+		Expect(statuses(entries)).To(Equal(map[strfmt.UUID]string{*h2.ID: StatusSuccess, *h3.ID: StatusSuccess}))
+		Expect(entries[0].SourceHostID).To(Equal(*h1.ID))
+		Expect(entries[0].L2Connected).To(BeTrue())
+		Expect(entries[1].L2Connected).To(BeFalse())
+		Expect(entries[1].L3Connected).To(BeTrue())
+	})
+
+	It("unreachable host", func() {
+		setReport(h1, time.Now(), remoteHost(h2, "1.2.3.5", true, true), remoteHost(h3, "1.2.3.6", false, false))
+		Expect(statuses(GetHostConnectivity(log, cluster, h1))).To(Equal(map[strfmt.UUID]string{
+			*h2.ID: StatusSuccess, *h3.ID: StatusFailure}))
+	})
+
+	It("reachable only outside of the machine network", func() {
+		setReport(h1, time.Now(), remoteHost(h2, "1.2.3.5", true, true), remoteHost(h3, "10.0.0.6", true, true))
+		Expect(statuses(GetHostConnectivity(log, cluster, h1))).To(Equal(map[strfmt.UUID]string{
+			*h2.ID: StatusSuccess, *h3.ID: StatusFailure}))
+	})
+
+	It("host missing from report", func() {
+		setReport(h1, time.Now(), remoteHost(h2, "1.2.3.5", true, true))
+		Expect(statuses(GetHostConnectivity(log, cluster, h1))).To(Equal(map[strfmt.UUID]string{
+			*h2.ID: StatusSuccess, *h3.ID: StatusPending}))
+	})
+
+	It("stale report", func() {
+		setReport(h1, time.Now().Add(-2*ReportValidity), remoteHost(h2, "1.2.3.5", true, true),
+			remoteHost(h3, "1.2.3.6", false, false))
+		Expect(statuses(GetHostConnectivity(log, cluster, h1))).To(Equal(map[strfmt.UUID]string{
+			*h2.ID: StatusPending, *h3.ID: StatusPending}))
+	})
+
+	It("no report", func() {
+		Expect(statuses(GetHostConnectivity(log, cluster, h1))).To(Equal(map[strfmt.UUID]string{
+			*h2.ID: StatusPending, *h3.ID: StatusPending}))
+	})
+
+	It("no machine network cidr", func() {
+		cluster.MachineNetworkCidr = ""
+		setReport(h1, time.Now(), remoteHost(h2, "1.2.3.5", true, true), remoteHost(h3, "1.2.3.6", true, true))
+		Expect(statuses(GetHostConnectivity(log, cluster, h1))).To(Equal(map[strfmt.UUID]string{
+			*h2.ID: StatusPending, *h3.ID: StatusPending}))
+	})
+
+	It("invalid machine network cidr", func() {
+		cluster.MachineNetworkCidr = "1.2.3.0/33"
+		setReport(h1, time.Now(), remoteHost(h2, "1.2.3.5", true, true), remoteHost(h3, "1.2.3.6", true, true))
+		Expect(statuses(GetHostConnectivity(log, cluster, h1))).To(Equal(map[strfmt.UUID]string{
+			*h2.ID: StatusFailure, *h3.ID: StatusFailure}))
+	})
+
+	It("disconnected and discovering hosts are ignored", func() {
+		h2.Status = swag.String(models.HostStatusDisconnected)
+		h3.Status = swag.String(models.HostStatusDiscovering)
+		Expect(GetHostConnectivity(log, cluster, h1)).To(BeEmpty())
+	})
+
+	It("disabled host is ignored", func() {
+		h3.Status = swag.String(models.HostStatusDisabled)
+		setReport(h1, time.Now(), remoteHost(h2, "1.2.3.5", true, true))
+		Expect(statuses(GetHostConnectivity(log, cluster, h1))).To(Equal(map[strfmt.UUID]string{
+			*h2.ID: StatusSuccess}))
+	})
+
+	It("host without inventory is ignored", func() {
+		h3.Inventory = ""
+		setReport(h1, time.Now(), remoteHost(h2, "1.2.3.5", true, true))
+		Expect(statuses(GetHostConnectivity(log, cluster, h1))).To(Equal(map[strfmt.UUID]string{
+			*h2.ID: StatusSuccess}))
+	})
+
+	It("flagged duplicate host is ignored", func() {
+		h3.DuplicateOf = *h2.ID
+		setReport(h1, time.Now(), remoteHost(h2, "1.2.3.5", true, true))
+		Expect(statuses(GetHostConnectivity(log, cluster, h1))).To(Equal(map[strfmt.UUID]string{
+			*h2.ID: StatusSuccess}))
+		Expect(BuildConnectivityMatrix(log, cluster).Entries).To(HaveLen(2))
+	})
+
+	It("matrix", func() {
+		h3.Status = swag.String(models.HostStatusDisabled)
+		setReport(h1, time.Now(), remoteHost(h2, "1.2.3.5", true, true))
+		matrix := BuildConnectivityMatrix(log, cluster)
+		Expect(matrix.Entries).To(HaveLen(2))
+		Expect(matrix.Entries[0].SourceHostID).To(Equal(*h1.ID))
+		Expect(matrix.Entries[0].TargetHostID).To(Equal(*h2.ID))
+		Expect(matrix.Entries[0].Status).To(Equal(StatusSuccess))
+		Expect(matrix.Entries[1].SourceHostID).To(Equal(*h2.ID))
+		Expect(matrix.Entries[1].TargetHostID).To(Equal(*h1.ID))
+		Expect(matrix.Entries[1].Status).To(Equal(StatusPending))
+	})
+})
@@ -0,0 +1,166 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/internal/network"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
+	"github.com/sirupsen/logrus"
+)
+
+// topologyNetwork is a network of the topology, with the IDs of its hosts
+type topologyNetwork struct {
+	network *models.NetworkTopologyNetwork
+	ipnet   *net.IPNet
+	hostIDs map[string]bool
+}
+
+func hostReport(log logrus.FieldLogger, host *models.Host) *models.ConnectivityReport {
+	if host.Connectivity == "" {
+		return nil
+	}
+	var report models.ConnectivityReport
+	if err := json.Unmarshal([]byte(host.Connectivity), &report); err != nil {
+		log.WithError(err).Warnf("Failed to unmarshal connectivity report of host %s", host.ID.String())
+		return nil
+	}
+	return &report
+}
+
+// networkConnectivity returns the reachability of the target host from the source host over a network, according to
+// the last connectivity report of the source host. The report is shown even when it is stale, but the status is then
+// pending.
+func networkConnectivity(source, target *models.Host, report *models.ConnectivityReport,
+	ipnet *net.IPNet) *models.NetworkTopologyConnectivity {
+	ipnets := []*net.IPNet{ipnet}
+	ret := &models.NetworkTopologyConnectivity{
+		SourceHostID: *source.ID,
+		TargetHostID: *target.ID,
+		ReportedAt:   source.ConnectivityUpdatedAt,
+		Status:       StatusPending,
+	}
+	if report == nil {
+		return ret
+	}
+	remote := findRemoteHost(report, *target.ID)
+	if remote == nil {
+		return ret
+	}
+	checked := false
+	for _, l2 := range remote.L2Connectivity {
+		if ipInNetworks(l2.RemoteIPAddress, ipnets) {
+			checked = true
+			ret.L2Connected = ret.L2Connected || l2.Successful
+		}
+	}
+	for _, l3 := range remote.L3Connectivity {
+		if ipInNetworks(l3.RemoteIPAddress, ipnets) {
+			checked = true
+			ret.L3Connected = ret.L3Connected || l3.Successful
+		}
+	}
+	switch {
+	case !checked || isReportStale(source):
+		ret.Status = StatusPending
+	case ret.L2Connected || ret.L3Connected:
+		ret.Status = StatusSuccess
+	default:
+		ret.Status = StatusFailure
+	}
+	return ret
+}
+
+// BuildNetworkTopology returns the networks of the interfaces of the non-disabled hosts of the cluster that reported
+// their inventory, each with the interfaces of the hosts in it and the reachability between these hosts over it
+func BuildNetworkTopology(log logrus.FieldLogger, cluster *common.Cluster) *models.NetworkTopology {
+	machineNetworks := make(map[string]bool)
+	for _, cidr := range network.MachineNetworkCidrs(&cluster.Cluster) {
+		machineNetworks[cidr] = true
+	}
+	hosts := make([]*models.Host, 0, len(cluster.Hosts))
+	networks := make(map[string]*topologyNetwork)
+	for _, h := range cluster.Hosts {
+		if swag.StringValue(h.Status) == models.HostStatusDisabled || h.Inventory == "" {
+			continue
+		}
+		var inventory models.Inventory
+		if err := json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+			log.WithError(err).Warnf("Could not parse inventory of host %s", h.ID.String())
+			continue
+		}
+		hosts = append(hosts, h)
+		for _, intf := range inventory.Interfaces {
+			for _, address := range append(intf.IPV4Addresses, intf.IPV6Addresses...) {
+				ip, ipnet, err := net.ParseCIDR(address)
+				if err != nil {
+					log.WithError(err).Warnf("Could not parse CIDR %s", address)
+					continue
+				}
+				// Every host has the same IPv6 link-local network on all its interfaces
+				if ip.To4() == nil && ip.IsLinkLocalUnicast() {
+					continue
+				}
+				n, ok := networks[ipnet.String()]
+				if !ok {
+					n = &topologyNetwork{
+						network: &models.NetworkTopologyNetwork{
+							Cidr:           ipnet.String(),
+							MachineNetwork: machineNetworks[ipnet.String()],
+							Hosts:          make([]*models.NetworkTopologyHost, 0),
+							Connectivity:   make([]*models.NetworkTopologyConnectivity, 0),
+						},
+						ipnet:   ipnet,
+						hostIDs: make(map[string]bool),
+					}
+					networks[ipnet.String()] = n
+				}
+				if !n.hostIDs[h.ID.String()] {
+					n.hostIDs[h.ID.String()] = true
+					n.network.Hosts = append(n.network.Hosts, &models.NetworkTopologyHost{
+						HostID:   *h.ID,
+						Hostname: common.GetHostnameForMsg(h),
+					})
+				}
+				// The hosts are added one after the other, so the host is the last one of the network
+				topologyHost := n.network.Hosts[len(n.network.Hosts)-1]
+				topologyHost.Interfaces = append(topologyHost.Interfaces, &models.NetworkTopologyInterface{
+					Name:       intf.Name,
+					MacAddress: intf.MacAddress,
+					IPAddress:  address,
+					SpeedMbps:  intf.SpeedMbps,
+					Mtu:        intf.Mtu,
+					HasCarrier: intf.HasCarrier,
+				})
+			}
+		}
+	}
+
+	reports := make(map[string]*models.ConnectivityReport)
+	for _, h := range hosts {
+		reports[h.ID.String()] = hostReport(log, h)
+	}
+	ret := &models.NetworkTopology{Networks: make([]*models.NetworkTopologyNetwork, 0, len(networks))}
+	for _, n := range networks {
+		for _, source := range hosts {
+			if !n.hostIDs[source.ID.String()] {
+				continue
+			}
+			for _, target := range hosts {
+				if !n.hostIDs[target.ID.String()] || target.ID.String() == source.ID.String() {
+					continue
+				}
+				n.network.Connectivity = append(n.network.Connectivity,
+					networkConnectivity(source, target, reports[source.ID.String()], n.ipnet))
+			}
+		}
+		ret.Networks = append(ret.Networks, n.network)
+	}
+	sort.Slice(ret.Networks, func(i, j int) bool {
+		return ret.Networks[i].Cidr < ret.Networks[j].Cidr
+	})
+	return ret
+}
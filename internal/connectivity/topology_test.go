@@ -0,0 +1,159 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("network topology", func() {
+	var (
+		log        logrus.FieldLogger
+		cluster    *common.Cluster
+		h1, h2, h3 *models.Host
+	)
+
+	newHost := func(hostname string, interfaces ...*models.Interface) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		inventory, err := json.Marshal(&models.Inventory{Hostname: hostname, Interfaces: interfaces})
+		Expect(err).NotTo(HaveOccurred())
+		return &models.Host{ID: &id, Status: swag.String(models.HostStatusKnown), Inventory: string(inventory)}
+	}
+
+	newInterface := func(name string, speed, mtu int64, addresses ...string) *models.Interface {
+		return &models.Interface{Name: name, MacAddress: "52:54:00:00:00:01", IPV4Addresses: addresses,
+			IPV6Addresses: []string{"fe80::1/64"}, SpeedMbps: speed, Mtu: mtu, HasCarrier: true}
+	}
+
+	setReport := func(h *models.Host, updatedAt time.Time, remoteHosts ...*models.ConnectivityRemoteHost) {
+		b, err := json.Marshal(&models.ConnectivityReport{RemoteHosts: remoteHosts})
+		Expect(err).NotTo(HaveOccurred())
+		h.Connectivity = string(b)
+		h.ConnectivityUpdatedAt = strfmt.DateTime(updatedAt)
+	}
+
+	remoteHost := func(h *models.Host, l2 map[string]bool, l3 map[string]bool) *models.ConnectivityRemoteHost {
+		ret := &models.ConnectivityRemoteHost{HostID: *h.ID}
+		for ip, successful := range l2 {
+			ret.L2Connectivity = append(ret.L2Connectivity, &models.L2Connectivity{RemoteIPAddress: ip, Successful: successful})
+		}
+		for ip, successful := range l3 {
+			ret.L3Connectivity = append(ret.L3Connectivity, &models.L3Connectivity{RemoteIPAddress: ip, Successful: successful})
+		}
+		return ret
+	}
+
+	findNetwork := func(topology *models.NetworkTopology, cidr string) *models.NetworkTopologyNetwork {
+		for _, n := range topology.Networks {
+			if n.Cidr == cidr {
+				return n
+			}
+		}
+		return nil
+	}
+
+	findConnectivity := func(n *models.NetworkTopologyNetwork, source, target *models.Host) *models.NetworkTopologyConnectivity {
+		for _, c := range n.Connectivity {
+			if c.SourceHostID == *source.ID && c.TargetHostID == *target.ID {
+				return c
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		log = logrus.New()
+		h1 = newHost("h1", newInterface("eth0", 10000, 1500, "1.2.3.4/24"), newInterface("eth1", 1000, 9000, "10.0.0.4/16"))
+		h2 = newHost("h2", newInterface("eth0", 10000, 1500, "1.2.3.5/24"), newInterface("eth1", 1000, 1500, "10.0.0.5/16"))
+		h3 = newHost("h3", newInterface("eth0", 100, 1500, "1.2.3.6/24", "1.2.3.7/24"))
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			MachineNetworkCidr: "1.2.3.0/24",
+			Hosts:              []*models.Host{h1, h2, h3},
+		}}
+	})
+
+	It("networks and interfaces", func() {
+		topology := BuildNetworkTopology(log, cluster)
+		Expect(topology.Networks).To(HaveLen(2))
+		Expect(topology.Networks[0].Cidr).To(Equal("1.2.3.0/24"))
+		Expect(topology.Networks[1].Cidr).To(Equal("10.0.0.0/16"))
+
+		machineNetwork := topology.Networks[0]
+		Expect(machineNetwork.MachineNetwork).To(BeTrue())
+		Expect(machineNetwork.Hosts).To(HaveLen(3))
+		Expect(machineNetwork.Hosts[0].HostID).To(Equal(*h1.ID))
+		Expect(machineNetwork.Hosts[0].Hostname).To(Equal("h1"))
+		Expect(machineNetwork.Hosts[0].Interfaces).To(Equal([]*models.NetworkTopologyInterface{{
+			Name: "eth0", MacAddress: "52:54:00:00:00:01", IPAddress: "1.2.3.4/24", SpeedMbps: 10000, Mtu: 1500, HasCarrier: true,
+		}}))
+		Expect(machineNetwork.Hosts[2].Interfaces).To(HaveLen(2))
+		Expect(machineNetwork.Connectivity).To(HaveLen(6))
+
+		other := topology.Networks[1]
+		Expect(other.MachineNetwork).To(BeFalse())
+		Expect(other.Hosts).To(HaveLen(2))
+		Expect(other.Hosts[0].Interfaces[0].Mtu).To(Equal(int64(9000)))
+		Expect(other.Connectivity).To(HaveLen(2))
+	})
+
+	It("reachability per network", func() {
+		now := time.Now()
+		setReport(h1, now,
+			remoteHost(h2, map[string]bool{"1.2.3.5": true, "10.0.0.5": false}, map[string]bool{"1.2.3.5": true, "10.0.0.5": false}),
+			remoteHost(h3, map[string]bool{"1.2.3.6": false, "1.2.3.7": false}, map[string]bool{"1.2.3.6": false, "1.2.3.7": true}))
+		topology := BuildNetworkTopology(log, cluster)
+
+		c := findConnectivity(findNetwork(topology, "1.2.3.0/24"), h1, h2)
+		Expect(c.Status).To(Equal(StatusSuccess))
+		Expect(c.L2Connected).To(BeTrue())
+		Expect(c.L3Connected).To(BeTrue())
+		Expect(c.ReportedAt).To(Equal(strfmt.DateTime(now)))
+
+		c = findConnectivity(findNetwork(topology, "1.2.3.0/24"), h1, h3)
+		Expect(c.Status).To(Equal(StatusSuccess))
+		Expect(c.L2Connected).To(BeFalse())
+		Expect(c.L3Connected).To(BeTrue())
+
+		c = findConnectivity(findNetwork(topology, "10.0.0.0/16"), h1, h2)
+		Expect(c.Status).To(Equal(StatusFailure))
+		Expect(c.L2Connected).To(BeFalse())
+		Expect(c.L3Connected).To(BeFalse())
+
+		c = findConnectivity(findNetwork(topology, "1.2.3.0/24"), h2, h1)
+		Expect(c.Status).To(Equal(StatusPending))
+		Expect(time.Time(c.ReportedAt).IsZero()).To(BeTrue())
+	})
+
+	It("addresses of the network not checked", func() {
+		setReport(h1, time.Now(), remoteHost(h2, map[string]bool{"1.2.3.5": true}, nil))
+		topology := BuildNetworkTopology(log, cluster)
+		Expect(findConnectivity(findNetwork(topology, "1.2.3.0/24"), h1, h2).Status).To(Equal(StatusSuccess))
+		Expect(findConnectivity(findNetwork(topology, "10.0.0.0/16"), h1, h2).Status).To(Equal(StatusPending))
+	})
+
+	It("stale report", func() {
+		setReport(h1, time.Now().Add(-2*ReportValidity), remoteHost(h2, map[string]bool{"1.2.3.5": true}, nil))
+		c := findConnectivity(findNetwork(BuildNetworkTopology(log, cluster), "1.2.3.0/24"), h1, h2)
+		Expect(c.Status).To(Equal(StatusPending))
+		Expect(c.L2Connected).To(BeTrue())
+	})
+
+	It("disabled host and host without inventory are ignored", func() {
+		h2.Status = swag.String(models.HostStatusDisabled)
+		h3.Inventory = ""
+		topology := BuildNetworkTopology(log, cluster)
+		Expect(topology.Networks).To(HaveLen(2))
+		for _, n := range topology.Networks {
+			Expect(n.Hosts).To(HaveLen(1))
+			Expect(n.Connectivity).To(BeEmpty())
+		}
+	})
+})
@@ -30,6 +30,8 @@ var _ Handler = &Events{}
 type Event struct {
 	gorm.Model
 	models.Event
+	// The entity that the event was added for, the same in all the copies of the event for its other entities
+	PrimaryEntityID string `gorm:"index"`
 }
 
 type Events struct {
@@ -44,7 +46,7 @@ func New(db *gorm.DB, log logrus.FieldLogger) *Events {
 	}
 }
 
-func addEventToDB(log logrus.FieldLogger, db *gorm.DB, id string, severity string, message string, t time.Time, requestID string) error {
+func addEventToDB(log logrus.FieldLogger, db *gorm.DB, id string, primaryID string, severity string, message string, t time.Time, requestID string) error {
 	tt := strfmt.DateTime(t)
 	uid := strfmt.UUID(id)
 	rid := strfmt.UUID(requestID)
@@ -56,6 +58,7 @@ func addEventToDB(log logrus.FieldLogger, db *gorm.DB, id string, severity strin
 			Message:   &message,
 			RequestID: rid,
 		},
+		PrimaryEntityID: primaryID,
 	}
 
 	if err := db.Create(&e).Error; err != nil {
@@ -78,7 +81,7 @@ func (e *Events) AddEvent(ctx context.Context, entityID string, severity string,
 	}()
 
 	requestID := requestid.FromContext(ctx)
-	err := addEventToDB(log, tx, entityID, severity, msg, eventTime, requestID)
+	err := addEventToDB(log, tx, entityID, entityID, severity, msg, eventTime, requestID)
 	if err != nil {
 		return
 	}
@@ -86,7 +89,7 @@ func (e *Events) AddEvent(ctx context.Context, entityID string, severity string,
 	// Since we don't keep different tables to support multiple IDs for a single event,
 	// the workaround is to add to the DB a new event for every ID this event relates to
 	for _, entity := range otherEntities {
-		err := addEventToDB(log, tx, entity, severity, msg, eventTime, requestID)
+		err := addEventToDB(log, tx, entity, entityID, severity, msg, eventTime, requestID)
 		if err != nil {
 			return
 		}
@@ -102,3 +105,10 @@ func (e Events) GetEvents(entityID string) ([]*Event, error) {
 
 	return evs, nil
 }
+
+// MoveRelatedEvents re-associates the events of an entity that were also recorded for another entity,
+// e.g. the events of a host that was moved between clusters
+func MoveRelatedEvents(db *gorm.DB, entityID, fromID, toID string) error {
+	return db.Model(&Event{}).Where("entity_id = ? and primary_entity_id = ?", fromID, entityID).
+		Update("entity_id", toID).Error
+}
@@ -108,6 +108,21 @@ var _ = Describe("Events library", func() {
 		})
 	})
 
+	Context("Moving related events", func() {
+		It("moves only the events of the entity", func() {
+			t := time.Now()
+			theEvents.AddEvent(context.TODO(), "host", models.EventSeverityInfo, "host event", t, "cluster1")
+			theEvents.AddEvent(context.TODO(), "other", models.EventSeverityInfo, "host event", t, "cluster1")
+			Expect(events.MoveRelatedEvents(db, "host", "cluster1", "cluster2")).ShouldNot(HaveOccurred())
+			Expect(numOfEvents("host")).Should(Equal(1))
+			Expect(numOfEvents("cluster1")).Should(Equal(1))
+			Expect(numOfEvents("cluster2")).Should(Equal(1))
+			evs, err := theEvents.GetEvents("cluster2")
+			Expect(err).Should(BeNil())
+			Expect(evs[0]).Should(WithMessage(swag.String("host event")))
+		})
+	})
+
 	Context("events with request ID", func() {
 		It("events with request ID", func() {
 			ctx := context.Background()
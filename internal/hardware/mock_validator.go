@@ -48,3 +48,18 @@ func (mr *MockValidatorMockRecorder) GetHostValidDisks(host interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostValidDisks", reflect.TypeOf((*MockValidator)(nil).GetHostValidDisks), host)
 }
+
+// GetHostInstallationDisk mocks base method
+func (m *MockValidator) GetHostInstallationDisk(host *models.Host) (*models.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostInstallationDisk", host)
+	ret0, _ := ret[0].(*models.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostInstallationDisk indicates an expected call of GetHostInstallationDisk
+func (mr *MockValidatorMockRecorder) GetHostInstallationDisk(host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostInstallationDisk", reflect.TypeOf((*MockValidator)(nil).GetHostInstallationDisk), host)
+}
@@ -0,0 +1,43 @@
+package hardware
+
+import (
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+)
+
+const (
+	PlatformUnknown   = "unknown"
+	PlatformVirtual   = "virtual"
+	PlatformBareMetal = "bare metal"
+)
+
+// Substrings of the system manufacturer or product name that are reported by common hypervisors
+var virtualPlatformIdentifiers = []string{
+	"kvm",
+	"qemu",
+	"bochs",
+	"virtualbox",
+	"innotek",
+	"vmware",
+	"virtual machine",
+	"xen",
+	"ovirt",
+	"rhev",
+	"openstack",
+}
+
+// GetPlatform classifies the host as virtual or bare metal according to its system vendor
+func GetPlatform(inventory *models.Inventory) string {
+	if inventory == nil || inventory.SystemVendor == nil ||
+		(inventory.SystemVendor.Manufacturer == "" && inventory.SystemVendor.ProductName == "") {
+		return PlatformUnknown
+	}
+	vendor := strings.ToLower(inventory.SystemVendor.Manufacturer + " " + inventory.SystemVendor.ProductName)
+	for _, id := range virtualPlatformIdentifiers {
+		if strings.Contains(vendor, id) {
+			return PlatformVirtual
+		}
+	}
+	return PlatformBareMetal
+}
@@ -0,0 +1,39 @@
+package hardware
+
+import (
+	"github.com/filanov/bm-inventory/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetPlatform", func() {
+	tests := []struct {
+		manufacturer string
+		productName  string
+		platform     string
+	}{
+		{manufacturer: "Red Hat", productName: "KVM", platform: PlatformVirtual},
+		{manufacturer: "QEMU", productName: "Standard PC (Q35 + ICH9, 2009)", platform: PlatformVirtual},
+		{manufacturer: "innotek GmbH", productName: "VirtualBox", platform: PlatformVirtual},
+		{manufacturer: "VMware, Inc.", productName: "VMware Virtual Platform", platform: PlatformVirtual},
+		{manufacturer: "Microsoft Corporation", productName: "Virtual Machine", platform: PlatformVirtual},
+		{manufacturer: "oVirt", productName: "RHEV Hypervisor", platform: PlatformVirtual},
+		{manufacturer: "Dell Inc.", productName: "PowerEdge R640", platform: PlatformBareMetal},
+		{manufacturer: "HPE", productName: "ProLiant DL360 Gen10", platform: PlatformBareMetal},
+		{manufacturer: "", productName: "", platform: PlatformUnknown},
+	}
+	for i := range tests {
+		t := tests[i]
+		It(t.manufacturer+" "+t.productName, func() {
+			inventory := &models.Inventory{SystemVendor: &models.SystemVendor{
+				Manufacturer: t.manufacturer,
+				ProductName:  t.productName,
+			}}
+			Expect(GetPlatform(inventory)).To(Equal(t.platform))
+		})
+	}
+
+	It("no system vendor", func() {
+		Expect(GetPlatform(&models.Inventory{})).To(Equal(PlatformUnknown))
+	})
+})
@@ -17,6 +17,7 @@ import (
 //go:generate mockgen -source=validator.go -package=hardware -destination=mock_validator.go
 type Validator interface {
 	GetHostValidDisks(host *models.Host) ([]*models.Disk, error)
+	GetHostInstallationDisk(host *models.Host) (*models.Disk, error)
 }
 
 func NewValidator(log logrus.FieldLogger, cfg ValidatorCfg) Validator {
@@ -27,13 +28,15 @@ func NewValidator(log logrus.FieldLogger, cfg ValidatorCfg) Validator {
 }
 
 type ValidatorCfg struct {
-	MinCPUCores       int64 `envconfig:"HW_VALIDATOR_MIN_CPU_CORES" default:"2"`
-	MinCPUCoresWorker int64 `envconfig:"HW_VALIDATOR_MIN_CPU_CORES_WORKER" default:"2"`
-	MinCPUCoresMaster int64 `envconfig:"HW_VALIDATOR_MIN_CPU_CORES_MASTER" default:"4"`
-	MinRamGib         int64 `envconfig:"HW_VALIDATOR_MIN_RAM_GIB" default:"8"`
-	MinRamGibWorker   int64 `envconfig:"HW_VALIDATOR_MIN_RAM_GIB_WORKER" default:"8"`
-	MinRamGibMaster   int64 `envconfig:"HW_VALIDATOR_MIN_RAM_GIB_MASTER" default:"16"`
-	MinDiskSizeGb     int64 `envconfig:"HW_VALIDATOR_MIN_DISK_SIZE_GIB" default:"120"` // Env variable is GIB to not break infra
+	MinCPUCores           int64 `envconfig:"HW_VALIDATOR_MIN_CPU_CORES" default:"2"`
+	MinCPUCoresWorker     int64 `envconfig:"HW_VALIDATOR_MIN_CPU_CORES_WORKER" default:"2"`
+	MinCPUCoresMaster     int64 `envconfig:"HW_VALIDATOR_MIN_CPU_CORES_MASTER" default:"4"`
+	MinRamGib             int64 `envconfig:"HW_VALIDATOR_MIN_RAM_GIB" default:"8"`
+	MinRamGibWorker       int64 `envconfig:"HW_VALIDATOR_MIN_RAM_GIB_WORKER" default:"8"`
+	MinRamGibMaster       int64 `envconfig:"HW_VALIDATOR_MIN_RAM_GIB_MASTER" default:"16"`
+	MinDiskSizeGb         int64 `envconfig:"HW_VALIDATOR_MIN_DISK_SIZE_GIB" default:"120"` // Env variable is GIB to not break infra
+	MinNicSpeedMbps       int64 `envconfig:"HW_VALIDATOR_MIN_NIC_SPEED_MBPS" default:"1000"`
+	MaxDiskSyncDurationMs int64 `envconfig:"HW_VALIDATOR_MAX_DISK_SYNC_DURATION_MS" default:"10"` // 99th percentile of fsync, as recommended for etcd
 }
 
 type validator struct {
@@ -46,15 +49,60 @@ func (v *validator) GetHostValidDisks(host *models.Host) ([]*models.Disk, error)
 	if err := json.Unmarshal([]byte(host.Inventory), &inventory); err != nil {
 		return nil, err
 	}
-	disks := ListValidDisks(&inventory, gbToBytes(v.MinDiskSizeGb))
+	disks := ListValidDisks(&inventory, GibToBytes(v.MinDiskSizeGb))
 	if len(disks) == 0 {
 		return nil, fmt.Errorf("host %s doesn't have valid disks", host.ID)
 	}
 	return disks, nil
 }
 
-func gbToBytes(gb int64) int64 {
-	return gb * int64(units.GB)
+// GetHostInstallationDisk returns the disk selected by the user for the installation, or the first valid disk
+// when no disk was selected. An error is returned if the selected disk is not one of the valid disks of the host.
+func (v *validator) GetHostInstallationDisk(host *models.Host) (*models.Disk, error) {
+	disks, err := v.GetHostValidDisks(host)
+	if err != nil {
+		return nil, err
+	}
+	disk := SelectInstallationDisk(disks, host.InstallationDiskID)
+	if disk == nil {
+		return nil, fmt.Errorf("installation disk %s is not a valid disk of host %s", host.InstallationDiskID, host.ID)
+	}
+	return disk, nil
+}
+
+// SelectInstallationDisk returns the valid disk with the given id, or the first valid disk when no disk was selected.
+// All the installation disk lookups go through it, so that they agree on the disk.
+func SelectInstallationDisk(validDisks []*models.Disk, installationDiskID string) *models.Disk {
+	if len(validDisks) == 0 {
+		return nil
+	}
+	if installationDiskID == "" {
+		return validDisks[0]
+	}
+	return FindDisk(validDisks, installationDiskID)
+}
+
+// DevicePath returns the device file of the disk
+func DevicePath(disk *models.Disk) string {
+	return fmt.Sprintf("/dev/%s", disk.Name)
+}
+
+// FindDisk returns the disk whose serial, WWN or by-path identifier matches the given id
+func FindDisk(disks []*models.Disk, id string) *models.Disk {
+	if id == "" {
+		return nil
+	}
+	for _, disk := range disks {
+		if disk.Serial == id || disk.Wwn == id || disk.ByPath == id {
+			return disk
+		}
+	}
+	return nil
+}
+
+// GibToBytes returns the number of bytes of the given size in GiB, the unit of the hardware requirements
+func GibToBytes(gib int64) int64 {
+	return gib * int64(units.GiB)
 }
 
 func isNvme(name string) bool {
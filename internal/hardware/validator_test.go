@@ -90,6 +90,68 @@ var _ = Describe("hardware_validator", func() {
 		Expect(disks[4].DriveType).To(Equal("SSD"))
 		Expect(disks[4].Name).To(HavePrefix("nvme"))
 	})
+
+	Context("installation disk", func() {
+		BeforeEach(func() {
+			inventory.Disks = []*models.Disk{
+				{DriveType: "HDD", Name: "sda", SizeBytes: validDiskSize, Serial: "serial-a", Wwn: "0x5000a"},
+				{DriveType: "SSD", Name: "sdb", SizeBytes: validDiskSize, Serial: "serial-b",
+					ByPath: "pci-0000:00:1f.2-ata-2"},
+				{DriveType: "SSD", Name: "sdc", SizeBytes: 1, Serial: "serial-c"},
+			}
+			hw, err := json.Marshal(&inventory)
+			Expect(err).NotTo(HaveOccurred())
+			host1.Inventory = string(hw)
+		})
+
+		It("automatic selection", func() {
+			disk, err := hwvalidator.GetHostInstallationDisk(host1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disk.Name).To(Equal("sda"))
+		})
+
+		It("selected by serial", func() {
+			host1.InstallationDiskID = "serial-b"
+			disk, err := hwvalidator.GetHostInstallationDisk(host1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disk.Name).To(Equal("sdb"))
+		})
+
+		It("selected by wwn", func() {
+			host1.InstallationDiskID = "0x5000a"
+			disk, err := hwvalidator.GetHostInstallationDisk(host1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disk.Name).To(Equal("sda"))
+		})
+
+		It("selected by path", func() {
+			host1.InstallationDiskID = "pci-0000:00:1f.2-ata-2"
+			disk, err := hwvalidator.GetHostInstallationDisk(host1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disk.Name).To(Equal("sdb"))
+		})
+
+		It("selected disk is too small", func() {
+			host1.InstallationDiskID = "serial-c"
+			_, err := hwvalidator.GetHostInstallationDisk(host1)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("selected disk is missing", func() {
+			host1.InstallationDiskID = "serial-d"
+			_, err := hwvalidator.GetHostInstallationDisk(host1)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("select among the valid disks", func() {
+			disks := ListValidDisks(inventory, GibToBytes(120))
+			Expect(SelectInstallationDisk(disks, "").Name).To(Equal("sda"))
+			Expect(SelectInstallationDisk(disks, "serial-b").Name).To(Equal("sdb"))
+			Expect(DevicePath(SelectInstallationDisk(disks, "serial-b"))).To(Equal("/dev/sdb"))
+			Expect(SelectInstallationDisk(disks, "serial-c")).To(BeNil())
+			Expect(SelectInstallationDisk(nil, "")).To(BeNil())
+		})
+	})
 })
 
 func isBlockDeviceNameInlist(disks []*models.Disk, name string) bool {
@@ -0,0 +1,90 @@
+package host
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+)
+
+// AgentVersionConfig is the policy of the discovery agent versions of the hosts. The agents report the image they run
+// from as their version.
+type AgentVersionConfig struct {
+	AgentDockerImg string `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/ocpmetal/agent:latest"`
+	// Oldest version, compared with the tag of the agent image, that the hosts can be installed with. Every agent is
+	// supported when it is empty.
+	MinimumAgentVersion string `envconfig:"MINIMUM_AGENT_VERSION" default:""`
+}
+
+var agentVersionRegex = regexp.MustCompile(`^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?`)
+
+// parseAgentVersion returns the numeric components of a version or of the tag of an image, nil when it has none
+func parseAgentVersion(version string) []int {
+	// Images that are referenced by their digest have no version
+	if strings.Contains(version, "@") {
+		return nil
+	}
+	if i := strings.LastIndex(version, "/"); i >= 0 {
+		version = version[i+1:]
+	}
+	if i := strings.LastIndex(version, ":"); i >= 0 {
+		version = version[i+1:]
+	}
+	match := agentVersionRegex.FindStringSubmatch(version)
+	if match == nil {
+		return nil
+	}
+	ret := make([]int, 0, 3)
+	for _, part := range match[1:] {
+		n, _ := strconv.Atoi(part)
+		ret = append(ret, n)
+	}
+	return ret
+}
+
+func compareAgentVersions(a, b []int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// GetAgentVersionStatus returns how the agent version of a host relates to the policy, every agent is current when
+// there is no policy
+func (c *AgentVersionConfig) GetAgentVersionStatus(agentVersion string) models.AgentVersionStatus {
+	if c == nil || c.AgentDockerImg == "" || agentVersion == c.AgentDockerImg {
+		return models.AgentVersionStatusCurrent
+	}
+	if c.MinimumAgentVersion == "" {
+		return models.AgentVersionStatusCompatible
+	}
+	minimum := parseAgentVersion(c.MinimumAgentVersion)
+	version := parseAgentVersion(agentVersion)
+	// Agents without a version, such as the ones of a digest or a floating tag, cannot be known to be recent enough
+	if minimum == nil || version == nil || compareAgentVersions(version, minimum) < 0 {
+		return models.AgentVersionStatusUnsupported
+	}
+	return models.AgentVersionStatusCompatible
+}
+
+// IsAgentUpgradeNeeded returns whether the agent of a host should be upgraded to the configured image. Unsupported
+// agents are always upgraded, supported agents only when they are known to be older than the configured image, so
+// that newer agents are never downgraded.
+func (c *AgentVersionConfig) IsAgentUpgradeNeeded(agentVersion string) bool {
+	switch c.GetAgentVersionStatus(agentVersion) {
+	case models.AgentVersionStatusUnsupported:
+		return true
+	case models.AgentVersionStatusCompatible:
+		current := parseAgentVersion(c.AgentDockerImg)
+		version := parseAgentVersion(agentVersion)
+		return current != nil && version != nil && compareAgentVersions(version, current) < 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,86 @@
+package host
+
+import (
+	"github.com/filanov/bm-inventory/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("agent version policy", func() {
+	tests := []struct {
+		name           string
+		agentDockerImg string
+		minimum        string
+		agentVersion   string
+		expected       models.AgentVersionStatus
+	}{
+		{name: "no policy", agentDockerImg: "", agentVersion: "quay.io/ocpmetal/agent:v1.0.0",
+			expected: models.AgentVersionStatusCurrent},
+		{name: "current", agentDockerImg: "quay.io/ocpmetal/agent:v2.0.0", agentVersion: "quay.io/ocpmetal/agent:v2.0.0",
+			expected: models.AgentVersionStatusCurrent},
+		{name: "no minimum", agentDockerImg: "quay.io/ocpmetal/agent:v2.0.0", agentVersion: "quay.io/ocpmetal/agent:latest",
+			expected: models.AgentVersionStatusCompatible},
+		{name: "missing version", agentDockerImg: "quay.io/ocpmetal/agent:v2.0.0", agentVersion: "",
+			expected: models.AgentVersionStatusCompatible},
+		{name: "above minimum", agentDockerImg: "quay.io/ocpmetal/agent:v2.0.0", minimum: "v1.2",
+			agentVersion: "quay.io/ocpmetal/agent:v1.10.0", expected: models.AgentVersionStatusCompatible},
+		{name: "minimum", agentDockerImg: "quay.io/ocpmetal/agent:v2.0.0", minimum: "1.2.3",
+			agentVersion: "registry.example.com:5000/agent:1.2.3-rc1", expected: models.AgentVersionStatusCompatible},
+		{name: "below minimum", agentDockerImg: "quay.io/ocpmetal/agent:v2.0.0", minimum: "v1.2",
+			agentVersion: "quay.io/ocpmetal/agent:v1.1.9", expected: models.AgentVersionStatusUnsupported},
+		{name: "no version with minimum", agentDockerImg: "quay.io/ocpmetal/agent:v2.0.0", minimum: "v1.2",
+			agentVersion: "quay.io/ocpmetal/agent:latest", expected: models.AgentVersionStatusUnsupported},
+		{name: "digest with minimum", agentDockerImg: "quay.io/ocpmetal/agent:v2.0.0", minimum: "v1.2",
+			agentVersion: "quay.io/ocpmetal/agent@sha256:3f2b", expected: models.AgentVersionStatusUnsupported},
+		{name: "registry port is not a version", agentDockerImg: "quay.io/ocpmetal/agent:v2.0.0", minimum: "v1.2",
+			agentVersion: "registry.example.com:5000/agent", expected: models.AgentVersionStatusUnsupported},
+	}
+
+	for i := range tests {
+		t := tests[i]
+		It(t.name, func() {
+			cfg := &AgentVersionConfig{AgentDockerImg: t.agentDockerImg, MinimumAgentVersion: t.minimum}
+			Expect(cfg.GetAgentVersionStatus(t.agentVersion)).To(Equal(t.expected))
+		})
+	}
+
+	It("validation", func() {
+		v := validator{
+			log:             getTestLog(),
+			agentVersionCfg: &AgentVersionConfig{AgentDockerImg: "quay.io/ocpmetal/agent:v2.0.0", MinimumAgentVersion: "v1.2"},
+		}
+		c := &validationContext{host: &models.Host{DiscoveryAgentVersion: "quay.io/ocpmetal/agent:v1.5.0"}}
+		Expect(v.isAgentVersionSupported(c)).To(Equal(ValidationSuccess))
+		Expect(v.printAgentVersionSupported(c, ValidationSuccess)).To(Equal(
+			"Discovery agent quay.io/ocpmetal/agent:v1.5.0 is supported and is being upgraded to quay.io/ocpmetal/agent:v2.0.0"))
+		c.host.DiscoveryAgentVersion = "quay.io/ocpmetal/agent:v1.0.0"
+		Expect(v.isAgentVersionSupported(c)).To(Equal(ValidationFailure))
+		Expect(v.printAgentVersionSupported(c, ValidationFailure)).To(Equal(
+			"Discovery agent quay.io/ocpmetal/agent:v1.0.0 is older than the minimum version v1.2, the host is being upgraded to quay.io/ocpmetal/agent:v2.0.0"))
+		c.host.DiscoveryAgentVersion = "quay.io/ocpmetal/agent:v1.5.0"
+		c.host.AgentUpgradeAttempts = maxAgentUpgradeAttempts
+		Expect(v.isAgentVersionSupported(c)).To(Equal(ValidationFailure))
+		Expect(v.printAgentVersionSupported(c, ValidationFailure)).To(Equal(
+			"Discovery agent quay.io/ocpmetal/agent:v1.5.0 could not be upgraded to quay.io/ocpmetal/agent:v2.0.0 after 3 attempts"))
+		c.host.DiscoveryAgentVersion = "quay.io/ocpmetal/agent:v2.1.0"
+		Expect(v.isAgentVersionSupported(c)).To(Equal(ValidationSuccess))
+		Expect(v.printAgentVersionSupported(c, ValidationSuccess)).To(Equal("Discovery agent version is supported"))
+	})
+
+	It("upgrade needed", func() {
+		cfg := &AgentVersionConfig{AgentDockerImg: "quay.io/ocpmetal/agent:v2.0.0", MinimumAgentVersion: "v1.2"}
+		Expect(cfg.IsAgentUpgradeNeeded("quay.io/ocpmetal/agent:v2.0.0")).To(BeFalse())
+		Expect(cfg.IsAgentUpgradeNeeded("quay.io/ocpmetal/agent:v1.5.0")).To(BeTrue())
+		Expect(cfg.IsAgentUpgradeNeeded("quay.io/ocpmetal/agent:v1.0.0")).To(BeTrue())
+		Expect(cfg.IsAgentUpgradeNeeded("quay.io/ocpmetal/agent:latest")).To(BeTrue())
+		Expect(cfg.IsAgentUpgradeNeeded("quay.io/ocpmetal/agent:v2.1.0")).To(BeFalse())
+		cfg.MinimumAgentVersion = ""
+		Expect(cfg.IsAgentUpgradeNeeded("quay.io/ocpmetal/agent:latest")).To(BeFalse())
+		Expect(cfg.IsAgentUpgradeNeeded("quay.io/ocpmetal/agent:v1.0.0")).To(BeTrue())
+	})
+
+	It("nil policy", func() {
+		var cfg *AgentVersionConfig
+		Expect(cfg.GetAgentVersionStatus("quay.io/ocpmetal/agent:v1.0.0")).To(Equal(models.AgentVersionStatusCurrent))
+	})
+})
@@ -31,7 +31,8 @@ func NewConnectivityCheckCmd(log logrus.FieldLogger, db *gorm.DB, connectivityVa
 func (c *connectivityCheckCmd) GetStep(ctx context.Context, host *models.Host) (*models.Step, error) {
 
 	var hosts []*models.Host
-	if err := c.db.Find(&hosts, "cluster_id = ?", host.ClusterID).Error; err != nil {
+	// Hosts that didn't report their inventory yet have no known interfaces to check connectivity to
+	if err := c.db.Find(&hosts, "cluster_id = ? and inventory <> ''", host.ClusterID).Error; err != nil {
 		c.log.WithError(err).Errorf("failed to get list of hosts for cluster %s", host.ClusterID)
 		return nil, err
 	}
@@ -0,0 +1,165 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/filanov/bm-inventory/internal/hardware"
+	"github.com/filanov/bm-inventory/models"
+)
+
+const (
+	// Number of times a disk is checked before its failed check is final
+	maxDiskSpeedCheckAttempts = 3
+	// Time after which a check that did not reply is considered lost and the disk is checked again
+	diskSpeedCheckTimeout = 5 * time.Minute
+)
+
+type diskSpeedCheckCmd struct {
+	baseCmd
+	db             *gorm.DB
+	hwValidator    hardware.Validator
+	diskCheckImage string
+}
+
+func NewDiskSpeedCheckCmd(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator, diskCheckImage string) *diskSpeedCheckCmd {
+	return &diskSpeedCheckCmd{
+		baseCmd:        baseCmd{log: log},
+		db:             db,
+		hwValidator:    hwValidator,
+		diskCheckImage: diskCheckImage,
+	}
+}
+
+func getDisksSpeed(disksSpeed string) ([]*models.DiskSpeed, error) {
+	var ret []*models.DiskSpeed
+	if disksSpeed == "" {
+		return ret, nil
+	}
+	if err := json.Unmarshal([]byte(disksSpeed), &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func findDiskSpeed(disksSpeed []*models.DiskSpeed, path string) *models.DiskSpeed {
+	for _, speed := range disksSpeed {
+		if speed.Path == path {
+			return speed
+		}
+	}
+	return nil
+}
+
+// MergeDiskSpeed adds the measurement of a disk to the disks speed of a host, replacing a former measurement of the
+// same disk and counting the attempts to measure it
+func MergeDiskSpeed(disksSpeed string, speed *models.DiskSpeed) (string, error) {
+	speeds, err := getDisksSpeed(disksSpeed)
+	if err != nil {
+		return "", err
+	}
+	speed.Attempts = 1
+	if existing := findDiskSpeed(speeds, speed.Path); existing != nil {
+		speed.Attempts = existing.Attempts + 1
+		*existing = *speed
+	} else {
+		speeds = append(speeds, speed)
+	}
+	b, err := json.Marshal(&speeds)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// DiskSpeedCheckPath returns the disk that a disk speed check step of the host checks, according to the step history
+// of the host. It is used when the reply of the step does not tell the disk.
+func DiskSpeedCheckPath(db *gorm.DB, h *models.Host, stepID string) (string, error) {
+	var hostStep models.HostStep
+	if err := db.First(&hostStep, "host_id = ? and cluster_id = ? and step_id = ?", h.ID.String(), h.ClusterID.String(),
+		stepID).Error; err != nil {
+		return "", err
+	}
+	var args []string
+	if err := json.Unmarshal([]byte(hostStep.Args), &args); err != nil {
+		return "", err
+	}
+	if len(args) == 0 {
+		return "", errors.Errorf("step %s of host %s has no arguments", stepID, h.ID)
+	}
+	var request models.DiskSpeedCheckRequest
+	if err := json.Unmarshal([]byte(args[len(args)-1]), &request); err != nil {
+		return "", err
+	}
+	if request.Path == nil || *request.Path == "" {
+		return "", errors.Errorf("step %s of host %s is missing the disk path", stepID, h.ID)
+	}
+	return *request.Path, nil
+}
+
+// checkInProgress returns whether the previous check of the host may still be running. The checks run in a container
+// with a fixed name, so a new check can't start before the previous one is done.
+func (d *diskSpeedCheckCmd) checkInProgress(host *models.Host) (bool, error) {
+	var last models.HostStep
+	err := d.db.Where("host_id = ? and cluster_id = ? and step_type = ?", host.ID.String(), host.ClusterID.String(),
+		models.StepTypeDiskSpeedCheck).Order("issued_at desc").First(&last).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return time.Time(last.RepliedAt).IsZero() && time.Since(time.Time(last.IssuedAt)) < diskSpeedCheckTimeout, nil
+}
+
+func (d *diskSpeedCheckCmd) GetStep(ctx context.Context, host *models.Host) (*models.Step, error) {
+	// etcd runs only on masters
+	if host.Role != models.HostRoleMaster {
+		return nil, nil
+	}
+	path, err := getBootDevice(d.log, d.hwValidator, *host)
+	if err != nil {
+		// The host can't be installed without an installation disk, which is reported by the validations
+		return nil, nil
+	}
+	speeds, err := getDisksSpeed(host.DisksSpeed)
+	if err != nil {
+		d.log.WithError(err).Warnf("failed to parse disks speed of host %s", host.ID)
+		return nil, err
+	}
+	// The benchmark writes to the disk for a while, so each disk is checked once, or a few times if the check fails
+	if speed := findDiskSpeed(speeds, path); speed != nil &&
+		(speed.ExitCode == 0 || speed.Attempts >= maxDiskSpeedCheckAttempts) {
+		return nil, nil
+	}
+
+	if running, err := d.checkInProgress(host); err != nil || running {
+		return nil, err
+	}
+
+	request, err := json.Marshal(&models.DiskSpeedCheckRequest{Path: &path})
+	if err != nil {
+		d.log.WithError(err).Warn("Json marshal")
+		return nil, err
+	}
+	step := &models.Step{
+		StepType: models.StepTypeDiskSpeedCheck,
+		Command:  "podman",
+		Args: []string{
+			"run", "--privileged", "--net=host", "--rm", "--quiet",
+			"--name", "disk_speed_check",
+			"-v", "/dev:/dev:rw",
+			"-v", "/var/log:/var/log",
+			"-v", "/run/systemd/journal/socket:/run/systemd/journal/socket",
+			d.diskCheckImage,
+			"disk_speed_check",
+			string(request),
+		},
+	}
+	return step, nil
+}
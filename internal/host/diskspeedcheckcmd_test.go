@@ -0,0 +1,197 @@
+package host
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/internal/hardware"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("disk speed check", func() {
+	ctx := context.Background()
+	var host models.Host
+	var ctrl *gomock.Controller
+	var mockValidator *hardware.MockValidator
+	var dCmd *diskSpeedCheckCmd
+	var db *gorm.DB
+	dbName := "disk_speed_check_cmd"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		db = common.PrepareTestDB(dbName)
+		mockValidator = hardware.NewMockValidator(ctrl)
+		dCmd = NewDiskSpeedCheckCmd(getTestLog(), db, mockValidator, "quay.io/ocpmetal/disk_speed_check:latest")
+		host = getTestHost(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()), HostStatusKnown)
+		host.Role = models.HostRoleMaster
+		host.DisksSpeed = ""
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	recordCheck := func(issuedAt, repliedAt time.Time) {
+		Expect(db.Create(&models.HostStep{
+			StepID:    swag.String("disk-speed-check-1"),
+			HostID:    host.ID,
+			ClusterID: &host.ClusterID,
+			StepType:  models.StepTypeDiskSpeedCheck,
+			IssuedAt:  strfmt.DateTime(issuedAt),
+			RepliedAt: strfmt.DateTime(repliedAt),
+		}).Error).ShouldNot(HaveOccurred())
+	}
+
+	It("happy flow", func() {
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(&models.Disk{Name: "sdb"}, nil).Times(1)
+		stepReply, stepErr := dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply.StepType).To(Equal(models.StepTypeDiskSpeedCheck))
+		Expect(stepReply.Args[len(stepReply.Args)-1]).To(Equal(`{"path":"/dev/sdb"}`))
+	})
+
+	It("disk already checked", func() {
+		host.DisksSpeed = disksSpeed("/dev/sdb", 0, 1)
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(&models.Disk{Name: "sdb"}, nil).Times(1)
+		stepReply, stepErr := dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("failed check is retried", func() {
+		host.DisksSpeed = `[{"path":"/dev/sdb","exit_code":124,"attempts":1}]`
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(&models.Disk{Name: "sdb"}, nil).Times(1)
+		stepReply, stepErr := dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).ToNot(BeNil())
+	})
+
+	It("failed check attempts exhausted", func() {
+		host.DisksSpeed = fmt.Sprintf(`[{"path":"/dev/sdb","exit_code":124,"attempts":%d}]`, maxDiskSpeedCheckAttempts)
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(&models.Disk{Name: "sdb"}, nil).Times(1)
+		stepReply, stepErr := dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("previous check is running", func() {
+		recordCheck(time.Now().Add(-time.Minute), time.Time{})
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(&models.Disk{Name: "sdb"}, nil).Times(1)
+		stepReply, stepErr := dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("previous check did not reply in time", func() {
+		recordCheck(time.Now().Add(-diskSpeedCheckTimeout-time.Minute), time.Time{})
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(&models.Disk{Name: "sdb"}, nil).Times(1)
+		stepReply, stepErr := dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).ToNot(BeNil())
+	})
+
+	It("failed check replied", func() {
+		host.DisksSpeed = `[{"path":"/dev/sdb","exit_code":124,"attempts":1}]`
+		recordCheck(time.Now().Add(-2*time.Minute), time.Now().Add(-time.Minute))
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(&models.Disk{Name: "sdb"}, nil).Times(1)
+		stepReply, stepErr := dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).ToNot(BeNil())
+	})
+
+	It("previous check of another host is running", func() {
+		hostID := *host.ID
+		otherHostID := strfmt.UUID(uuid.New().String())
+		host.ID = &otherHostID
+		recordCheck(time.Now().Add(-time.Minute), time.Time{})
+		host.ID = &hostID
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(&models.Disk{Name: "sdb"}, nil).Times(1)
+		stepReply, stepErr := dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).ToNot(BeNil())
+	})
+
+	It("installation disk changed", func() {
+		host.DisksSpeed = disksSpeed("/dev/sda", 2, 0)
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(&models.Disk{Name: "sdb"}, nil).Times(1)
+		stepReply, stepErr := dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).ToNot(BeNil())
+	})
+
+	It("no installation disk", func() {
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(nil, errors.New("no valid disks")).Times(1)
+		stepReply, stepErr := dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("worker", func() {
+		host.Role = models.HostRoleWorker
+		stepReply, stepErr := dCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+})
+
+var _ = Describe("merge disk speed", func() {
+	It("add and replace", func() {
+		speeds, err := MergeDiskSpeed("", &models.DiskSpeed{Path: "/dev/sda", IoSyncDuration: 20})
+		Expect(err).ShouldNot(HaveOccurred())
+		speeds, err = MergeDiskSpeed(speeds, &models.DiskSpeed{Path: "/dev/sdb", ExitCode: 1})
+		Expect(err).ShouldNot(HaveOccurred())
+		speeds, err = MergeDiskSpeed(speeds, &models.DiskSpeed{Path: "/dev/sda", IoSyncDuration: 3})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(speeds).To(Equal(`[{"attempts":2,"io_sync_duration":3,"path":"/dev/sda"},{"attempts":1,"exit_code":1,"path":"/dev/sdb"}]`))
+	})
+
+	It("invalid disks speed", func() {
+		_, err := MergeDiskSpeed("blah", &models.DiskSpeed{Path: "/dev/sda"})
+		Expect(err).Should(HaveOccurred())
+	})
+})
+
+var _ = Describe("disk speed check path", func() {
+	var (
+		db     *gorm.DB
+		dbName = "disk_speed_check_path"
+		host   models.Host
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		host = getTestHost(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()), HostStatusKnown)
+		Expect(RecordSteps(db, &host, []*models.Step{{
+			StepID:   "disk-speed-check-1",
+			StepType: models.StepTypeDiskSpeedCheck,
+			Command:  "podman",
+			Args:     []string{"run", "disk_speed_check", `{"path":"/dev/sdb"}`},
+		}})).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("path of the step", func() {
+		path, err := DiskSpeedCheckPath(db, &host, "disk-speed-check-1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(path).To(Equal("/dev/sdb"))
+	})
+
+	It("unknown step", func() {
+		_, err := DiskSpeedCheckPath(db, &host, "disk-speed-check-2")
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,97 @@
+package host
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/thoas/go-funk"
+)
+
+// Serial numbers that firmwares report when the real one is not set, they do not identify a machine
+var placeholderSerials = []string{"", "0", "none", "unknown", "not specified", "not available", "default string",
+	"to be filled by o.e.m.", "system serial number", "0123456789"}
+
+// Types of the disks that a host can be installed on
+var installationDiskTypes = []string{"HDD", "SSD"}
+
+// Fingerprint identifies the physical machine of a host, independently of the id its agent registered with
+type Fingerprint struct {
+	SystemSerial string
+	MacAddresses []string
+	// Serials of the disks that the host can be installed on
+	DiskSerials []string
+}
+
+func normalizeSerial(serial string) string {
+	serial = strings.TrimSpace(serial)
+	if funk.ContainsString(placeholderSerials, strings.ToLower(serial)) {
+		return ""
+	}
+	return serial
+}
+
+// normalizeMac returns the MAC address in lower case, or an empty string when the address does not identify an
+// interface: it is unset, all zeros or multicast. Locally administered addresses are kept, virtual machines have only
+// such addresses.
+func normalizeMac(mac string) string {
+	hw, err := net.ParseMAC(strings.TrimSpace(mac))
+	if err != nil || len(hw) == 0 || hw[0]&0x01 != 0 {
+		return ""
+	}
+	for _, b := range hw {
+		if b != 0 {
+			return hw.String()
+		}
+	}
+	return ""
+}
+
+// NewFingerprint returns the fingerprint of the machine described by the inventory of a host
+func NewFingerprint(inventory string) (*Fingerprint, error) {
+	var inv models.Inventory
+	if err := json.Unmarshal([]byte(inventory), &inv); err != nil {
+		return nil, err
+	}
+	f := &Fingerprint{MacAddresses: make([]string, 0), DiskSerials: make([]string, 0)}
+	if inv.SystemVendor != nil {
+		f.SystemSerial = normalizeSerial(inv.SystemVendor.SerialNumber)
+	}
+	for _, intf := range inv.Interfaces {
+		if mac := normalizeMac(intf.MacAddress); mac != "" && !funk.ContainsString(f.MacAddresses, mac) {
+			f.MacAddresses = append(f.MacAddresses, mac)
+		}
+	}
+	for _, disk := range inv.Disks {
+		if !funk.ContainsString(installationDiskTypes, disk.DriveType) {
+			continue
+		}
+		if serial := normalizeSerial(disk.Serial); serial != "" && !funk.ContainsString(f.DiskSerials, serial) {
+			f.DiskSerials = append(f.DiskSerials, serial)
+		}
+	}
+	sort.Strings(f.MacAddresses)
+	sort.Strings(f.DiskSerials)
+	return f, nil
+}
+
+// CanMatch returns whether the inventory has a system serial and a MAC address or an installation disk serial,
+// without them the machine is not identified well enough to be matched
+func (f *Fingerprint) CanMatch() bool {
+	return f.SystemSerial != "" && (len(f.MacAddresses) > 0 || len(f.DiskSerials) > 0)
+}
+
+// Matches returns whether both fingerprints belong to the same machine: they have the same system serial and share a
+// MAC address or an installation disk. Locally administered MAC addresses may repeat on other machines, so when both
+// machines report installation disks they have to share one of them.
+func (f *Fingerprint) Matches(other *Fingerprint) bool {
+	if !f.CanMatch() || !other.CanMatch() || f.SystemSerial != other.SystemSerial {
+		return false
+	}
+	if len(f.DiskSerials) > 0 && len(other.DiskSerials) > 0 {
+		return len(funk.IntersectString(f.DiskSerials, other.DiskSerials)) > 0
+	}
+	return len(funk.IntersectString(f.MacAddresses, other.MacAddresses)) > 0
+}
@@ -0,0 +1,96 @@
+package host
+
+import (
+	"encoding/json"
+
+	"github.com/filanov/bm-inventory/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("host fingerprint", func() {
+	makeFingerprint := func(serial string, macs ...string) *Fingerprint {
+		inventory := models.Inventory{SystemVendor: &models.SystemVendor{SerialNumber: serial}}
+		for _, mac := range macs {
+			inventory.Interfaces = append(inventory.Interfaces, &models.Interface{MacAddress: mac})
+		}
+		b, err := json.Marshal(&inventory)
+		ExpectWithOffset(1, err).ShouldNot(HaveOccurred())
+		f, err := NewFingerprint(string(b))
+		ExpectWithOffset(1, err).ShouldNot(HaveOccurred())
+		return f
+	}
+
+	It("normalized", func() {
+		f := makeFingerprint(" SN1 ", "F8:F2:1E:AA:BB:02", "f8:f2:1e:aa:bb:01", "f8:f2:1e:aa:bb:02", "")
+		Expect(f.SystemSerial).To(Equal("SN1"))
+		Expect(f.MacAddresses).To(Equal([]string{"f8:f2:1e:aa:bb:01", "f8:f2:1e:aa:bb:02"}))
+		Expect(f.CanMatch()).To(BeTrue())
+	})
+
+	It("zero and multicast MAC addresses", func() {
+		f := makeFingerprint("SN1", "00:00:00:00:00:00", "01:00:5e:00:00:01", "not a mac")
+		Expect(f.MacAddresses).To(BeEmpty())
+		Expect(f.CanMatch()).To(BeFalse())
+		Expect(f.Matches(makeFingerprint("SN1", "01:00:5e:00:00:01"))).To(BeFalse())
+	})
+
+	It("locally administered MAC addresses", func() {
+		f := makeFingerprint("SN1", "52:54:00:aa:bb:01", "02:42:ac:11:00:02")
+		Expect(f.MacAddresses).To(Equal([]string{"02:42:ac:11:00:02", "52:54:00:aa:bb:01"}))
+		Expect(f.CanMatch()).To(BeTrue())
+		Expect(f.Matches(makeFingerprint("SN1", "52:54:00:aa:bb:01"))).To(BeTrue())
+	})
+
+	It("placeholder serials", func() {
+		f := makeFingerprint("To Be Filled By O.E.M.", "f8:f2:1e:aa:bb:01")
+		Expect(f.SystemSerial).To(BeEmpty())
+		Expect(f.CanMatch()).To(BeFalse())
+		Expect(f.Matches(makeFingerprint("Default string", "f8:f2:1e:aa:bb:01"))).To(BeFalse())
+	})
+
+	It("matches", func() {
+		f := makeFingerprint("SN1", "f8:f2:1e:aa:bb:01", "f8:f2:1e:aa:bb:02")
+		Expect(f.Matches(makeFingerprint("SN1", "F8:F2:1E:AA:BB:02"))).To(BeTrue())
+		Expect(f.Matches(makeFingerprint("SN1"))).To(BeFalse())
+		Expect(f.Matches(makeFingerprint("SN2", "f8:f2:1e:aa:bb:01"))).To(BeFalse())
+		Expect(f.Matches(makeFingerprint("SN1", "f8:f2:1e:aa:bb:03"))).To(BeFalse())
+	})
+
+	makeFingerprintWithDisks := func(serial string, mac string, disks ...*models.Disk) *Fingerprint {
+		b, err := json.Marshal(&models.Inventory{
+			SystemVendor: &models.SystemVendor{SerialNumber: serial},
+			Interfaces:   []*models.Interface{{MacAddress: mac}},
+			Disks:        disks,
+		})
+		ExpectWithOffset(1, err).ShouldNot(HaveOccurred())
+		f, err := NewFingerprint(string(b))
+		ExpectWithOffset(1, err).ShouldNot(HaveOccurred())
+		return f
+	}
+
+	It("installation disk serials", func() {
+		f := makeFingerprintWithDisks("SN1", "", &models.Disk{DriveType: "SSD", Serial: " DISK2 "},
+			&models.Disk{DriveType: "HDD", Serial: "DISK1"}, &models.Disk{DriveType: "ODD", Serial: "CDROM1"},
+			&models.Disk{DriveType: "HDD", Serial: "0"}, &models.Disk{DriveType: "HDD", Serial: "DISK1"})
+		Expect(f.DiskSerials).To(Equal([]string{"DISK1", "DISK2"}))
+		Expect(f.MacAddresses).To(BeEmpty())
+		Expect(f.CanMatch()).To(BeTrue())
+	})
+
+	It("matches by installation disk", func() {
+		f := makeFingerprintWithDisks("SN1", "02:42:ac:11:00:02", &models.Disk{DriveType: "SSD", Serial: "DISK1"})
+		Expect(f.Matches(makeFingerprintWithDisks("SN1", "", &models.Disk{DriveType: "SSD", Serial: "DISK1"}))).To(BeTrue())
+		Expect(f.Matches(makeFingerprintWithDisks("SN2", "", &models.Disk{DriveType: "SSD", Serial: "DISK1"}))).To(BeFalse())
+		// The same locally administered MAC address on a machine with other disks
+		Expect(f.Matches(makeFingerprintWithDisks("SN1", "02:42:ac:11:00:02",
+			&models.Disk{DriveType: "SSD", Serial: "DISK2"}))).To(BeFalse())
+		// A machine that does not report its disks is matched by MAC address
+		Expect(f.Matches(makeFingerprintWithDisks("SN1", "02:42:ac:11:00:02"))).To(BeTrue())
+	})
+
+	It("invalid inventory", func() {
+		_, err := NewFingerprint("not json")
+		Expect(err).Should(HaveOccurred())
+	})
+})
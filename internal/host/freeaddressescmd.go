@@ -23,6 +23,18 @@ func NewFreeAddressesCmd(log logrus.FieldLogger, freeAddressesImage string) *fre
 	}
 }
 
+// Largest IPv6 network, in host bits, whose addresses are scanned. The IPv6 subnets are usually /64 and cannot be
+// scanned address by address.
+const maxScannedIPv6HostBits = 16
+
+func isScannableNetwork(ip net.IP, cidr *net.IPNet) bool {
+	if ip.To4() != nil {
+		return true
+	}
+	ones, bits := cidr.Mask.Size()
+	return !ip.IsLinkLocalUnicast() && bits-ones <= maxScannedIPv6HostBits
+}
+
 func (f *freeAddressesCmd) prepareParam(host *models.Host) (string, error) {
 	var inventory models.Inventory
 	err := json.Unmarshal([]byte(host.Inventory), &inventory)
@@ -32,13 +44,19 @@ func (f *freeAddressesCmd) prepareParam(host *models.Host) (string, error) {
 	}
 	m := make(map[string]struct{})
 	for _, intf := range inventory.Interfaces {
-		for _, ipv4 := range intf.IPV4Addresses {
-			var cidr *net.IPNet
-			_, cidr, err = net.ParseCIDR(ipv4)
+		for _, addr := range append(intf.IPV4Addresses, intf.IPV6Addresses...) {
+			var (
+				ip   net.IP
+				cidr *net.IPNet
+			)
+			ip, cidr, err = net.ParseCIDR(addr)
 			if err != nil {
 				f.log.WithError(err).Warn("Cidr parse")
 				return "", err
 			}
+			if !isScannableNetwork(ip, cidr) {
+				continue
+			}
 			m[cidr.String()] = struct{}{}
 		}
 	}
@@ -2,6 +2,7 @@ package host
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/filanov/bm-inventory/internal/common"
 
@@ -41,6 +42,23 @@ var _ = Describe("inventory", func() {
 		Expect(stepErr).ShouldNot(HaveOccurred())
 	})
 
+	It("IPv6 networks", func() {
+		inventory := models.Inventory{Interfaces: []*models.Interface{
+			{
+				IPV4Addresses: []string{"1.2.3.4/24"},
+				IPV6Addresses: []string{"fe80::5054:ff:fe12:3456/64", "fd00:1::4/64", "fd00:2::4/120"},
+			},
+		}}
+		b, err := json.Marshal(&inventory)
+		Expect(err).ShouldNot(HaveOccurred())
+		host.Inventory = string(b)
+		stepReply, stepErr = fCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		var request models.FreeAddressesRequest
+		Expect(json.Unmarshal([]byte(stepReply.Args[len(stepReply.Args)-1]), &request)).ShouldNot(HaveOccurred())
+		Expect(request).To(ConsistOf("1.2.3.0/24", "fd00:2::/120"))
+	})
+
 	It("Illegal inventory", func() {
 		host.Inventory = "blah"
 		stepReply, stepErr = fCmd.GetStep(ctx, &host)
@@ -2,9 +2,11 @@ package host
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/filanov/bm-inventory/internal/common"
@@ -15,6 +17,7 @@ import (
 	logutil "github.com/filanov/bm-inventory/pkg/log"
 	"github.com/filanov/stateswitch"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -74,6 +77,13 @@ type API interface {
 	HostMonitoring()
 	UpdateRole(ctx context.Context, h *models.Host, role models.HostRole, db *gorm.DB) error
 	UpdateHostname(ctx context.Context, h *models.Host, hostname string, db *gorm.DB) error
+	// Select the installation disk by its serial, WWN or by-path identifier, an empty id clears the selection
+	UpdateInstallationDisk(ctx context.Context, h *models.Host, installationDiskID string, db *gorm.DB) error
+	// Replace the user-defined labels of the host
+	UpdateLabels(ctx context.Context, h *models.Host, labels map[string]string, db *gorm.DB) error
+	UpdateNotes(ctx context.Context, h *models.Host, notes string, db *gorm.DB) error
+	// Move a host that was not installed yet to another cluster, together with its inventory and events
+	RebindHost(ctx context.Context, h *models.Host, newClusterID strfmt.UUID, db *gorm.DB) error
 	CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
 	IsRequireUserActionReset(h *models.Host) bool
 	ResetHost(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
@@ -103,7 +113,7 @@ type Manager struct {
 }
 
 func NewManager(log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handler, hwValidator hardware.Validator, instructionApi InstructionApi,
-	hwValidatorCfg *hardware.ValidatorCfg, metricApi metrics.API) *Manager {
+	hwValidatorCfg *hardware.ValidatorCfg, agentVersionCfg *AgentVersionConfig, metricApi metrics.API) *Manager {
 	th := &transitionHandler{
 		db:            db,
 		log:           log,
@@ -116,7 +126,7 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, eventsHandler events.Handle
 		hwValidator:    hwValidator,
 		eventsHandler:  eventsHandler,
 		sm:             NewHostStateMachine(th),
-		rp:             newRefreshPreprocessor(log, hwValidatorCfg),
+		rp:             newRefreshPreprocessor(log, hwValidatorCfg, agentVersionCfg),
 		metricApi:      metricApi,
 	}
 }
@@ -276,11 +286,14 @@ func (m *Manager) SetBootstrap(ctx context.Context, h *models.Host, isbootstrap
 }
 
 func (m *Manager) UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error {
+	// The report timestamp is always refreshed, even if the report itself didn't change, so that the
+	// connectivity validation is able to tell an up to date report from a stale one
+	updates := map[string]interface{}{"connectivity_updated_at": strfmt.DateTime(time.Now())}
 	if h.Connectivity != connectivityReport {
-		err := m.db.Model(h).Update("connectivity", connectivityReport).Error
-		if err != nil {
-			return errors.Wrapf(err, "failed to set connectivity to host %s", h.ID.String())
-		}
+		updates["connectivity"] = connectivityReport
+	}
+	if err := m.db.Model(h).Updates(updates).Error; err != nil {
+		return errors.Wrapf(err, "failed to set connectivity to host %s", h.ID.String())
 	}
 	return nil
 }
@@ -320,6 +333,119 @@ func (m *Manager) UpdateHostname(ctx context.Context, h *models.Host, hostname s
 	return cdb.Model(h).Update("requested_hostname", hostname).Error
 }
 
+func (m *Manager) UpdateInstallationDisk(ctx context.Context, h *models.Host, installationDiskID string, db *gorm.DB) error {
+	hostStatus := swag.StringValue(h.Status)
+	allowedStatuses := []string{HostStatusDiscovering, HostStatusKnown, HostStatusDisconnected, HostStatusInsufficient,
+		HostStatusPendingForInput}
+	if !funk.ContainsString(allowedStatuses, hostStatus) {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Host is in %s state, installation disk can be set only in one of %s states",
+				hostStatus, allowedStatuses))
+	}
+
+	if installationDiskID != "" {
+		if h.Inventory == "" {
+			return common.NewApiError(http.StatusBadRequest,
+				errors.Errorf("Host %s did not report its inventory yet", common.GetHostnameForMsg(h)))
+		}
+		disks, err := m.hwValidator.GetHostValidDisks(h)
+		if err != nil || hardware.FindDisk(disks, installationDiskID) == nil {
+			return common.NewApiError(http.StatusBadRequest,
+				errors.Errorf("Disk %s is not one of the valid installation disks of host %s",
+					installationDiskID, common.GetHostnameForMsg(h)))
+		}
+	}
+
+	h.InstallationDiskID = installationDiskID
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+	return cdb.Model(h).Update("installation_disk_id", installationDiskID).Error
+}
+
+func (m *Manager) UpdateLabels(ctx context.Context, h *models.Host, labels map[string]string, db *gorm.DB) error {
+	if err := ValidateLabels(labels); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	b, err := json.Marshal(labels)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	h.Labels = string(b)
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+	return cdb.Model(h).Update("labels", h.Labels).Error
+}
+
+func (m *Manager) UpdateNotes(ctx context.Context, h *models.Host, notes string, db *gorm.DB) error {
+	if len(notes) > maxNotesLength {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Host notes are limited to %d characters", maxNotesLength))
+	}
+
+	h.Notes = notes
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+	return cdb.Model(h).Update("notes", notes).Error
+}
+
+func (m *Manager) RebindHost(ctx context.Context, h *models.Host, newClusterID strfmt.UUID, db *gorm.DB) error {
+	hostStatus := swag.StringValue(h.Status)
+	allowedStatuses := []string{HostStatusDiscovering, HostStatusKnown, HostStatusDisconnected, HostStatusInsufficient,
+		HostStatusPendingForInput}
+	if !funk.ContainsString(allowedStatuses, hostStatus) {
+		return common.NewApiError(http.StatusConflict,
+			errors.Errorf("Host is in %s state, host can be moved to another cluster only in one of %s states",
+				hostStatus, allowedStatuses))
+	}
+
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+	var existing models.Host
+	err := cdb.First(&existing, "id = ? and cluster_id = ?", h.ID.String(), newClusterID.String()).Error
+	if err == nil {
+		return common.NewApiError(http.StatusConflict,
+			errors.Errorf("Host %s is already registered to cluster %s", h.ID.String(), newClusterID.String()))
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	oldClusterID := h.ClusterID
+	// The connectivity report and the bootstrap selection refer to the hosts of the previous cluster
+	updates := map[string]interface{}{
+		"cluster_id":          newClusterID,
+		"previous_cluster_id": oldClusterID,
+		"href":                strings.Replace(swag.StringValue(h.Href), oldClusterID.String(), newClusterID.String(), 1),
+		"bootstrap":           false,
+		"connectivity":        "",
+	}
+	if err = cdb.Model(&models.Host{}).Where("id = ? and cluster_id = ?", h.ID.String(), oldClusterID.String()).
+		Updates(updates).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = events.MoveRelatedEvents(cdb, h.ID.String(), oldClusterID.String(), newClusterID.String()); err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	// The debug steps and the step history of the host move with it
+	for _, model := range []interface{}{&models.DebugStepResult{}, &models.HostStep{}} {
+		if err = cdb.Model(model).Where("host_id = ? and cluster_id = ?", h.ID.String(), oldClusterID.String()).
+			Update("cluster_id", newClusterID).Error; err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	return cdb.First(h, "id = ? and cluster_id = ?", h.ID.String(), newClusterID.String()).Error
+}
+
 func (m *Manager) CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse {
 	eventSeverity := models.EventSeverityInfo
 	eventInfo := fmt.Sprintf("Installation canceled for host %s", common.GetHostnameForMsg(h))
@@ -7,10 +7,12 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/filanov/bm-inventory/internal/common"
 	"github.com/filanov/bm-inventory/internal/events"
+	"github.com/filanov/bm-inventory/internal/hardware"
 	"github.com/filanov/bm-inventory/internal/metrics"
 	"github.com/filanov/bm-inventory/models"
 
@@ -41,7 +43,7 @@ var _ = Describe("update_role", func() {
 
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
-		state = NewManager(getTestLog(), db, nil, nil, nil, createValidatorCfg(), nil)
+		state = NewManager(getTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, nil)
 		id = strfmt.UUID(uuid.New().String())
 		clusterID = strfmt.UUID(uuid.New().String())
 	})
@@ -181,7 +183,7 @@ var _ = Describe("update_progress", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
-		state = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric)
+		state = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, mockMetric)
 		id := strfmt.UUID(uuid.New().String())
 		clusterId := strfmt.UUID(uuid.New().String())
 		host = getTestHost(id, clusterId, "")
@@ -368,7 +370,7 @@ var _ = Describe("monitor_disconnection", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
-		state = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil)
+		state = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, nil)
 		clusterID := strfmt.UUID(uuid.New().String())
 		host = getTestHost(strfmt.UUID(uuid.New().String()), clusterID, HostStatusDiscovering)
 		cluster := getTestCluster(clusterID, "1.1.0.0/16")
@@ -437,6 +439,51 @@ var _ = Describe("monitor_disconnection", func() {
 	})
 })
 
+var _ = Describe("monitor_debug_steps", func() {
+	var (
+		db     *gorm.DB
+		state  API
+		dbName = "monitor_debug_steps"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		state = NewManager(getTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, nil)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createDebugStep := func(stepID string, startedAt time.Time) {
+		hostID := strfmt.UUID(uuid.New().String())
+		clusterID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.DebugStepResult{
+			StepID:    swag.String(stepID),
+			HostID:    &hostID,
+			ClusterID: &clusterID,
+			Command:   swag.String("echo hello"),
+			Timeout:   10,
+			Status:    swag.String(models.DebugStepResultStatusRunning),
+			StartedAt: strfmt.DateTime(startedAt),
+		}).Error).ShouldNot(HaveOccurred())
+	}
+
+	getStatus := func(stepID string) string {
+		var debugStep models.DebugStepResult
+		Expect(db.First(&debugStep, "step_id = ?", stepID).Error).ShouldNot(HaveOccurred())
+		return swag.StringValue(debugStep.Status)
+	}
+
+	It("lost reply times out", func() {
+		createDebugStep("lost", time.Now().Add(-10*time.Second-debugStepReplyGracePeriod-time.Second))
+		createDebugStep("running", time.Now())
+		state.HostMonitoring()
+		Expect(getStatus("lost")).Should(Equal(models.DebugStepResultStatusTimedOut))
+		Expect(getStatus("running")).Should(Equal(models.DebugStepResultStatusRunning))
+	})
+})
+
 var _ = Describe("cancel_installation", func() {
 	var (
 		ctx           = context.Background()
@@ -450,7 +497,7 @@ var _ = Describe("cancel_installation", func() {
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		eventsHandler = events.New(db, logrus.New())
-		state = NewManager(getTestLog(), db, eventsHandler, nil, nil, nil, nil)
+		state = NewManager(getTestLog(), db, eventsHandler, nil, nil, nil, nil, nil)
 		id := strfmt.UUID(uuid.New().String())
 		clusterId := strfmt.UUID(uuid.New().String())
 		h = getTestHost(id, clusterId, HostStatusDiscovering)
@@ -518,7 +565,7 @@ var _ = Describe("reset_host", func() {
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		eventsHandler = events.New(db, logrus.New())
-		state = NewManager(getTestLog(), db, eventsHandler, nil, nil, nil, nil)
+		state = NewManager(getTestLog(), db, eventsHandler, nil, nil, nil, nil, nil)
 	})
 	AfterEach(func() {
 		common.DeleteTestDB(db, dbName)
@@ -584,15 +631,34 @@ func getTestLog() logrus.FieldLogger {
 
 func getTestHost(hostID, clusterID strfmt.UUID, state string) models.Host {
 	return models.Host{
-		ID:          &hostID,
-		ClusterID:   clusterID,
-		Status:      swag.String(state),
-		Inventory:   defaultInventory(),
-		Role:        models.HostRoleWorker,
-		CheckedInAt: strfmt.DateTime(time.Now()),
+		ID:           &hostID,
+		ClusterID:    clusterID,
+		Status:       swag.String(state),
+		Inventory:    defaultInventory(),
+		Role:         models.HostRoleWorker,
+		CheckedInAt:  strfmt.DateTime(time.Now()),
+		ImagesStatus: imagesStatus(models.ContainerImageAvailabilityResultSuccess),
+		DisksSpeed:   disksSpeed("/dev/sda", 2, 0),
 	}
 }
 
+func disksSpeed(path string, ioSyncDuration, exitCode int64) string {
+	speeds := []*models.DiskSpeed{{Path: path, IoSyncDuration: ioSyncDuration, ExitCode: exitCode}}
+	b, err := json.Marshal(&speeds)
+	Expect(err).ShouldNot(HaveOccurred())
+	return string(b)
+}
+
+func imagesStatus(result models.ContainerImageAvailabilityResult) string {
+	images := []*models.ContainerImageAvailability{
+		{Name: "quay.io/openshift-release-dev/ocp-release:4.5.0-x86_64", Result: result},
+		{Name: "quay.io/ocpmetal/assisted-installer:latest", Result: models.ContainerImageAvailabilityResultSuccess},
+	}
+	b, err := json.Marshal(&images)
+	Expect(err).ShouldNot(HaveOccurred())
+	return string(b)
+}
+
 func getTestCluster(clusterID strfmt.UUID, machineNetworkCidr string) common.Cluster {
 	return common.Cluster{
 		Cluster: models.Cluster{
@@ -659,7 +725,7 @@ func workerInventory() string {
 				},
 			},
 		},
-		Memory: &models.Memory{PhysicalBytes: gibToBytes(8)},
+		Memory: &models.Memory{PhysicalBytes: hardware.GibToBytes(8)},
 	}
 	b, err := json.Marshal(&inventory)
 	Expect(err).To(Not(HaveOccurred()))
@@ -675,8 +741,9 @@ func masterInventoryWithHostname(hostname string) string {
 		CPU: &models.CPU{Count: 8},
 		Disks: []*models.Disk{
 			{
+				Name:      "sda",
 				SizeBytes: 128849018880,
-				DriveType: "HDD",
+				DriveType: "SSD",
 			},
 		},
 		Interfaces: []*models.Interface{
@@ -687,7 +754,7 @@ func masterInventoryWithHostname(hostname string) string {
 				},
 			},
 		},
-		Memory:   &models.Memory{PhysicalBytes: gibToBytes(16)},
+		Memory:   &models.Memory{PhysicalBytes: hardware.GibToBytes(16)},
 		Hostname: hostname,
 	}
 	b, err := json.Marshal(&inventory)
@@ -695,6 +762,24 @@ func masterInventoryWithHostname(hostname string) string {
 	return string(b)
 }
 
+func connectivityReport(successful bool, remoteHostIDs ...strfmt.UUID) string {
+	report := models.ConnectivityReport{}
+	for _, id := range remoteHostIDs {
+		report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+			HostID: id,
+			L2Connectivity: []*models.L2Connectivity{
+				{OutgoingNic: "eth0", RemoteIPAddress: "1.2.3.5", Successful: successful},
+			},
+			L3Connectivity: []*models.L3Connectivity{
+				{OutgoingNic: "eth0", RemoteIPAddress: "1.2.3.5", Successful: successful},
+			},
+		})
+	}
+	b, err := json.Marshal(&report)
+	Expect(err).To(Not(HaveOccurred()))
+	return string(b)
+}
+
 var _ = Describe("UpdateInventory", func() {
 	var (
 		ctx               = context.Background()
@@ -707,7 +792,7 @@ var _ = Describe("UpdateInventory", func() {
 
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
-		hapi = NewManager(getTestLog(), db, nil, nil, nil, createValidatorCfg(), nil)
+		hapi = NewManager(getTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -822,7 +907,7 @@ var _ = Describe("Update hostname", func() {
 
 	BeforeEach(func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
-		hapi = NewManager(getTestLog(), db, nil, nil, nil, createValidatorCfg(), nil)
+		hapi = NewManager(getTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -922,6 +1007,226 @@ var _ = Describe("Update hostname", func() {
 	})
 })
 
+var _ = Describe("Update installation disk", func() {
+	var (
+		ctx               = context.Background()
+		hapi              API
+		db                *gorm.DB
+		hostId, clusterId strfmt.UUID
+		host              models.Host
+		dbName            = "update_installation_disk"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName, &events.Event{})
+		hwValidator := hardware.NewValidator(getTestLog(), *createValidatorCfg())
+		hapi = NewManager(getTestLog(), db, nil, hwValidator, nil, createValidatorCfg(), nil, nil)
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		host = getTestHost(hostId, clusterId, models.HostStatusKnown)
+		inventory := models.Inventory{
+			Disks: []*models.Disk{
+				{Name: "sda", DriveType: "SSD", SizeBytes: 128849018880, Serial: "serial-a", Wwn: "0x5000a"},
+				{Name: "sdb", DriveType: "SSD", SizeBytes: 1, Serial: "serial-b"},
+			},
+		}
+		b, err := json.Marshal(&inventory)
+		Expect(err).ShouldNot(HaveOccurred())
+		host.Inventory = string(b)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("select valid disk", func() {
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		Expect(hapi.UpdateInstallationDisk(ctx, &host, "0x5000a", db)).ShouldNot(HaveOccurred())
+		Expect(getHost(hostId, clusterId, db).InstallationDiskID).To(Equal("0x5000a"))
+	})
+
+	It("clear selection", func() {
+		host.InstallationDiskID = "serial-a"
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		Expect(hapi.UpdateInstallationDisk(ctx, &host, "", db)).ShouldNot(HaveOccurred())
+		Expect(getHost(hostId, clusterId, db).InstallationDiskID).To(Equal(""))
+	})
+
+	It("disk is too small", func() {
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		err := hapi.UpdateInstallationDisk(ctx, &host, "serial-b", db)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+		Expect(getHost(hostId, clusterId, db).InstallationDiskID).To(Equal(""))
+	})
+
+	It("disk does not exist", func() {
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		err := hapi.UpdateInstallationDisk(ctx, &host, "serial-c", db)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+
+	It("no inventory", func() {
+		host.Inventory = ""
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		err := hapi.UpdateInstallationDisk(ctx, &host, "serial-a", db)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+
+	It("wrong state", func() {
+		host.Status = swag.String(models.HostStatusInstalling)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		err := hapi.UpdateInstallationDisk(ctx, &host, "serial-a", db)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+})
+
+var _ = Describe("Update labels and notes", func() {
+	var (
+		ctx               = context.Background()
+		hapi              API
+		db                *gorm.DB
+		hostId, clusterId strfmt.UUID
+		host              models.Host
+		dbName            = "update_labels_and_notes"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName, &events.Event{})
+		hapi = NewManager(getTestLog(), db, nil, nil, nil, createValidatorCfg(), nil, nil)
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		host = getTestHost(hostId, clusterId, models.HostStatusInstalled)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("set labels", func() {
+		Expect(hapi.UpdateLabels(ctx, &host, map[string]string{"rack": "r1", "gpu": ""}, db)).ShouldNot(HaveOccurred())
+		labels, err := GetLabels(getHost(hostId, clusterId, db))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{"rack": "r1", "gpu": ""}))
+	})
+
+	It("invalid label", func() {
+		err := hapi.UpdateLabels(ctx, &host, map[string]string{"rack": "r 1"}, db)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+		Expect(getHost(hostId, clusterId, db).Labels).To(Equal(""))
+	})
+
+	It("set notes", func() {
+		Expect(hapi.UpdateNotes(ctx, &host, "replace the PSU", db)).ShouldNot(HaveOccurred())
+		Expect(getHost(hostId, clusterId, db).Notes).To(Equal("replace the PSU"))
+	})
+
+	It("notes too long", func() {
+		err := hapi.UpdateNotes(ctx, &host, strings.Repeat("a", maxNotesLength+1), db)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+})
+
+var _ = Describe("RebindHost", func() {
+	var (
+		ctx               = context.Background()
+		hapi              API
+		db                *gorm.DB
+		hostId, clusterId strfmt.UUID
+		newClusterId      strfmt.UUID
+		host              models.Host
+		dbName            = "rebind_host"
+		theEvents         *events.Events
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName, &events.Event{})
+		theEvents = events.New(db, getTestLog())
+		hapi = NewManager(getTestLog(), db, theEvents, nil, nil, createValidatorCfg(), nil, nil)
+		hostId = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		newClusterId = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	numOfEvents := func(id strfmt.UUID) int {
+		evs, err := theEvents.GetEvents(id.String())
+		Expect(err).ShouldNot(HaveOccurred())
+		return len(evs)
+	}
+
+	createHost := func(state string) {
+		host = getTestHost(hostId, clusterId, state)
+		host.Href = swag.String(fmt.Sprintf("/api/assisted-install/v1/clusters/%s/hosts/%s", clusterId.String(), hostId.String()))
+		host.Bootstrap = true
+		host.Connectivity = connectivityReport(true)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		theEvents.AddEvent(ctx, hostId.String(), models.EventSeverityInfo, "host event", time.Now(), clusterId.String())
+		Expect(db.Create(&models.DebugStepResult{StepID: swag.String("debug-step"), HostID: &hostId,
+			ClusterID: &clusterId}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&models.HostStep{StepID: swag.String("step"), HostID: &hostId,
+			ClusterID: &clusterId}).Error).ShouldNot(HaveOccurred())
+	}
+
+	It("success", func() {
+		createHost(HostStatusKnown)
+		Expect(hapi.RebindHost(ctx, &host, newClusterId, db)).ShouldNot(HaveOccurred())
+		Expect(host.ClusterID).To(Equal(newClusterId))
+
+		var hosts []*models.Host
+		Expect(db.Find(&hosts, "id = ?", hostId.String()).Error).ShouldNot(HaveOccurred())
+		Expect(hosts).To(HaveLen(1))
+		h := hosts[0]
+		Expect(h.ClusterID).To(Equal(newClusterId))
+		Expect(h.PreviousClusterID).To(Equal(clusterId))
+		Expect(h.Inventory).To(Equal(defaultInventory()))
+		Expect(swag.StringValue(h.Href)).To(ContainSubstring(newClusterId.String()))
+		Expect(h.Bootstrap).To(BeFalse())
+		Expect(h.Connectivity).To(BeEmpty())
+
+		Expect(numOfEvents(hostId)).To(Equal(1))
+		Expect(numOfEvents(clusterId)).To(Equal(0))
+		Expect(numOfEvents(newClusterId)).To(Equal(1))
+
+		var debugStep models.DebugStepResult
+		Expect(db.First(&debugStep, "step_id = ?", "debug-step").Error).ShouldNot(HaveOccurred())
+		Expect(*debugStep.ClusterID).To(Equal(newClusterId))
+		var hostStep models.HostStep
+		Expect(db.First(&hostStep, "step_id = ?", "step").Error).ShouldNot(HaveOccurred())
+		Expect(*hostStep.ClusterID).To(Equal(newClusterId))
+	})
+
+	It("host already registered to the new cluster", func() {
+		createHost(HostStatusKnown)
+		other := getTestHost(hostId, newClusterId, HostStatusDiscovering)
+		Expect(db.Create(&other).Error).ShouldNot(HaveOccurred())
+		err := hapi.RebindHost(ctx, &host, newClusterId, db)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+	})
+
+	for _, state := range []string{HostStatusInstalling, HostStatusInstallingInProgress, HostStatusInstalled,
+		HostStatusError, HostStatusDisabled, HostStatusResetting} {
+		state := state
+		It(fmt.Sprintf("not allowed in %s state", state), func() {
+			createHost(state)
+			err := hapi.RebindHost(ctx, &host, newClusterId, db)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+			Expect(getHost(hostId, clusterId, db).ClusterID).To(Equal(clusterId))
+		})
+	}
+})
+
 var _ = Describe("SetBootstrap", func() {
 	var (
 		ctx               = context.Background()
@@ -938,7 +1243,7 @@ var _ = Describe("SetBootstrap", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 
@@ -991,7 +1296,7 @@ var _ = Describe("PrepareForInstallation", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
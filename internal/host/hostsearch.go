@@ -0,0 +1,131 @@
+package host
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxLabelKeyLength   = 63
+	maxLabelValueLength = 63
+	maxNotesLength      = 4096
+)
+
+var labelRegex = regexp.MustCompile(`^([a-zA-Z0-9]([-a-zA-Z0-9_.]*[a-zA-Z0-9])?)?$`)
+
+type LabelSelector struct {
+	Key   string
+	Value string
+	// When false, only the existence of the key is checked
+	HasValue bool
+}
+
+type SearchFilter struct {
+	LabelSelectors []LabelSelector
+	MacAddress     string
+	SerialNumber   string
+	BmcAddress     string
+	Vendor         string
+	Product        string
+}
+
+func validateLabel(key, value string) error {
+	if key == "" || len(key) > maxLabelKeyLength || !labelRegex.MatchString(key) {
+		return errors.Errorf("Invalid label key %q, must be up to %d alphanumeric characters, '-', '_' or '.'",
+			key, maxLabelKeyLength)
+	}
+	if len(value) > maxLabelValueLength || !labelRegex.MatchString(value) {
+		return errors.Errorf("Invalid value %q of label %s, must be up to %d alphanumeric characters, '-', '_' or '.'",
+			value, key, maxLabelValueLength)
+	}
+	return nil
+}
+
+func ValidateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if err := validateLabel(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func GetLabels(h *models.Host) (map[string]string, error) {
+	labels := make(map[string]string)
+	if h.Labels == "" {
+		return labels, nil
+	}
+	if err := json.Unmarshal([]byte(h.Labels), &labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// ParseLabelSelectors parses a comma separated list of label selectors, each either key or key=value
+func ParseLabelSelectors(selectors string) ([]LabelSelector, error) {
+	ret := make([]LabelSelector, 0)
+	for _, s := range strings.Split(selectors, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		var selector LabelSelector
+		if i := strings.Index(s, "="); i >= 0 {
+			selector = LabelSelector{Key: s[:i], Value: s[i+1:], HasValue: true}
+		} else {
+			selector = LabelSelector{Key: s}
+		}
+		if err := validateLabel(selector.Key, selector.Value); err != nil {
+			return nil, err
+		}
+		ret = append(ret, selector)
+	}
+	return ret, nil
+}
+
+// Labels and inventory are stored as JSON text, empty until set
+const (
+	labelsJSON    = "NULLIF(labels, '')::jsonb"
+	inventoryJSON = "NULLIF(inventory, '')::jsonb"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func containsPattern(substr string) string {
+	return "%" + likeEscaper.Replace(substr) + "%"
+}
+
+// Apply adds the conditions of the filter to the hosts query.
+// Hosts that did not report their inventory yet match only filters that do not look into the inventory.
+func (f *SearchFilter) Apply(db *gorm.DB) *gorm.DB {
+	for _, selector := range f.LabelSelectors {
+		if selector.HasValue {
+			db = db.Where(labelsJSON+" ->> ? = ?", selector.Key, selector.Value)
+		} else {
+			db = db.Where("("+labelsJSON+" -> ?) IS NOT NULL", selector.Key)
+		}
+	}
+	if f.MacAddress != "" {
+		db = db.Where("EXISTS (SELECT 1 FROM jsonb_array_elements("+inventoryJSON+" -> 'interfaces') AS intf "+
+			"WHERE lower(intf ->> 'mac_address') = lower(?))", f.MacAddress)
+	}
+	if f.BmcAddress != "" {
+		db = db.Where("("+inventoryJSON+" ->> 'bmc_address' = ? OR "+inventoryJSON+" ->> 'bmc_v6address' = ?)",
+			f.BmcAddress, f.BmcAddress)
+	}
+	if f.SerialNumber != "" {
+		db = db.Where("lower("+inventoryJSON+" -> 'system_vendor' ->> 'serial_number') = lower(?)", f.SerialNumber)
+	}
+	if f.Vendor != "" {
+		db = db.Where(inventoryJSON+" -> 'system_vendor' ->> 'manufacturer' ILIKE ?", containsPattern(f.Vendor))
+	}
+	if f.Product != "" {
+		db = db.Where(inventoryJSON+" -> 'system_vendor' ->> 'product_name' ILIKE ?", containsPattern(f.Product))
+	}
+	return db
+}
@@ -0,0 +1,117 @@
+package host
+
+import (
+	"encoding/json"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("host search", func() {
+	var (
+		db     *gorm.DB
+		dbName = "host_search_test"
+		h      models.Host
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		inventory := models.Inventory{
+			BmcAddress: "10.0.0.5",
+			Interfaces: []*models.Interface{
+				{Name: "eth0", MacAddress: "52:54:00:aa:bb:cc"},
+			},
+			SystemVendor: &models.SystemVendor{Manufacturer: "Dell Inc.", ProductName: "PowerEdge R640", SerialNumber: "ABC123"},
+		}
+		b, err := json.Marshal(&inventory)
+		Expect(err).ShouldNot(HaveOccurred())
+		h = getTestHost(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()), HostStatusKnown)
+		h.Inventory = string(b)
+		h.Labels = `{"rack":"r1","gpu":""}`
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		other := getTestHost(strfmt.UUID(uuid.New().String()), h.ClusterID, HostStatusDiscovering)
+		other.Inventory = ""
+		Expect(db.Create(&other).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	search := func(filter *SearchFilter) []*models.Host {
+		var hosts []*models.Host
+		Expect(filter.Apply(db).Where("inventory <> ''").Find(&hosts).Error).ShouldNot(HaveOccurred())
+		return hosts
+	}
+
+	It("parse label selectors", func() {
+		selectors, err := ParseLabelSelectors("rack=r1, gpu,,")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(selectors).To(Equal([]LabelSelector{
+			{Key: "rack", Value: "r1", HasValue: true},
+			{Key: "gpu"},
+		}))
+	})
+
+	It("invalid label selectors", func() {
+		_, err := ParseLabelSelectors("=r1")
+		Expect(err).Should(HaveOccurred())
+		_, err = ParseLabelSelectors("rack=r 1")
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("validate labels", func() {
+		Expect(ValidateLabels(map[string]string{"rack": "r1", "zone.a": ""})).ShouldNot(HaveOccurred())
+		Expect(ValidateLabels(map[string]string{"-rack": "r1"})).Should(HaveOccurred())
+		Expect(ValidateLabels(map[string]string{"rack": "r1/2"})).Should(HaveOccurred())
+	})
+
+	tests := []struct {
+		name    string
+		filter  SearchFilter
+		matched bool
+	}{
+		{name: "empty filter", filter: SearchFilter{}, matched: true},
+		{name: "label value", filter: SearchFilter{LabelSelectors: []LabelSelector{{Key: "rack", Value: "r1", HasValue: true}}}, matched: true},
+		{name: "label key", filter: SearchFilter{LabelSelectors: []LabelSelector{{Key: "gpu"}}}, matched: true},
+		{name: "label value mismatch", filter: SearchFilter{LabelSelectors: []LabelSelector{{Key: "rack", Value: "r2", HasValue: true}}}, matched: false},
+		{name: "missing label", filter: SearchFilter{LabelSelectors: []LabelSelector{{Key: "zone"}}}, matched: false},
+		{name: "mac address", filter: SearchFilter{MacAddress: "52:54:00:AA:BB:CC"}, matched: true},
+		{name: "mac address mismatch", filter: SearchFilter{MacAddress: "52:54:00:aa:bb:cd"}, matched: false},
+		{name: "serial number", filter: SearchFilter{SerialNumber: "abc123"}, matched: true},
+		{name: "bmc address", filter: SearchFilter{BmcAddress: "10.0.0.5"}, matched: true},
+		{name: "bmc address mismatch", filter: SearchFilter{BmcAddress: "10.0.0.6"}, matched: false},
+		{name: "vendor and product", filter: SearchFilter{Vendor: "dell", Product: "R640"}, matched: true},
+		{name: "product mismatch", filter: SearchFilter{Vendor: "dell", Product: "R740"}, matched: false},
+		{name: "vendor wildcard", filter: SearchFilter{Vendor: "d%l"}, matched: false},
+	}
+
+	for i := range tests {
+		t := tests[i]
+		It(t.name, func() {
+			hosts := search(&t.filter)
+			if t.matched {
+				Expect(hosts).To(HaveLen(1))
+				Expect(hosts[0].ID.String()).To(Equal(h.ID.String()))
+			} else {
+				Expect(hosts).To(BeEmpty())
+			}
+		})
+	}
+
+	It("host without inventory", func() {
+		Expect(db.Model(&h).Update("inventory", "").Error).ShouldNot(HaveOccurred())
+		var hosts []*models.Host
+		filter := &SearchFilter{LabelSelectors: []LabelSelector{{Key: "gpu"}}}
+		Expect(filter.Apply(db).Find(&hosts).Error).ShouldNot(HaveOccurred())
+		Expect(hosts).To(HaveLen(1))
+		filter = &SearchFilter{Vendor: "dell"}
+		Expect(filter.Apply(db).Find(&hosts).Error).ShouldNot(HaveOccurred())
+		Expect(hosts).To(BeEmpty())
+	})
+})
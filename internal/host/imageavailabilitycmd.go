@@ -0,0 +1,110 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+
+	"github.com/filanov/bm-inventory/models"
+)
+
+const (
+	imagePullTimeout = 10 * time.Minute
+	// Time to wait after a failed check before pulling the images again
+	imageAvailabilityBackoff = 5 * time.Minute
+)
+
+type imageAvailabilityCmd struct {
+	baseCmd
+	db                *gorm.DB
+	instructionConfig InstructionConfig
+}
+
+func NewImageAvailabilityCmd(log logrus.FieldLogger, db *gorm.DB, instructionConfig InstructionConfig) *imageAvailabilityCmd {
+	return &imageAvailabilityCmd{
+		baseCmd:           baseCmd{log: log},
+		db:                db,
+		instructionConfig: instructionConfig,
+	}
+}
+
+// Images that each host has to be able to pull in order to be installed
+func (i *imageAvailabilityCmd) requiredImages() []string {
+	return []string{i.instructionConfig.ReleaseImage, i.instructionConfig.InstallerImage}
+}
+
+// Once all the required images were pulled there is no need to check them again
+func (i *imageAvailabilityCmd) allImagesAvailable(host *models.Host) bool {
+	if host.ImagesStatus == "" {
+		return false
+	}
+	var images []*models.ContainerImageAvailability
+	if err := json.Unmarshal([]byte(host.ImagesStatus), &images); err != nil {
+		i.log.WithError(err).Warnf("failed to parse images status of host %s", host.ID)
+		return false
+	}
+	available := make(map[string]bool)
+	for _, image := range images {
+		available[image.Name] = image.Result == models.ContainerImageAvailabilityResultSuccess
+	}
+	for _, image := range i.requiredImages() {
+		if !available[image] {
+			return false
+		}
+	}
+	return true
+}
+
+// waitForPreviousCheck returns whether the previous check of the host may still be pulling the images, or failed recently
+// enough to wait before pulling them again
+func (i *imageAvailabilityCmd) waitForPreviousCheck(host *models.Host) (bool, error) {
+	var last models.HostStep
+	err := i.db.Where("host_id = ? and cluster_id = ? and step_type = ?", host.ID.String(), host.ClusterID.String(),
+		models.StepTypeContainerImageAvailability).Order("issued_at desc").First(&last).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if time.Time(last.RepliedAt).IsZero() {
+		return time.Since(time.Time(last.IssuedAt)) < imagePullTimeout*time.Duration(len(i.requiredImages())), nil
+	}
+	return time.Since(time.Time(last.RepliedAt)) < imageAvailabilityBackoff, nil
+}
+
+func (i *imageAvailabilityCmd) GetStep(ctx context.Context, host *models.Host) (*models.Step, error) {
+	if i.allImagesAvailable(host) {
+		return nil, nil
+	}
+
+	if running, err := i.waitForPreviousCheck(host); err != nil || running {
+		return nil, err
+	}
+
+	// The pull secret is never part of the step, the agent fetches it with the token it was started with into a
+	// temporary file that is removed once the images were pulled. The script prints a container-image-availability-response
+	pullSecretURL := fmt.Sprintf("%s://%s:%s/api/assisted-install/v1/clusters/%s/hosts/%s/pull-secret",
+		strings.TrimSpace(i.instructionConfig.InventoryScheme), strings.TrimSpace(i.instructionConfig.InventoryURL),
+		strings.TrimSpace(i.instructionConfig.InventoryPort), host.ClusterID, host.ID)
+	script := fmt.Sprintf("secretfile=$(mktemp); trap 'rm -f ${secretfile}' EXIT; authfile=''; "+
+		"if curl -sSf -H \"X-Secret-Key: ${PULL_SECRET_TOKEN}\" -o ${secretfile} %[1]s; then authfile=\"--authfile ${secretfile}\"; fi; "+
+		"sep=''; printf '{\"images\":['; "+
+		"for image in \"$@\"; do "+
+		"if timeout %[2]d podman pull --quiet ${authfile} ${image} > /dev/null 2>&1; "+
+		"then result=success; else result=failure; fi; "+
+		"printf '%%s{\"name\":\"%%s\",\"result\":\"%%s\"}' \"${sep}\" \"${image}\" \"${result}\"; sep=','; "+
+		"done; printf ']}'", pullSecretURL, int64(imagePullTimeout.Seconds()))
+
+	step := &models.Step{
+		StepType: models.StepTypeContainerImageAvailability,
+		Command:  "bash",
+		Args:     append([]string{"-c", script, "image_availability"}, i.requiredImages()...),
+	}
+	return step, nil
+}
@@ -0,0 +1,113 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("image availability", func() {
+	ctx := context.Background()
+	var host models.Host
+	var cluster common.Cluster
+	var db *gorm.DB
+	var imageCmd *imageAvailabilityCmd
+	var id, clusterId strfmt.UUID
+	dbName := "image_availability_cmd"
+	pullSecret := `{"auths":{"quay.io":{"auth":"dXNlcjpwYXNzd29yZA==","email":"r@r.com"}}}`
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		imageCmd = NewImageAvailabilityCmd(getTestLog(), db, InstructionConfig{
+			ReleaseImage:    "quay.io/openshift-release-dev/ocp-release:4.5.0-x86_64",
+			InstallerImage:  "quay.io/ocpmetal/assisted-installer:latest",
+			InventoryScheme: "http",
+			InventoryURL:    "10.35.59.36",
+			InventoryPort:   "30485",
+		})
+
+		id = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		cluster = common.Cluster{Cluster: models.Cluster{ID: &clusterId}, PullSecret: pullSecret}
+		Expect(db.Create(&cluster).Error).ShouldNot(HaveOccurred())
+		host = getTestHost(id, clusterId, HostStatusInsufficient)
+		host.ImagesStatus = imagesStatus(models.ContainerImageAvailabilityResultFailure)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	It("get_step", func() {
+		stepReply, stepErr := imageCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply.StepType).To(Equal(models.StepTypeContainerImageAvailability))
+		Expect(stepReply.Command).To(Equal("bash"))
+		Expect(stepReply.Args[1]).To(ContainSubstring("-H \"X-Secret-Key: ${PULL_SECRET_TOKEN}\""))
+		Expect(stepReply.Args[1]).To(ContainSubstring(fmt.Sprintf(
+			"http://10.35.59.36:30485/api/assisted-install/v1/clusters/%s/hosts/%s/pull-secret", clusterId, id)))
+		Expect(stepReply.Args[1]).To(ContainSubstring("--authfile ${secretfile}"))
+		Expect(stepReply.Args[2:]).To(Equal([]string{"image_availability",
+			"quay.io/openshift-release-dev/ocp-release:4.5.0-x86_64", "quay.io/ocpmetal/assisted-installer:latest"}))
+		for _, arg := range stepReply.Args {
+			Expect(arg).NotTo(ContainSubstring(pullSecret))
+		}
+	})
+
+	recordCheck := func(issuedAt, repliedAt time.Time) {
+		Expect(db.Create(&models.HostStep{
+			StepID:    swag.String("container-image-availability-1"),
+			HostID:    &id,
+			ClusterID: &clusterId,
+			StepType:  models.StepTypeContainerImageAvailability,
+			IssuedAt:  strfmt.DateTime(issuedAt),
+			RepliedAt: strfmt.DateTime(repliedAt),
+		}).Error).ShouldNot(HaveOccurred())
+	}
+
+	It("previous check is running", func() {
+		recordCheck(time.Now().Add(-time.Minute), time.Time{})
+		stepReply, stepErr := imageCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).Should(BeNil())
+	})
+
+	It("previous check did not reply in time", func() {
+		recordCheck(time.Now().Add(-2*imagePullTimeout-time.Minute), time.Time{})
+		stepReply, stepErr := imageCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).ShouldNot(BeNil())
+	})
+
+	It("back off after a failed check", func() {
+		recordCheck(time.Now().Add(-2*time.Minute), time.Now().Add(-time.Minute))
+		stepReply, stepErr := imageCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).Should(BeNil())
+	})
+
+	It("check again after the back off", func() {
+		recordCheck(time.Now().Add(-imageAvailabilityBackoff-2*time.Minute), time.Now().Add(-imageAvailabilityBackoff-time.Minute))
+		stepReply, stepErr := imageCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).ShouldNot(BeNil())
+	})
+
+	It("all images available", func() {
+		host.ImagesStatus = imagesStatus(models.ContainerImageAvailabilityResultSuccess)
+		stepReply, stepErr := imageCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		// cleanup
+		common.DeleteTestDB(db, dbName)
+	})
+})
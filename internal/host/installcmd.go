@@ -98,11 +98,11 @@ func (i *installCmd) GetStep(ctx context.Context, host *models.Host) (*models.St
 }
 
 func getBootDevice(log logrus.FieldLogger, hwValidator hardware.Validator, host models.Host) (string, error) {
-	disks, err := hwValidator.GetHostValidDisks(&host)
-	if err != nil || len(disks) == 0 {
-		err := fmt.Errorf("Failed to get valid disks on host with id %s", host.ID)
-		log.Errorf("Failed to get valid disks on host with id %s", host.ID)
+	disk, err := hwValidator.GetHostInstallationDisk(&host)
+	if err != nil || disk == nil {
+		err := fmt.Errorf("Failed to get installation disk on host with id %s", host.ID)
+		log.Errorf("Failed to get installation disk on host with id %s", host.ID)
 		return "", err
 	}
-	return fmt.Sprintf("/dev/%s", disks[0].Name), nil
+	return hardware.DevicePath(disk), nil
 }
@@ -60,20 +60,19 @@ var _ = Describe("installcmd", func() {
 	})
 
 	It("get_step_one_master", func() {
-		mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(nil, errors.New("error")).Times(1)
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(nil, errors.New("error")).Times(1)
 		stepReply, stepErr = installCmd.GetStep(ctx, &host)
 		postvalidation(true, true, stepReply, stepErr, "")
 	})
 
-	It("get_step_one_master_no_disks", func() {
-		var emptydisks []*models.Disk
-		mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(emptydisks, nil).Times(1)
+	It("get_step_one_master_no_installation_disk", func() {
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(nil, nil).Times(1)
 		stepReply, stepErr = installCmd.GetStep(ctx, &host)
 		postvalidation(true, true, stepReply, stepErr, "")
 	})
 
 	It("get_step_one_master_success", func() {
-		mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(disks, nil).Times(1)
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(disks[0], nil).Times(1)
 		stepReply, stepErr = installCmd.GetStep(ctx, &host)
 		postvalidation(false, false, stepReply, stepErr, models.HostRoleMaster)
 		validateInstallCommand(stepReply, models.HostRoleMaster, string(clusterId), string(*host.ID), "")
@@ -85,7 +84,7 @@ var _ = Describe("installcmd", func() {
 
 		host2 := createHostInDb(db, clusterId, models.HostRoleMaster, false, "")
 		host3 := createHostInDb(db, clusterId, models.HostRoleMaster, true, "some_hostname")
-		mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(disks, nil).Times(3)
+		mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(disks[0], nil).Times(3)
 		stepReply, stepErr = installCmd.GetStep(ctx, &host)
 		postvalidation(false, false, stepReply, stepErr, models.HostRoleMaster)
 		validateInstallCommand(stepReply, models.HostRoleMaster, string(clusterId), string(*host.ID), "")
@@ -25,6 +25,10 @@ type InstructionApi interface {
 const (
 	defaultNextInstructionInSec      = int64(60)
 	defaultBackedOffInstructionInSec = int64(120)
+	// Hosts that cannot leave their state before the next report of the agent, or that wait for the user to fix
+	// them, are polled more often
+	discoveringNextInstructionInSec = int64(10)
+	userActionNextInstructionInSec  = int64(30)
 )
 
 type StepsStruct struct {
@@ -35,11 +39,14 @@ type StepsStruct struct {
 type stateToStepsMap map[string]StepsStruct
 
 type InstructionManager struct {
-	log          logrus.FieldLogger
-	db           *gorm.DB
-	stateToSteps stateToStepsMap
+	log             logrus.FieldLogger
+	db              *gorm.DB
+	stateToSteps    stateToStepsMap
+	agentVersionCfg AgentVersionConfig
 }
 type InstructionConfig struct {
+	AgentVersionConfig
+	InventoryScheme        string `envconfig:"INVENTORY_SCHEME" default:"http"`
 	InventoryURL           string `envconfig:"INVENTORY_URL" default:"10.35.59.36"`
 	InventoryPort          string `envconfig:"INVENTORY_PORT" default:"30485"`
 	InstallerImage         string `envconfig:"INSTALLER_IMAGE" default:"quay.io/ocpmetal/assisted-installer:latest"`
@@ -47,6 +54,8 @@ type InstructionConfig struct {
 	ConnectivityCheckImage string `envconfig:"CONNECTIVITY_CHECK_IMAGE" default:"quay.io/ocpmetal/connectivity_check:latest"`
 	InventoryImage         string `envconfig:"INVENTORY_IMAGE" default:"quay.io/ocpmetal/inventory:latest"`
 	FreeAddressesImage     string `envconfig:"FREE_ADDRESSES_IMAGE" default:"quay.io/ocpmetal/free_addresses:latest"`
+	DiskCheckImage         string `envconfig:"DISK_CHECK_IMAGE" default:"quay.io/ocpmetal/disk_speed_check:latest"`
+	ReleaseImage           string `envconfig:"OPENSHIFT_INSTALL_RELEASE_IMAGE" default:"quay.io/openshift-release-dev/ocp-release@sha256:eab93b4591699a5a4ff50ad3517892653f04fb840127895bb3609b3cc68f98f3"`
 }
 
 func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator, instructionConfig InstructionConfig, connectivityValidator connectivity.Validator) *InstructionManager {
@@ -56,20 +65,26 @@ func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hard
 	freeAddressesCmd := NewFreeAddressesCmd(log, instructionConfig.FreeAddressesImage)
 	resetCmd := NewResetInstallationCmd(log)
 	stopCmd := NewStopInstallationCmd(log)
+	logsCmd := NewLogsGatherCmd(log, db, instructionConfig)
+	imageAvailabilityCmd := NewImageAvailabilityCmd(log, db, instructionConfig)
+	diskSpeedCheckCmd := NewDiskSpeedCheckCmd(log, db, hwValidator, instructionConfig.DiskCheckImage)
+	timeSyncCmd := NewTimeSyncCmd(log)
+	upgradeAgentCmd := NewUpgradeAgentCmd(log, db, instructionConfig)
 
 	return &InstructionManager{
-		log: log,
-		db:  db,
+		log:             log,
+		db:              db,
+		agentVersionCfg: instructionConfig.AgentVersionConfig,
 		stateToSteps: stateToStepsMap{
-			HostStatusKnown:           {[]CommandGetter{connectivityCmd, freeAddressesCmd}, defaultNextInstructionInSec},
-			HostStatusInsufficient:    {[]CommandGetter{inventoryCmd, connectivityCmd, freeAddressesCmd}, defaultNextInstructionInSec},
-			HostStatusDisconnected:    {[]CommandGetter{inventoryCmd, connectivityCmd}, defaultBackedOffInstructionInSec},
-			HostStatusDiscovering:     {[]CommandGetter{inventoryCmd, connectivityCmd}, defaultNextInstructionInSec},
-			HostStatusPendingForInput: {[]CommandGetter{inventoryCmd, connectivityCmd, freeAddressesCmd}, defaultNextInstructionInSec},
+			HostStatusKnown:           {[]CommandGetter{upgradeAgentCmd, connectivityCmd, timeSyncCmd, freeAddressesCmd, imageAvailabilityCmd, diskSpeedCheckCmd}, defaultNextInstructionInSec},
+			HostStatusInsufficient:    {[]CommandGetter{upgradeAgentCmd, inventoryCmd, connectivityCmd, timeSyncCmd, freeAddressesCmd, imageAvailabilityCmd, diskSpeedCheckCmd}, userActionNextInstructionInSec},
+			HostStatusDisconnected:    {[]CommandGetter{upgradeAgentCmd, inventoryCmd, connectivityCmd}, defaultBackedOffInstructionInSec},
+			HostStatusDiscovering:     {[]CommandGetter{upgradeAgentCmd, inventoryCmd, connectivityCmd}, discoveringNextInstructionInSec},
+			HostStatusPendingForInput: {[]CommandGetter{upgradeAgentCmd, inventoryCmd, connectivityCmd, timeSyncCmd, freeAddressesCmd}, userActionNextInstructionInSec},
 			HostStatusInstalling:      {[]CommandGetter{installCmd}, defaultBackedOffInstructionInSec},
 			HostStatusDisabled:        {[]CommandGetter{}, defaultBackedOffInstructionInSec},
 			HostStatusResetting:       {[]CommandGetter{resetCmd}, defaultBackedOffInstructionInSec},
-			HostStatusError:           {[]CommandGetter{stopCmd}, defaultBackedOffInstructionInSec},
+			HostStatusError:           {[]CommandGetter{stopCmd, logsCmd}, defaultBackedOffInstructionInSec},
 		},
 	}
 }
@@ -87,16 +102,34 @@ func (i *InstructionManager) GetNextSteps(ctx context.Context, host *models.Host
 	if cmdsMap, ok := i.stateToSteps[HostStatus]; ok {
 		//need to add the step id
 		returnSteps.NextInstructionSeconds = cmdsMap.NextStepInSec
+		var upgradeStep *models.Step
 		for _, cmd := range cmdsMap.Commands {
 			step, err := cmd.GetStep(ctx, host)
 			if err != nil {
 				return returnSteps, err
 			}
+			// Commands may have nothing to do in the current state of the host
+			if step == nil {
+				continue
+			}
 			if step.StepID == "" {
 				step.StepID = createStepID(step.StepType)
 			}
+			// The agent restarts once it is upgraded. An unsupported agent gets only the upgrade and the other steps
+			// are sent to the new agent, a supported agent keeps getting the other steps and is upgraded after them.
+			if step.StepType == models.StepTypeUpgradeAgent {
+				if i.agentVersionCfg.GetAgentVersionStatus(host.DiscoveryAgentVersion) == models.AgentVersionStatusUnsupported {
+					returnSteps.Instructions = []*models.Step{step}
+					break
+				}
+				upgradeStep = step
+				continue
+			}
 			returnSteps.Instructions = append(returnSteps.Instructions, step)
 		}
+		if upgradeStep != nil {
+			returnSteps.Instructions = append(returnSteps.Instructions, upgradeStep)
+		}
 	} else {
 		returnSteps.NextInstructionSeconds = defaultNextInstructionInSec
 	}
@@ -113,7 +146,7 @@ func logSteps(steps models.Steps, clusterId strfmt.UUID, hostId *strfmt.UUID, lo
 		log.Infof("No steps required for cluster <%s> host <%s>", clusterId, hostId)
 	}
 	for _, step := range steps.Instructions {
-		log.Infof("Submitting step <%s> id <%s> to cluster <%s> host <%s> Command: <%s> Arguments: <%+v>", step.StepType, step.StepID, clusterId, hostId,
-			step.Command, step.Args)
+		log.Infof("Submitting step <%s> id <%s> to cluster <%s> host <%s> Command: <%s> Arguments: <%s>", step.StepType, step.StepID, clusterId, hostId,
+			step.Command, RedactSecrets(fmt.Sprintf("%+v", step.Args)))
 	}
 }
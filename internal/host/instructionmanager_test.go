@@ -59,7 +59,8 @@ var _ = Describe("instructionmanager", func() {
 		})
 		It("known", func() {
 			checkStepsByState(HostStatusKnown, &host, db, mockEvents, instMng, hwValidator, ctx,
-				[]models.StepType{models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses})
+				[]models.StepType{models.StepTypeConnectivityCheck, models.StepTypeTimeSync, models.StepTypeFreeNetworkAddresses,
+					models.StepTypeContainerImageAvailability, models.StepTypeDiskSpeedCheck})
 		})
 		It("disconnected", func() {
 			checkStepsByState(HostStatusDisconnected, &host, db, mockEvents, instMng, hwValidator, ctx,
@@ -67,15 +68,17 @@ var _ = Describe("instructionmanager", func() {
 		})
 		It("insufficient", func() {
 			checkStepsByState(HostStatusInsufficient, &host, db, mockEvents, instMng, hwValidator, ctx,
-				[]models.StepType{models.StepTypeInventory, models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses})
+				[]models.StepType{models.StepTypeInventory, models.StepTypeConnectivityCheck, models.StepTypeTimeSync,
+					models.StepTypeFreeNetworkAddresses, models.StepTypeContainerImageAvailability, models.StepTypeDiskSpeedCheck})
 		})
 		It("pending-for-input", func() {
 			checkStepsByState(HostStatusPendingForInput, &host, db, mockEvents, instMng, hwValidator, ctx,
-				[]models.StepType{models.StepTypeInventory, models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses})
+				[]models.StepType{models.StepTypeInventory, models.StepTypeConnectivityCheck, models.StepTypeTimeSync,
+					models.StepTypeFreeNetworkAddresses})
 		})
 		It("error", func() {
 			checkStepsByState(HostStatusError, &host, db, mockEvents, instMng, hwValidator, ctx,
-				[]models.StepType{models.StepTypeExecute})
+				[]models.StepType{models.StepTypeExecute, models.StepTypeLogsGather})
 		})
 		It("installing", func() {
 			checkStepsByState(HostStatusInstalling, &host, db, mockEvents, instMng, hwValidator, ctx,
@@ -87,6 +90,38 @@ var _ = Describe("instructionmanager", func() {
 		})
 	})
 
+	Context("outdated agent", func() {
+		var agentConfig InstructionConfig
+
+		BeforeEach(func() {
+			agentConfig = instructionConfig
+			agentConfig.AgentDockerImg = "quay.io/ocpmetal/agent:v2.0.0"
+			instMng = NewInstructionManager(getTestLog(), db, hwValidator, agentConfig, nil)
+			Expect(db.Model(&host).Update("discovery_agent_version", "quay.io/ocpmetal/agent:v1.0.0").Error).ShouldNot(HaveOccurred())
+			host.DiscoveryAgentVersion = "quay.io/ocpmetal/agent:v1.0.0"
+		})
+		It("supported agent keeps its steps and is upgraded after them", func() {
+			checkStepsByState(HostStatusKnown, &host, db, mockEvents, instMng, hwValidator, ctx,
+				[]models.StepType{models.StepTypeConnectivityCheck, models.StepTypeTimeSync, models.StepTypeFreeNetworkAddresses,
+					models.StepTypeContainerImageAvailability, models.StepTypeDiskSpeedCheck, models.StepTypeUpgradeAgent})
+		})
+		It("unsupported agent is upgraded first", func() {
+			agentConfig.MinimumAgentVersion = "v1.2"
+			instMng = NewInstructionManager(getTestLog(), db, hwValidator, agentConfig, nil)
+			checkStepsByState(HostStatusDiscovering, &host, db, mockEvents, instMng, hwValidator, ctx,
+				[]models.StepType{models.StepTypeUpgradeAgent})
+		})
+		It("newer agent is not downgraded", func() {
+			Expect(db.Model(&host).Update("discovery_agent_version", "quay.io/ocpmetal/agent:v3.0.0").Error).ShouldNot(HaveOccurred())
+			checkStepsByState(HostStatusDiscovering, &host, db, mockEvents, instMng, hwValidator, ctx,
+				[]models.StepType{models.StepTypeInventory, models.StepTypeConnectivityCheck})
+		})
+		It("installing host is not upgraded", func() {
+			checkStepsByState(HostStatusInstalling, &host, db, mockEvents, instMng, hwValidator, ctx,
+				[]models.StepType{models.StepTypeInstall})
+		})
+	})
+
 	AfterEach(func() {
 		// cleanup
 		common.DeleteTestDB(db, dbName)
@@ -111,7 +146,7 @@ func checkStepsByState(state string, host *models.Host, db *gorm.DB, mockEvents
 		{DriveType: "disk", Name: "sda", SizeBytes: validDiskSize},
 		{DriveType: "disk", Name: "sdh", SizeBytes: validDiskSize},
 	}
-	mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(disks, nil).AnyTimes()
+	mockValidator.EXPECT().GetHostInstallationDisk(gomock.Any()).Return(disks[0], nil).AnyTimes()
 	stepsReply, stepsErr := instMng.GetNextSteps(ctx, h)
 	ExpectWithOffset(1, stepsReply.Instructions).To(HaveLen(len(expectedStepTypes)))
 	if stateValues, ok := instMng.stateToSteps[state]; ok {
@@ -0,0 +1,86 @@
+package host
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+
+	"github.com/filanov/bm-inventory/models"
+)
+
+// Number of times the logs are gathered in a status before giving up on uploading them
+const maxLogsGatherAttempts = 3
+
+type logsGatherCmd struct {
+	baseCmd
+	db                *gorm.DB
+	instructionConfig InstructionConfig
+}
+
+func NewLogsGatherCmd(log logrus.FieldLogger, db *gorm.DB, instructionConfig InstructionConfig) *logsGatherCmd {
+	return &logsGatherCmd{
+		baseCmd:           baseCmd{log: log},
+		db:                db,
+		instructionConfig: instructionConfig,
+	}
+}
+
+// The logs are collected once each time the host enters a status that gathers them
+func logsCollectedInCurrentStatus(host *models.Host) bool {
+	return time.Time(host.LogsCollectedAt).After(time.Time(host.StatusUpdatedAt))
+}
+
+func (l *logsGatherCmd) GetStep(ctx context.Context, host *models.Host) (*models.Step, error) {
+	if logsCollectedInCurrentStatus(host) {
+		return nil, nil
+	}
+
+	// The attempts are counted in the step history of the host
+	var attempts int
+	if err := l.db.Model(&models.HostStep{}).Where("host_id = ? and cluster_id = ? and step_type = ? and issued_at > ?",
+		host.ID.String(), host.ClusterID.String(), models.StepTypeLogsGather, host.StatusUpdatedAt).
+		Count(&attempts).Error; err != nil {
+		return nil, err
+	}
+	if attempts >= maxLogsGatherAttempts {
+		l.log.Debugf("Stopped gathering the logs of host %s in cluster %s after %d attempts",
+			host.ID.String(), host.ClusterID.String(), attempts)
+		return nil, nil
+	}
+
+	cmdTmpl := "logs_dir=$(mktemp -d /tmp/logs.XXXXXX); " +
+		"journalctl --no-pager -u agent.service > ${logs_dir}/agent.log 2>&1; " +
+		"podman logs assisted-installer > ${logs_dir}/installer.log 2>&1; " +
+		"journalctl --no-pager -b -n 100000 > ${logs_dir}/journal.log 2>&1; " +
+		"tar -czf ${logs_dir}.tar.gz -C ${logs_dir} .; " +
+		"curl -sf -X POST -F upfile=@${logs_dir}.tar.gz " +
+		"{{.SCHEME}}://{{.HOST}}:{{.PORT}}/api/assisted-install/v1/clusters/{{.CLUSTER_ID}}/hosts/{{.HOST_ID}}/logs; " +
+		"rc=$?; rm -rf ${logs_dir} ${logs_dir}.tar.gz; exit ${rc}"
+
+	data := map[string]string{
+		"SCHEME":     strings.TrimSpace(l.instructionConfig.InventoryScheme),
+		"HOST":       strings.TrimSpace(l.instructionConfig.InventoryURL),
+		"PORT":       strings.TrimSpace(l.instructionConfig.InventoryPort),
+		"CLUSTER_ID": string(host.ClusterID),
+		"HOST_ID":    string(*host.ID),
+	}
+
+	t, err := template.New("cmd").Parse(cmdTmpl)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, data); err != nil {
+		return nil, err
+	}
+	step := &models.Step{}
+	step.StepType = models.StepTypeLogsGather
+	step.Command = "bash"
+	step.Args = []string{"-c", buf.String()}
+	return step, nil
+}
@@ -0,0 +1,73 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("logs gather", func() {
+	ctx := context.Background()
+	var host models.Host
+	var db *gorm.DB
+	var logsCmd *logsGatherCmd
+	var id, clusterId strfmt.UUID
+	var stepReply *models.Step
+	var stepErr error
+	dbName := "logs_gather_cmd"
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		logsCmd = NewLogsGatherCmd(getTestLog(), db, InstructionConfig{InventoryScheme: "https",
+			InventoryURL: "10.35.59.36", InventoryPort: "30485"})
+
+		id = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		host = getTestHost(id, clusterId, HostStatusError)
+		host.StatusUpdatedAt = strfmt.DateTime(time.Now())
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	It("get_step", func() {
+		stepReply, stepErr = logsCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply.StepType).To(Equal(models.StepTypeLogsGather))
+		Expect(stepReply.Args[1]).Should(ContainSubstring(
+			"https://10.35.59.36:30485/api/assisted-install/v1/clusters/" + clusterId.String() + "/hosts/" + id.String() + "/logs"))
+	})
+
+	It("logs already collected", func() {
+		host.LogsCollectedAt = strfmt.DateTime(time.Time(host.StatusUpdatedAt).Add(time.Second))
+		stepReply, stepErr = logsCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).Should(BeNil())
+	})
+
+	It("attempts exhausted", func() {
+		for i := 0; i < maxLogsGatherAttempts; i++ {
+			stepReply, stepErr = logsCmd.GetStep(ctx, &host)
+			Expect(stepErr).ShouldNot(HaveOccurred())
+			Expect(stepReply).ShouldNot(BeNil())
+			stepReply.StepID = fmt.Sprintf("logs-gather-%d", i)
+			Expect(RecordSteps(db, &host, []*models.Step{stepReply})).ShouldNot(HaveOccurred())
+		}
+		stepReply, stepErr = logsCmd.GetStep(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		// cleanup
+		common.DeleteTestDB(db, dbName)
+		stepReply = nil
+		stepErr = nil
+	})
+})
@@ -10,6 +10,7 @@ import (
 
 	common "github.com/filanov/bm-inventory/internal/common"
 	models "github.com/filanov/bm-inventory/models"
+	strfmt "github.com/go-openapi/strfmt"
 	gomock "github.com/golang/mock/gomock"
 	gorm "github.com/jinzhu/gorm"
 )
@@ -176,6 +177,62 @@ func (mr *MockAPIMockRecorder) UpdateHostname(ctx, h, hostname, db interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostname", reflect.TypeOf((*MockAPI)(nil).UpdateHostname), ctx, h, hostname, db)
 }
 
+// UpdateInstallationDisk mocks base method
+func (m *MockAPI) UpdateInstallationDisk(ctx context.Context, h *models.Host, installationDiskID string, db *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstallationDisk", ctx, h, installationDiskID, db)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInstallationDisk indicates an expected call of UpdateInstallationDisk
+func (mr *MockAPIMockRecorder) UpdateInstallationDisk(ctx, h, installationDiskID, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstallationDisk", reflect.TypeOf((*MockAPI)(nil).UpdateInstallationDisk), ctx, h, installationDiskID, db)
+}
+
+// UpdateLabels mocks base method
+func (m *MockAPI) UpdateLabels(ctx context.Context, h *models.Host, labels map[string]string, db *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabels", ctx, h, labels, db)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLabels indicates an expected call of UpdateLabels
+func (mr *MockAPIMockRecorder) UpdateLabels(ctx, h, labels, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabels", reflect.TypeOf((*MockAPI)(nil).UpdateLabels), ctx, h, labels, db)
+}
+
+// UpdateNotes mocks base method
+func (m *MockAPI) UpdateNotes(ctx context.Context, h *models.Host, notes string, db *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotes", ctx, h, notes, db)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotes indicates an expected call of UpdateNotes
+func (mr *MockAPIMockRecorder) UpdateNotes(ctx, h, notes, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotes", reflect.TypeOf((*MockAPI)(nil).UpdateNotes), ctx, h, notes, db)
+}
+
+// RebindHost mocks base method
+func (m *MockAPI) RebindHost(ctx context.Context, h *models.Host, newClusterID strfmt.UUID, db *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebindHost", ctx, h, newClusterID, db)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebindHost indicates an expected call of RebindHost
+func (mr *MockAPIMockRecorder) RebindHost(ctx, h, newClusterID, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebindHost", reflect.TypeOf((*MockAPI)(nil).RebindHost), ctx, h, newClusterID, db)
+}
+
 // CancelInstallation mocks base method
 func (m *MockAPI) CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse {
 	m.ctrl.T.Helper()
@@ -232,20 +289,6 @@ func (mr *MockAPIMockRecorder) ResetPendingUserAction(ctx, h, db interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPendingUserAction", reflect.TypeOf((*MockAPI)(nil).ResetPendingUserAction), ctx, h, db)
 }
 
-// GetHostname mocks base method
-func (m *MockAPI) GetHostname(h *models.Host) string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHostname", h)
-	ret0, _ := ret[0].(string)
-	return ret0
-}
-
-// GetHostname indicates an expected call of GetHostname
-func (mr *MockAPIMockRecorder) GetHostname(h interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostname", reflect.TypeOf((*MockAPI)(nil).GetHostname), h)
-}
-
 // DisableHost mocks base method
 func (m *MockAPI) DisableHost(ctx context.Context, h *models.Host) error {
 	m.ctrl.T.Helper()
@@ -2,11 +2,28 @@ package host
 
 import (
 	"context"
+	"time"
 
 	"github.com/filanov/bm-inventory/models"
 	"github.com/filanov/bm-inventory/pkg/requestid"
+	"github.com/go-openapi/strfmt"
 )
 
+// Time to wait for the reply of a debug step after its timeout before considering the reply lost
+const debugStepReplyGracePeriod = 60 * time.Second
+
+// expireDebugSteps marks the running debug steps that did not get a reply in time as timed out
+func (m *Manager) expireDebugSteps() error {
+	now := time.Now()
+	return m.db.Model(&models.DebugStepResult{}).
+		Where("status = ? and started_at + (timeout + ?) * interval '1 second' < ?", models.DebugStepResultStatusRunning,
+			int64(debugStepReplyGracePeriod.Seconds()), now).
+		Updates(map[string]interface{}{
+			"status":       models.DebugStepResultStatusTimedOut,
+			"completed_at": strfmt.DateTime(now),
+		}).Error
+}
+
 func (m *Manager) HostMonitoring() {
 	var (
 		hosts     []*models.Host
@@ -27,6 +44,10 @@ func (m *Manager) HostMonitoring() {
 		models.HostStatusInstalled,
 	}
 
+	if err := m.expireDebugSteps(); err != nil {
+		log.WithError(err).Errorf("failed to expire debug steps")
+	}
+
 	if err := m.db.Where("status IN (?)", monitorStates).Find(&hosts).Error; err != nil {
 		log.WithError(err).Errorf("failed to get hosts")
 		return
@@ -16,10 +16,10 @@ type refreshPreprocessor struct {
 	validations []validation
 }
 
-func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg) *refreshPreprocessor {
+func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, agentVersionCfg *AgentVersionConfig) *refreshPreprocessor {
 	return &refreshPreprocessor{
 		log:         log,
-		validations: newValidations(log, hwValidatorCfg),
+		validations: newValidations(log, hwValidatorCfg, agentVersionCfg),
 	}
 }
 
@@ -44,10 +44,11 @@ func (r *refreshPreprocessor) preprocess(c *validationContext) (map[validationID
 	return stateMachineInput, validationsOutput, nil
 }
 
-func newValidations(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg) []validation {
+func newValidations(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, agentVersionCfg *AgentVersionConfig) []validation {
 	v := validator{
-		log:            log,
-		hwValidatorCfg: hwValidatorCfg,
+		log:             log,
+		hwValidatorCfg:  hwValidatorCfg,
+		agentVersionCfg: agentVersionCfg,
 	}
 	ret := []validation{
 		{
@@ -110,6 +111,51 @@ func newValidations(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCf
 			condition: v.isHostnameValid,
 			formatter: v.printHostnameValid,
 		},
+		{
+			id:        HasConnectivityToAllHosts,
+			condition: v.hasConnectivityToAllHosts,
+			formatter: v.printHasConnectivityToAllHosts,
+		},
+		{
+			id:        HasDiskTypeForRole,
+			condition: v.hasDiskTypeForRole,
+			formatter: v.printHasDiskTypeForRole,
+		},
+		{
+			id:        IsMtuConsistent,
+			condition: v.isMtuConsistent,
+			formatter: v.printMtuConsistent,
+		},
+		{
+			id:        HasMinNicSpeed,
+			condition: v.hasMinNicSpeed,
+			formatter: v.printHasMinNicSpeed,
+		},
+		{
+			id:        IsPlatformUniform,
+			condition: v.isPlatformUniform,
+			formatter: v.printPlatformUniform,
+		},
+		{
+			id:        IsInstallationDiskValid,
+			condition: v.isInstallationDiskValid,
+			formatter: v.printInstallationDiskValid,
+		},
+		{
+			id:        AreContainerImagesAvailable,
+			condition: v.areContainerImagesAvailable,
+			formatter: v.printContainerImagesAvailable,
+		},
+		{
+			id:        HasFastEnoughDisk,
+			condition: v.hasFastEnoughDisk,
+			formatter: v.printHasFastEnoughDisk,
+		},
+		{
+			id:        IsAgentVersionSupported,
+			condition: v.isAgentVersionSupported,
+			formatter: v.printAgentVersionSupported,
+		},
 	}
 	return ret
 }
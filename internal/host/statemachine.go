@@ -220,7 +220,9 @@ func NewHostStateMachine(th *transitionHandler) stateswitch.StateMachine {
 	var requiredInputFieldsExist = stateswitch.And(If(IsMachineCidrDefined), If(IsRoleDefined))
 
 	var isSufficientForInstall = stateswitch.And(If(HasMemoryForRole), If(HasCPUCoresForRole), If(BelongsToMachineCidr),
-		If(IsHostnameUnique), If(IsHostnameValid))
+		If(IsHostnameUnique), If(IsHostnameValid), If(HasConnectivityToAllHosts), If(HasDiskTypeForRole),
+		If(IsMtuConsistent), If(HasMinNicSpeed), If(IsPlatformUniform), If(IsInstallationDiskValid),
+		If(AreContainerImagesAvailable), If(HasFastEnoughDisk), If(IsAgentVersionSupported))
 
 	// In order for this transition to be fired at least one of the validations in minRequiredHardwareValidations must fail.
 	// This transition handles the case that a host does not pass minimum hardware requirements for any of the roles
@@ -0,0 +1,119 @@
+package host
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+	"unicode/utf8"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Number of latest steps that are kept per host
+	MaxStepHistoryPerHost = 100
+	maxStepOutputLength   = 4096
+	redactedValue         = "<redacted>"
+)
+
+var secretPatterns = []*regexp.Regexp{
+	// Authorization headers, e.g. "Authorization: Bearer <token>"
+	regexp.MustCompile(`(?i)(authorization:\s*(?:bearer|basic)\s+)(\S+)`),
+	// Assignments, e.g. password=<value>, "token": "<value>"
+	regexp.MustCompile(`(?i)("?[\w-]*(?:password|passwd|secret|token|auth|apikey|api_key|access_key)[\w-]*"?\s*[=:]\s*"?)([^\s"',{\[]+)`),
+	// Command line flags, e.g. --pull-secret <value>
+	regexp.MustCompile(`(?i)(--[\w-]*(?:password|passwd|secret|token|key)[\w-]*[\s=]+)([^\s-]\S*)`),
+}
+
+// RedactSecrets replaces the values of passwords, tokens, keys and other secrets in s
+func RedactSecrets(s string) string {
+	for _, pattern := range secretPatterns {
+		s = pattern.ReplaceAllString(s, "${1}"+redactedValue)
+	}
+	return s
+}
+
+// truncateStepOutput cuts s to at most maxStepOutputLength bytes without splitting a multi-byte character
+func truncateStepOutput(s string) (string, bool) {
+	if len(s) <= maxStepOutputLength {
+		return s, false
+	}
+	end := maxStepOutputLength
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	return s[:end], true
+}
+
+// RecordSteps adds the steps sent to the host agent to the step history of the host in its cluster, dropping the
+// oldest steps when the history is full
+func RecordSteps(db *gorm.DB, h *models.Host, steps []*models.Step) error {
+	if len(steps) == 0 {
+		return nil
+	}
+	now := strfmt.DateTime(time.Now())
+	for _, step := range steps {
+		args := make([]string, 0, len(step.Args))
+		for _, arg := range step.Args {
+			args = append(args, RedactSecrets(arg))
+		}
+		argsJson, err := json.Marshal(args)
+		if err != nil {
+			return err
+		}
+		hostStep := models.HostStep{
+			StepID:    swag.String(step.StepID),
+			HostID:    h.ID,
+			ClusterID: &h.ClusterID,
+			StepType:  step.StepType,
+			Command:   step.Command,
+			Args:      string(argsJson),
+			IssuedAt:  now,
+		}
+		if err = db.Create(&hostStep).Error; err != nil {
+			return errors.Wrapf(err, "failed to record step %s of host %s", step.StepID, h.ID)
+		}
+	}
+
+	// The steps of one call share the issue time, the newest steps are kept by count rather than by time
+	newest := db.Model(&models.HostStep{}).Select("step_id").
+		Where("host_id = ? and cluster_id = ?", h.ID.String(), h.ClusterID.String()).
+		Order("issued_at desc, step_id desc").Limit(MaxStepHistoryPerHost).QueryExpr()
+	if err := db.Where("host_id = ? and cluster_id = ? and step_id not in (?)", h.ID.String(), h.ClusterID.String(),
+		newest).Delete(&models.HostStep{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to drop old steps of host %s", h.ID)
+	}
+	return nil
+}
+
+// RecordStepReply adds the reply of the host agent to the recorded step, replies of steps that are no longer in
+// the history are ignored
+func RecordStepReply(db *gorm.DB, h *models.Host, reply *models.StepReply) error {
+	var hostStep models.HostStep
+	err := db.First(&hostStep, "host_id = ? and cluster_id = ? and step_id = ?", h.ID.String(), h.ClusterID.String(),
+		reply.StepID).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	output, outputTruncated := truncateStepOutput(RedactSecrets(reply.Output))
+	stepError, errorTruncated := truncateStepOutput(RedactSecrets(reply.Error))
+	return db.Model(&models.HostStep{}).
+		Where("host_id = ? and cluster_id = ? and step_id = ?", h.ID.String(), h.ClusterID.String(), reply.StepID).
+		Updates(map[string]interface{}{
+			"replied_at":       strfmt.DateTime(now),
+			"exit_code":        reply.ExitCode,
+			"duration_ms":      now.Sub(time.Time(hostStep.IssuedAt)).Milliseconds(),
+			"output":           output,
+			"error":            stepError,
+			"output_truncated": outputTruncated || errorTruncated,
+		}).Error
+}
@@ -0,0 +1,186 @@
+package host
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("redact secrets", func() {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "podman run --env PULL_SECRET_TOKEN --name assisted-installer --pull-secret abc --host-id 1234",
+			expected: "podman run --env PULL_SECRET_TOKEN --name assisted-installer --pull-secret <redacted> --host-id 1234",
+		},
+		{
+			input:    "agent --ssh-key=AAAAB3Nza --cluster-id 1234",
+			expected: "agent --ssh-key=<redacted> --cluster-id 1234",
+		},
+		{
+			input:    `{"auths":{"cloud.openshift.com":{"auth":"dG9rZW4=","email":"r@r.com"}}}`,
+			expected: `{"auths":{"cloud.openshift.com":{"auth":"<redacted>","email":"r@r.com"}}}`,
+		},
+		{
+			input:    "mysql --user root password=hunter2",
+			expected: "mysql --user root password=<redacted>",
+		},
+		{
+			input:    "curl -H 'Authorization: Bearer abc.def' https://example.com",
+			expected: "curl -H 'Authorization: <redacted> <redacted> https://example.com",
+		},
+		{
+			input:    "echo hello",
+			expected: "echo hello",
+		},
+	}
+
+	for i := range tests {
+		t := tests[i]
+		It(t.input, func() {
+			Expect(RedactSecrets(t.input)).Should(Equal(t.expected))
+		})
+	}
+})
+
+var _ = Describe("truncate step output", func() {
+	It("short output", func() {
+		output, truncated := truncateStepOutput("hello")
+		Expect(output).Should(Equal("hello"))
+		Expect(truncated).Should(BeFalse())
+	})
+
+	It("multi-byte character at the limit", func() {
+		output, truncated := truncateStepOutput(strings.Repeat("a", maxStepOutputLength-1) + "€")
+		Expect(truncated).Should(BeTrue())
+		Expect(output).Should(Equal(strings.Repeat("a", maxStepOutputLength-1)))
+		Expect(utf8.ValidString(output)).Should(BeTrue())
+	})
+})
+
+var _ = Describe("step history", func() {
+	var (
+		db     *gorm.DB
+		h      models.Host
+		dbName = "step_history"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		hostID := strfmt.UUID(uuid.New().String())
+		h = models.Host{ID: &hostID, ClusterID: strfmt.UUID(uuid.New().String())}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	getHistory := func() []*models.HostStep {
+		var steps []*models.HostStep
+		Expect(db.Order("issued_at").Find(&steps, "host_id = ? and cluster_id = ?", h.ID.String(), h.ClusterID.String()).
+			Error).ShouldNot(HaveOccurred())
+		return steps
+	}
+
+	It("record steps and reply", func() {
+		Expect(RecordSteps(db, &h, []*models.Step{
+			{StepID: "inventory-1", StepType: models.StepTypeInventory, Command: "podman", Args: []string{"run", "--pull-secret", "abc"}},
+			{StepID: "connectivity-check-1", StepType: models.StepTypeConnectivityCheck, Command: "podman"},
+		})).ShouldNot(HaveOccurred())
+		steps := getHistory()
+		Expect(steps).To(HaveLen(2))
+		Expect(steps[0].Args).Should(Equal(`["run","--pull-secret","<redacted>"]`))
+		Expect(*steps[0].ClusterID).Should(Equal(h.ClusterID))
+
+		Expect(RecordStepReply(db, &h, &models.StepReply{StepID: "inventory-1", ExitCode: 0, Output: "token=abc"})).
+			ShouldNot(HaveOccurred())
+		Expect(RecordStepReply(db, &h, &models.StepReply{StepID: "connectivity-check-1", ExitCode: 1,
+			Error: strings.Repeat("e", maxStepOutputLength+1)})).ShouldNot(HaveOccurred())
+		steps = getHistory()
+		for _, step := range steps {
+			switch *step.StepID {
+			case "inventory-1":
+				Expect(step.Output).Should(Equal("token=<redacted>"))
+				Expect(step.OutputTruncated).Should(BeFalse())
+				Expect(step.RepliedAt).ShouldNot(Equal(strfmt.DateTime{}))
+				Expect(step.DurationMs).Should(BeNumerically(">=", 0))
+			case "connectivity-check-1":
+				Expect(step.ExitCode).Should(Equal(int64(1)))
+				Expect(step.Error).Should(HaveLen(maxStepOutputLength))
+				Expect(step.OutputTruncated).Should(BeTrue())
+			}
+		}
+	})
+
+	It("reply of unknown step", func() {
+		Expect(RecordStepReply(db, &h, &models.StepReply{StepID: "inventory-1"})).ShouldNot(HaveOccurred())
+		Expect(getHistory()).To(BeEmpty())
+	})
+
+	It("history is bounded", func() {
+		for i := 0; i < MaxStepHistoryPerHost+5; i++ {
+			Expect(RecordSteps(db, &h, []*models.Step{
+				{StepID: fmt.Sprintf("inventory-%d", i), StepType: models.StepTypeInventory},
+			})).ShouldNot(HaveOccurred())
+		}
+		steps := getHistory()
+		Expect(steps).To(HaveLen(MaxStepHistoryPerHost))
+		Expect(*steps[0].StepID).Should(Equal("inventory-5"))
+	})
+
+	It("history is bounded across a batch of steps", func() {
+		batch := func(prefix string, count int) []*models.Step {
+			steps := make([]*models.Step, 0, count)
+			for i := 0; i < count; i++ {
+				steps = append(steps, &models.Step{StepID: fmt.Sprintf("%s-%03d", prefix, i), StepType: models.StepTypeInventory})
+			}
+			return steps
+		}
+		Expect(RecordSteps(db, &h, batch("first", 3))).ShouldNot(HaveOccurred())
+		Expect(RecordSteps(db, &h, batch("second", MaxStepHistoryPerHost-1))).ShouldNot(HaveOccurred())
+		steps := getHistory()
+		Expect(steps).To(HaveLen(MaxStepHistoryPerHost))
+		second := 0
+		for _, step := range steps {
+			if strings.HasPrefix(*step.StepID, "second-") {
+				second++
+			}
+		}
+		Expect(second).To(Equal(MaxStepHistoryPerHost - 1))
+
+		Expect(RecordSteps(db, &h, batch("third", MaxStepHistoryPerHost+5))).ShouldNot(HaveOccurred())
+		Expect(getHistory()).To(HaveLen(MaxStepHistoryPerHost))
+	})
+
+	It("history of the same host in another cluster", func() {
+		other := h
+		other.ClusterID = strfmt.UUID(uuid.New().String())
+		Expect(RecordSteps(db, &other, []*models.Step{{StepID: "inventory-other", StepType: models.StepTypeInventory}})).
+			ShouldNot(HaveOccurred())
+		for i := 0; i < MaxStepHistoryPerHost+5; i++ {
+			Expect(RecordSteps(db, &h, []*models.Step{
+				{StepID: fmt.Sprintf("inventory-%d", i), StepType: models.StepTypeInventory},
+			})).ShouldNot(HaveOccurred())
+		}
+		Expect(getHistory()).To(HaveLen(MaxStepHistoryPerHost))
+		var steps []*models.HostStep
+		Expect(db.Find(&steps, "host_id = ? and cluster_id = ?", h.ID.String(), other.ClusterID.String()).Error).
+			ShouldNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+
+		Expect(RecordStepReply(db, &other, &models.StepReply{StepID: "inventory-1", ExitCode: 1})).ShouldNot(HaveOccurred())
+		for _, step := range getHistory() {
+			Expect(step.ExitCode).To(BeZero())
+		}
+	})
+})
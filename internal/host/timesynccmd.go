@@ -0,0 +1,43 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/filanov/bm-inventory/models"
+)
+
+type timeSyncCmd struct {
+	baseCmd
+}
+
+func NewTimeSyncCmd(log logrus.FieldLogger) *timeSyncCmd {
+	return &timeSyncCmd{
+		baseCmd: baseCmd{log: log},
+	}
+}
+
+// The script prints a time-sync-response. The time of the host clock is taken first, as close as possible to the time
+// the step was sent, and is reported together with the service time that the step was sent at, given as its argument.
+const timeSyncScript = "host_time=$(date +%s.%N); " +
+	"offset=$(chronyc -c tracking 2>/dev/null | cut -d, -f5); " +
+	"sources=$(chronyc -c sources 2>/dev/null | awk -F, 'BEGIN { " +
+	"s[\"*\"]=\"synced\"; s[\"+\"]=\"combined\"; s[\"-\"]=\"not-combined\"; " +
+	"s[\"?\"]=\"unreachable\"; s[\"x\"]=\"falseticker\"; s[\"~\"]=\"variable\" } " +
+	"{ state = ($2 in s) ? s[$2] : \"unknown\"; " +
+	"printf \"%s{\\\"name\\\":\\\"%s\\\",\\\"state\\\":\\\"%s\\\",\\\"stratum\\\":%d}\", sep, $3, state, $4; sep=\",\" }'); " +
+	"[ -n \"${offset}\" ] && offset=\",\\\"offset\\\":${offset}\"; " +
+	"printf '{\"sources\":[%s]%s,\"time\":%s,\"reference_time\":%s}' \"${sources}\" \"${offset}\" \"${host_time}\" \"$1\""
+
+func (t *timeSyncCmd) GetStep(ctx context.Context, host *models.Host) (*models.Step, error) {
+	now := time.Now()
+	step := &models.Step{
+		StepType: models.StepTypeTimeSync,
+		Command:  "bash",
+		Args:     []string{"-c", timeSyncScript, "time_sync", fmt.Sprintf("%d.%09d", now.Unix(), now.Nanosecond())},
+	}
+	return step, nil
+}
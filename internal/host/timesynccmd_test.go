@@ -0,0 +1,31 @@
+package host
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("time sync", func() {
+	It("get_step", func() {
+		id := strfmt.UUID(uuid.New().String())
+		host := getTestHost(id, strfmt.UUID(uuid.New().String()), HostStatusKnown)
+		stepReply, stepErr := NewTimeSyncCmd(getTestLog()).GetStep(context.Background(), &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply.StepType).To(Equal(models.StepTypeTimeSync))
+		Expect(stepReply.Command).To(Equal("bash"))
+		Expect(stepReply.Args).To(HaveLen(4))
+		Expect(stepReply.Args[2]).To(Equal("time_sync"))
+		referenceTime, err := strconv.ParseFloat(stepReply.Args[3], 64)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(referenceTime).Should(BeNumerically("~", float64(time.Now().Unix()), 5))
+		Expect(stepReply.Args[1]).Should(ContainSubstring("chronyc -c tracking"))
+		Expect(stepReply.Args[1]).Should(ContainSubstring("chronyc -c sources"))
+	})
+})
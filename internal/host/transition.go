@@ -55,7 +55,8 @@ func (th *transitionHandler) PostRegisterHost(sw stateswitch.StateSwitch, args s
 		// so we reset the hw info and progress, and start the discovery process again.
 		if host, err := updateHostProgress(params.ctx, log, th.db, th.eventsHandler, sHost.host.ClusterID, *sHost.host.ID, sHost.srcState,
 			swag.StringValue(sHost.host.Status), statusInfoDiscovering, sHost.host.Progress.CurrentStage, "", "",
-			"inventory", "", "discovery_agent_version", params.discoveryAgentVersion, "bootstrap", false); err != nil {
+			"inventory", "", "discovery_agent_version", params.discoveryAgentVersion, "agent_upgrade_attempts", 0,
+			"bootstrap", false); err != nil {
 			return err
 		} else {
 			sHost.host = host
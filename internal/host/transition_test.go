@@ -24,13 +24,15 @@ import (
 
 func createValidatorCfg() *hardware.ValidatorCfg {
 	return &hardware.ValidatorCfg{
-		MinCPUCores:       2,
-		MinCPUCoresWorker: 2,
-		MinCPUCoresMaster: 4,
-		MinDiskSizeGb:     120,
-		MinRamGib:         8,
-		MinRamGibWorker:   8,
-		MinRamGibMaster:   16,
+		MinCPUCores:           2,
+		MinCPUCoresWorker:     2,
+		MinCPUCoresMaster:     4,
+		MinDiskSizeGb:         120,
+		MinRamGib:             8,
+		MinRamGibWorker:       8,
+		MinRamGibMaster:       16,
+		MinNicSpeedMbps:       1000,
+		MaxDiskSyncDurationMs: 10,
 	}
 }
 
@@ -49,7 +51,7 @@ var _ = Describe("RegisterHost", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -284,7 +286,7 @@ var _ = Describe("HostInstallationFailed", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockMetric = metrics.NewMockAPI(ctrl)
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), mockMetric)
+		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, mockMetric)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 		host = getTestHost(hostId, clusterId, "")
@@ -324,7 +326,7 @@ var _ = Describe("Cancel host installation", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEventsHandler = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEventsHandler, nil, nil, createValidatorCfg(), nil)
+		hapi = NewManager(getTestLog(), db, mockEventsHandler, nil, nil, createValidatorCfg(), nil, nil)
 	})
 
 	tests := []struct {
@@ -397,7 +399,7 @@ var _ = Describe("Reset host", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEventsHandler = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEventsHandler, nil, nil, createValidatorCfg(), nil)
+		hapi = NewManager(getTestLog(), db, mockEventsHandler, nil, nil, createValidatorCfg(), nil, nil)
 	})
 
 	tests := []struct {
@@ -470,7 +472,7 @@ var _ = Describe("Install", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -623,7 +625,7 @@ var _ = Describe("Disable", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -754,7 +756,7 @@ var _ = Describe("Enable", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -937,7 +939,7 @@ var _ = Describe("Refresh Host", func() {
 		db = common.PrepareTestDB(dbName, &events.Event{})
 		ctrl = gomock.NewController(GinkgoT())
 		mockEvents = events.NewMockHandler(ctrl)
-		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil)
+		hapi = NewManager(getTestLog(), db, mockEvents, nil, nil, createValidatorCfg(), nil, nil)
 		hostId = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
@@ -1101,7 +1103,7 @@ var _ = Describe("Refresh Host", func() {
 					HasInventory:         {status: ValidationSuccess, messagePattern: "Valid inventory exists for the host"},
 					HasMinCPUCores:       {status: ValidationSuccess, messagePattern: "Sufficient CPU cores"},
 					HasMinMemory:         {status: ValidationFailure, messagePattern: "Require at least 8 GiB RAM, found only 0 GiB"},
-					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GB"},
+					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GiB"},
 					IsMachineCidrDefined: {status: ValidationFailure, messagePattern: "Machine network CIDR is undefined"},
 					IsRoleDefined:        {status: ValidationFailure, messagePattern: "Role is undefined"},
 					HasCPUCoresForRole:   {status: ValidationPending, messagePattern: "Missing inventory or role"},
@@ -1122,7 +1124,7 @@ var _ = Describe("Refresh Host", func() {
 					HasInventory:         {status: ValidationSuccess, messagePattern: "Valid inventory exists for the host"},
 					HasMinCPUCores:       {status: ValidationSuccess, messagePattern: "Sufficient CPU cores"},
 					HasMinMemory:         {status: ValidationFailure, messagePattern: "Require at least 8 GiB RAM, found only 0 GiB"},
-					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GB"},
+					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GiB"},
 					IsMachineCidrDefined: {status: ValidationFailure, messagePattern: "Machine network CIDR is undefined"},
 					IsRoleDefined:        {status: ValidationFailure, messagePattern: "Role is undefined"},
 					HasCPUCoresForRole:   {status: ValidationPending, messagePattern: "Missing inventory or role"},
@@ -1144,7 +1146,7 @@ var _ = Describe("Refresh Host", func() {
 					HasInventory:         {status: ValidationSuccess, messagePattern: "Valid inventory exists for the host"},
 					HasMinCPUCores:       {status: ValidationSuccess, messagePattern: "Sufficient CPU cores"},
 					HasMinMemory:         {status: ValidationFailure, messagePattern: "Require at least 8 GiB RAM, found only 0 GiB"},
-					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GB"},
+					HasMinValidDisks:     {status: ValidationFailure, messagePattern: "Require a disk of at least 120 GiB"},
 					IsMachineCidrDefined: {status: ValidationFailure, messagePattern: "Machine network CIDR is undefined"},
 					IsRoleDefined:        {status: ValidationFailure, messagePattern: "Role is undefined"},
 					HasCPUCoresForRole:   {status: ValidationPending, messagePattern: "Missing inventory or role"},
@@ -2001,6 +2003,8 @@ var _ = Describe("Refresh Host", func() {
 				host.Role = models.HostRole(t.role)
 				host.CheckedInAt = strfmt.DateTime(time.Now())
 				host.RequestedHostname = t.requestedHostname
+				host.Connectivity = connectivityReport(true, otherHostID)
+				host.ConnectivityUpdatedAt = strfmt.DateTime(time.Now())
 				Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
 				otherHost := getTestHost(otherHostID, clusterId, t.otherState)
 				otherHost.RequestedHostname = t.otherRequestedHostname
@@ -2030,6 +2034,356 @@ var _ = Describe("Refresh Host", func() {
 			})
 		}
 	})
+	Context("Connectivity to all hosts", func() {
+		var otherHostID strfmt.UUID
+
+		BeforeEach(func() {
+			otherHostID = strfmt.UUID(uuid.New().String())
+		})
+
+		tests := []struct {
+			name                string
+			srcState            string
+			dstState            string
+			hasReport           bool
+			reportSuccessful    bool
+			connectivityUpdated time.Time
+			statusInfoChecker   statusInfoChecker
+			validationsChecker  *validationsChecker
+		}{
+			{
+				name:                "known to known",
+				srcState:            HostStatusKnown,
+				dstState:            HostStatusKnown,
+				hasReport:           true,
+				reportSuccessful:    true,
+				connectivityUpdated: time.Now(),
+				statusInfoChecker:   makeValueChecker(""),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasConnectivityToAllHosts: {status: ValidationSuccess, messagePattern: "Host has connectivity to all hosts in the cluster"},
+				}),
+			},
+			{
+				name:                "known to insufficient (unreachable host)",
+				srcState:            HostStatusKnown,
+				dstState:            HostStatusInsufficient,
+				hasReport:           true,
+				reportSuccessful:    false,
+				connectivityUpdated: time.Now(),
+				statusInfoChecker:   makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasConnectivityToAllHosts: {status: ValidationFailure, messagePattern: "No connectivity over machine network CIDR 1.2.3.0/24 to hosts: second"},
+				}),
+			},
+			{
+				name:                "known to insufficient (stale report)",
+				srcState:            HostStatusKnown,
+				dstState:            HostStatusInsufficient,
+				hasReport:           true,
+				reportSuccessful:    true,
+				connectivityUpdated: time.Now().Add(-time.Hour),
+				statusInfoChecker:   makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasConnectivityToAllHosts: {status: ValidationPending, messagePattern: "Waiting for an up to date connectivity report to hosts: second"},
+				}),
+			},
+			{
+				name:              "insufficient to insufficient (no report)",
+				srcState:          HostStatusInsufficient,
+				dstState:          HostStatusInsufficient,
+				statusInfoChecker: makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasConnectivityToAllHosts: {status: ValidationPending, messagePattern: "Waiting for an up to date connectivity report to hosts: second"},
+				}),
+			},
+		}
+
+		for i := range tests {
+			t := tests[i]
+			It(t.name, func() {
+				host = getTestHost(hostId, clusterId, t.srcState)
+				host.Inventory = masterInventoryWithHostname("first")
+				if t.hasReport {
+					host.Connectivity = connectivityReport(t.reportSuccessful, otherHostID)
+					host.ConnectivityUpdatedAt = strfmt.DateTime(t.connectivityUpdated)
+				}
+				Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+				otherHost := getTestHost(otherHostID, clusterId, HostStatusKnown)
+				otherHost.Inventory = masterInventoryWithHostname("second")
+				Expect(db.Create(&otherHost).Error).ShouldNot(HaveOccurred())
+				cluster = getTestCluster(clusterId, "1.2.3.0/24")
+				Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+				if t.srcState != t.dstState {
+					mockEvents.EXPECT().AddEvent(gomock.Any(), hostId.String(), common.GetEventSeverityFromHostStatus(t.dstState),
+						gomock.Any(), gomock.Any(), clusterId.String())
+				}
+
+				Expect(hapi.RefreshStatus(ctx, &host, db)).ToNot(HaveOccurred())
+				var resultHost models.Host
+				Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", hostId.String(), clusterId.String()).Error).ToNot(HaveOccurred())
+				Expect(resultHost.Status).To(Equal(&t.dstState))
+				t.statusInfoChecker.check(resultHost.StatusInfo)
+				t.validationsChecker.check(resultHost.ValidationsInfo)
+			})
+		}
+	})
+	Context("Production readiness", func() {
+		var otherHostID strfmt.UUID
+
+		BeforeEach(func() {
+			otherHostID = strfmt.UUID(uuid.New().String())
+		})
+
+		makeInventory := func(hostname, driveType string, mtu, speedMbps int64, manufacturer, productName string) string {
+			inventory := models.Inventory{
+				CPU: &models.CPU{Count: 8},
+				Disks: []*models.Disk{
+					{Name: "sda", SizeBytes: 128849018880, DriveType: driveType, Serial: "serial-a"},
+				},
+				Interfaces: []*models.Interface{
+					{
+						Name:          "eth0",
+						IPV4Addresses: []string{"1.2.3.4/24"},
+						Mtu:           mtu,
+						SpeedMbps:     speedMbps,
+					},
+				},
+				Memory:       &models.Memory{PhysicalBytes: hardware.GibToBytes(16)},
+				Hostname:     hostname,
+				SystemVendor: &models.SystemVendor{Manufacturer: manufacturer, ProductName: productName},
+			}
+			b, err := json.Marshal(&inventory)
+			Expect(err).To(Not(HaveOccurred()))
+			return string(b)
+		}
+
+		tests := []struct {
+			name               string
+			role               string
+			dstState           string
+			driveType          string
+			mtu                int64
+			otherMtu           int64
+			speedMbps          int64
+			productName        string
+			otherProductName   string
+			installationDiskID string
+			imagesStatus       *string
+			disksSpeed         *string
+			statusInfoChecker  statusInfoChecker
+			validationsChecker *validationsChecker
+		}{
+			{
+				name:              "all valid",
+				role:              "master",
+				dstState:          HostStatusKnown,
+				driveType:         "SSD",
+				mtu:               1500,
+				otherMtu:          1500,
+				speedMbps:         10000,
+				productName:       "PowerEdge R640",
+				otherProductName:  "PowerEdge R640",
+				statusInfoChecker: makeValueChecker(""),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasDiskTypeForRole: {status: ValidationSuccess, messagePattern: "Installation disk type SSD is suitable for role master"},
+					IsMtuConsistent:    {status: ValidationSuccess, messagePattern: "Interfaces on machine network CIDR 1.2.3.0/24 have a consistent MTU"},
+					HasMinNicSpeed:     {status: ValidationSuccess, messagePattern: "Sufficient NIC speed on machine network"},
+					IsPlatformUniform:  {status: ValidationSuccess, messagePattern: "Host platform bare metal is uniform in cluster"},
+					IsInstallationDiskValid: {status: ValidationSuccess,
+						messagePattern: "Installation disk is selected automatically"},
+					AreContainerImagesAvailable: {status: ValidationSuccess,
+						messagePattern: "All required container images were pulled successfully"},
+					HasFastEnoughDisk: {status: ValidationSuccess, messagePattern: "Installation disk is fast enough for role master"},
+				}),
+			},
+			{
+				name:              "worker on HDD",
+				role:              "worker",
+				dstState:          HostStatusKnown,
+				driveType:         "HDD",
+				statusInfoChecker: makeValueChecker(""),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasDiskTypeForRole: {status: ValidationSuccess, messagePattern: "Installation disk type HDD is suitable for role worker"},
+					IsMtuConsistent:    {status: ValidationSuccess, messagePattern: "have a consistent MTU"},
+					HasMinNicSpeed:     {status: ValidationSuccess, messagePattern: "Sufficient NIC speed on machine network"},
+					IsPlatformUniform:  {status: ValidationSuccess, messagePattern: "Host platform unknown is uniform in cluster"},
+				}),
+			},
+			{
+				name:              "master on HDD",
+				role:              "master",
+				dstState:          HostStatusInsufficient,
+				driveType:         "HDD",
+				statusInfoChecker: makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasDiskTypeForRole: {status: ValidationFailure, messagePattern: "Installation disk sda is an HDD, role master requires an SSD"},
+				}),
+			},
+			{
+				name:              "MTU mismatch",
+				role:              "worker",
+				dstState:          HostStatusInsufficient,
+				driveType:         "SSD",
+				mtu:               9000,
+				otherMtu:          1500,
+				statusInfoChecker: makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					IsMtuConsistent: {status: ValidationFailure, messagePattern: "Interfaces on machine network CIDR 1.2.3.0/24 have different MTUs: \\[1500 9000\\]"},
+				}),
+			},
+			{
+				name:              "slow NIC",
+				role:              "worker",
+				dstState:          HostStatusInsufficient,
+				driveType:         "SSD",
+				speedMbps:         100,
+				statusInfoChecker: makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasMinNicSpeed: {status: ValidationFailure, messagePattern: "Require NIC speed of at least 1000 Mbps on machine network, found eth0 \\(100 Mbps\\)"},
+				}),
+			},
+			{
+				name:              "virtual host mixed with bare metal",
+				role:              "worker",
+				dstState:          HostStatusInsufficient,
+				driveType:         "SSD",
+				productName:       "KVM",
+				otherProductName:  "PowerEdge R640",
+				statusInfoChecker: makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					IsPlatformUniform: {status: ValidationFailure, messagePattern: "Host platform is virtual while the cluster contains bare metal hosts"},
+				}),
+			},
+			{
+				name:              "release image can't be pulled",
+				role:              "worker",
+				dstState:          HostStatusInsufficient,
+				driveType:         "SSD",
+				imagesStatus:      swag.String(imagesStatus(models.ContainerImageAvailabilityResultFailure)),
+				statusInfoChecker: makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					AreContainerImagesAvailable: {status: ValidationFailure,
+						messagePattern: "Failed to pull the container images quay.io/openshift-release-dev/ocp-release:4.5.0-x86_64"},
+				}),
+			},
+			{
+				name:              "images availability wasn't checked",
+				role:              "worker",
+				dstState:          HostStatusInsufficient,
+				driveType:         "SSD",
+				imagesStatus:      swag.String(""),
+				statusInfoChecker: makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					AreContainerImagesAvailable: {status: ValidationPending, messagePattern: "Missing container images availability check"},
+				}),
+			},
+			{
+				name:              "slow master disk",
+				role:              "master",
+				dstState:          HostStatusInsufficient,
+				driveType:         "SSD",
+				disksSpeed:        swag.String(disksSpeed("/dev/sda", 25, 0)),
+				statusInfoChecker: makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasFastEnoughDisk: {status: ValidationFailure,
+						messagePattern: "Installation disk /dev/sda is too slow for role master, fsync takes 25 ms while at most 10 ms are allowed"},
+				}),
+			},
+			{
+				name:              "master disk speed check failed",
+				role:              "master",
+				dstState:          HostStatusInsufficient,
+				driveType:         "SSD",
+				disksSpeed:        swag.String(disksSpeed("/dev/sda", 0, 1)),
+				statusInfoChecker: makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasFastEnoughDisk: {status: ValidationFailure, messagePattern: "Failed to measure the speed of installation disk /dev/sda"},
+				}),
+			},
+			{
+				name:              "master disk speed wasn't checked",
+				role:              "master",
+				dstState:          HostStatusInsufficient,
+				driveType:         "SSD",
+				disksSpeed:        swag.String(""),
+				statusInfoChecker: makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasFastEnoughDisk: {status: ValidationPending, messagePattern: "Missing inventory, role or installation disk speed"},
+				}),
+			},
+			{
+				name:              "slow worker disk",
+				role:              "worker",
+				dstState:          HostStatusKnown,
+				driveType:         "SSD",
+				disksSpeed:        swag.String(disksSpeed("/dev/sda", 25, 0)),
+				statusInfoChecker: makeValueChecker(""),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					HasFastEnoughDisk: {status: ValidationSuccess, messagePattern: "Installation disk speed is not checked for role worker"},
+				}),
+			},
+			{
+				name:               "selected installation disk",
+				role:               "master",
+				dstState:           HostStatusKnown,
+				driveType:          "SSD",
+				installationDiskID: "serial-a",
+				statusInfoChecker:  makeValueChecker(""),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					IsInstallationDiskValid: {status: ValidationSuccess, messagePattern: "Selected installation disk serial-a is valid"},
+					HasDiskTypeForRole:      {status: ValidationSuccess, messagePattern: "Installation disk type SSD is suitable for role master"},
+				}),
+			},
+			{
+				name:               "selected installation disk no longer in inventory",
+				role:               "master",
+				dstState:           HostStatusInsufficient,
+				driveType:          "SSD",
+				installationDiskID: "serial-b",
+				statusInfoChecker:  makeValueChecker(statusInfoNotReadyForInstall),
+				validationsChecker: makeJsonChecker(map[validationID]validationCheckResult{
+					IsInstallationDiskValid: {status: ValidationFailure,
+						messagePattern: "Selected installation disk serial-b is not one of the valid disks of the host"},
+					HasDiskTypeForRole: {status: ValidationPending, messagePattern: "Missing inventory, role or valid installation disk"},
+				}),
+			},
+		}
+
+		for i := range tests {
+			t := tests[i]
+			It(t.name, func() {
+				host = getTestHost(hostId, clusterId, HostStatusKnown)
+				host.Inventory = makeInventory("first", t.driveType, t.mtu, t.speedMbps, "", t.productName)
+				host.Role = models.HostRole(t.role)
+				host.InstallationDiskID = t.installationDiskID
+				if t.imagesStatus != nil {
+					host.ImagesStatus = *t.imagesStatus
+				}
+				if t.disksSpeed != nil {
+					host.DisksSpeed = *t.disksSpeed
+				}
+				host.Connectivity = connectivityReport(true, otherHostID)
+				host.ConnectivityUpdatedAt = strfmt.DateTime(time.Now())
+				Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+				otherHost := getTestHost(otherHostID, clusterId, HostStatusKnown)
+				otherHost.Inventory = makeInventory("second", "SSD", t.otherMtu, 0, "", t.otherProductName)
+				Expect(db.Create(&otherHost).Error).ShouldNot(HaveOccurred())
+				cluster = getTestCluster(clusterId, "1.2.3.0/24")
+				Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+				if t.dstState != HostStatusKnown {
+					mockEvents.EXPECT().AddEvent(gomock.Any(), hostId.String(), common.GetEventSeverityFromHostStatus(t.dstState),
+						gomock.Any(), gomock.Any(), clusterId.String())
+				}
+
+				Expect(hapi.RefreshStatus(ctx, &host, db)).ToNot(HaveOccurred())
+				var resultHost models.Host
+				Expect(db.Take(&resultHost, "id = ? and cluster_id = ?", hostId.String(), clusterId.String()).Error).ToNot(HaveOccurred())
+				Expect(resultHost.Status).To(Equal(&t.dstState))
+				t.statusInfoChecker.check(resultHost.StatusInfo)
+				t.validationsChecker.check(resultHost.ValidationsInfo)
+			})
+		}
+	})
 	Context("Cluster Errors", func() {
 		for _, srcState := range []string{
 			models.HostStatusInstalling,
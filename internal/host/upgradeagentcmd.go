@@ -0,0 +1,82 @@
+package host
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"text/template"
+
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+
+	"github.com/filanov/bm-inventory/models"
+)
+
+// Number of times the agent of a host is asked to upgrade before the host is left with its agent
+const maxAgentUpgradeAttempts = 3
+
+type upgradeAgentCmd struct {
+	baseCmd
+	db                *gorm.DB
+	instructionConfig InstructionConfig
+}
+
+func NewUpgradeAgentCmd(log logrus.FieldLogger, db *gorm.DB, instructionConfig InstructionConfig) *upgradeAgentCmd {
+	return &upgradeAgentCmd{
+		baseCmd:           baseCmd{log: log},
+		db:                db,
+		instructionConfig: instructionConfig,
+	}
+}
+
+// The image is pulled before the agent service is changed, so that a host that cannot pull it keeps its agent. The
+// drop-in replaces the agent binary of the service and keeps the command line of the service as it is, only the
+// version of the agent is changed to the new image. The service restarts without waiting for the restart to complete
+// since the command runs under the agent.
+const upgradeAgentCmdTemplate = "podman pull {{.IMAGE}} && " +
+	"exec_start=$(systemctl cat agent.service | sed -n 's/^ExecStart=\\(..*\\)$/\\1/p' | tail -1) && " +
+	"[ -n \"${exec_start}\" ] && " +
+	"case \"${exec_start}\" in " +
+	"*--agent-version*) exec_start=$(echo \"${exec_start}\" | sed 's|--agent-version[ =][^ ]*|--agent-version {{.IMAGE}}|') ;; " +
+	"*) exec_start=\"${exec_start} --agent-version {{.IMAGE}}\" ;; " +
+	"esac && " +
+	"mkdir -p /etc/systemd/system/agent.service.d && " +
+	"printf '[Service]\\nExecStartPre=\\nExecStartPre=podman run --privileged --rm -v /usr/local/bin:/hostbin {{.IMAGE}} cp /usr/bin/agent /hostbin\\n" +
+	"ExecStart=\\nExecStart=%s\\n' \"${exec_start}\" " +
+	"> /etc/systemd/system/agent.service.d/50-upgrade.conf && " +
+	"systemctl daemon-reload && systemctl restart --no-block agent.service"
+
+func (u *upgradeAgentCmd) GetStep(ctx context.Context, host *models.Host) (*models.Step, error) {
+	if !u.instructionConfig.IsAgentUpgradeNeeded(host.DiscoveryAgentVersion) {
+		return nil, nil
+	}
+	if host.AgentUpgradeAttempts >= maxAgentUpgradeAttempts {
+		u.log.Debugf("Stopped upgrading the agent of host %s in cluster %s after %d attempts",
+			host.ID.String(), host.ClusterID.String(), host.AgentUpgradeAttempts)
+		return nil, nil
+	}
+
+	data := map[string]string{
+		"IMAGE": strings.TrimSpace(u.instructionConfig.AgentDockerImg),
+	}
+	t, err := template.New("cmd").Parse(upgradeAgentCmdTemplate)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err = t.Execute(buf, data); err != nil {
+		return nil, err
+	}
+
+	host.AgentUpgradeAttempts++
+	if err = u.db.Model(&models.Host{}).Where("id = ? and cluster_id = ?", host.ID.String(), host.ClusterID.String()).
+		Update("agent_upgrade_attempts", host.AgentUpgradeAttempts).Error; err != nil {
+		return nil, err
+	}
+	step := &models.Step{
+		StepType: models.StepTypeUpgradeAgent,
+		Command:  "bash",
+		Args:     []string{"-c", buf.String()},
+	}
+	return step, nil
+}
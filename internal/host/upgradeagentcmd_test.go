@@ -0,0 +1,79 @@
+package host
+
+import (
+	"context"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("upgrade agent", func() {
+	var (
+		cmd    *upgradeAgentCmd
+		host   models.Host
+		db     *gorm.DB
+		dbName = "upgrade_agent_cmd"
+	)
+
+	BeforeEach(func() {
+		db = common.PrepareTestDB(dbName)
+		cmd = NewUpgradeAgentCmd(getTestLog(), db, InstructionConfig{
+			AgentVersionConfig: AgentVersionConfig{AgentDockerImg: "quay.io/ocpmetal/agent:v2.0.0"},
+			InventoryURL:       "10.35.59.36",
+			InventoryPort:      "30485",
+		})
+		host = getTestHost(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()), HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("current agent", func() {
+		host.DiscoveryAgentVersion = "quay.io/ocpmetal/agent:v2.0.0"
+		step, err := cmd.GetStep(context.Background(), &host)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(step).To(BeNil())
+	})
+
+	It("newer agent", func() {
+		host.DiscoveryAgentVersion = "quay.io/ocpmetal/agent:v2.1.0"
+		step, err := cmd.GetStep(context.Background(), &host)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(step).To(BeNil())
+	})
+
+	It("outdated agent", func() {
+		host.DiscoveryAgentVersion = "quay.io/ocpmetal/agent:v1.0.0"
+		step, err := cmd.GetStep(context.Background(), &host)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(step.StepType).To(Equal(models.StepTypeUpgradeAgent))
+		Expect(step.Command).To(Equal("bash"))
+		Expect(step.Args).To(HaveLen(2))
+		Expect(step.Args[1]).To(HavePrefix("podman pull quay.io/ocpmetal/agent:v2.0.0 && "))
+		Expect(step.Args[1]).To(ContainSubstring("systemctl cat agent.service"))
+		Expect(step.Args[1]).To(ContainSubstring("--agent-version quay.io/ocpmetal/agent:v2.0.0"))
+		Expect(step.Args[1]).NotTo(ContainSubstring("--host"))
+		Expect(step.Args[1]).To(HaveSuffix("systemctl daemon-reload && systemctl restart --no-block agent.service"))
+		Expect(getHost(*host.ID, host.ClusterID, db).AgentUpgradeAttempts).To(Equal(int64(1)))
+	})
+
+	It("attempts exhausted", func() {
+		host.DiscoveryAgentVersion = "quay.io/ocpmetal/agent:v1.0.0"
+		for i := 0; i < maxAgentUpgradeAttempts; i++ {
+			step, err := cmd.GetStep(context.Background(), &host)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(step).NotTo(BeNil())
+		}
+		step, err := cmd.GetStep(context.Background(), &host)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(step).To(BeNil())
+		Expect(getHost(*host.ID, host.ClusterID, db).AgentUpgradeAttempts).To(Equal(int64(maxAgentUpgradeAttempts)))
+	})
+})
@@ -12,29 +12,42 @@ import (
 type validationID models.HostValidationID
 
 const (
-	IsConnected          = validationID(models.HostValidationIDConnected)
-	HasInventory         = validationID(models.HostValidationIDHasInventory)
-	IsMachineCidrDefined = validationID(models.HostValidationIDMachineCidrDefined)
-	BelongsToMachineCidr = validationID(models.HostValidationIDBelongsToMachineCidr)
-	HasMinCPUCores       = validationID(models.HostValidationIDHasMinCPUCores)
-	HasMinValidDisks     = validationID(models.HostValidationIDHasMinValidDisks)
-	HasMinMemory         = validationID(models.HostValidationIDHasMinMemory)
-	HasCPUCoresForRole   = validationID(models.HostValidationIDHasCPUCoresForRole)
-	HasMemoryForRole     = validationID(models.HostValidationIDHasMemoryForRole)
-	IsHostnameUnique     = validationID(models.HostValidationIDHostnameUnique)
-	IsRoleDefined        = validationID(models.HostValidationIDRoleDefined)
-	IsHostnameValid      = validationID(models.HostValidationIDHostnameValid)
+	IsConnected                 = validationID(models.HostValidationIDConnected)
+	HasInventory                = validationID(models.HostValidationIDHasInventory)
+	IsMachineCidrDefined        = validationID(models.HostValidationIDMachineCidrDefined)
+	BelongsToMachineCidr        = validationID(models.HostValidationIDBelongsToMachineCidr)
+	HasMinCPUCores              = validationID(models.HostValidationIDHasMinCPUCores)
+	HasMinValidDisks            = validationID(models.HostValidationIDHasMinValidDisks)
+	HasMinMemory                = validationID(models.HostValidationIDHasMinMemory)
+	HasCPUCoresForRole          = validationID(models.HostValidationIDHasCPUCoresForRole)
+	HasMemoryForRole            = validationID(models.HostValidationIDHasMemoryForRole)
+	IsHostnameUnique            = validationID(models.HostValidationIDHostnameUnique)
+	IsRoleDefined               = validationID(models.HostValidationIDRoleDefined)
+	IsHostnameValid             = validationID(models.HostValidationIDHostnameValid)
+	HasConnectivityToAllHosts   = validationID(models.HostValidationIDHasConnectivityToAllHosts)
+	HasDiskTypeForRole          = validationID(models.HostValidationIDHasDiskTypeForRole)
+	IsMtuConsistent             = validationID(models.HostValidationIDMtuConsistent)
+	HasMinNicSpeed              = validationID(models.HostValidationIDHasMinNicSpeed)
+	IsPlatformUniform           = validationID(models.HostValidationIDPlatformUniform)
+	IsInstallationDiskValid     = validationID(models.HostValidationIDValidInstallationDisk)
+	AreContainerImagesAvailable = validationID(models.HostValidationIDContainerImagesAvailable)
+	HasFastEnoughDisk           = validationID(models.HostValidationIDSufficientInstallationDiskSpeed)
+	IsAgentVersionSupported     = validationID(models.HostValidationIDAgentVersionSupported)
 )
 
 func (v validationID) category() (string, error) {
 	switch v {
-	case IsConnected, IsMachineCidrDefined, BelongsToMachineCidr:
+	case IsConnected, IsMachineCidrDefined, BelongsToMachineCidr, HasConnectivityToAllHosts, IsMtuConsistent,
+		HasMinNicSpeed, AreContainerImagesAvailable:
 		return "network", nil
 	case HasInventory, HasMinCPUCores, HasMinValidDisks, HasMinMemory,
-		HasCPUCoresForRole, HasMemoryForRole, IsHostnameUnique, IsHostnameValid:
+		HasCPUCoresForRole, HasMemoryForRole, IsHostnameUnique, IsHostnameValid, HasDiskTypeForRole,
+		IsPlatformUniform, IsInstallationDiskValid, HasFastEnoughDisk:
 		return "hardware", nil
 	case IsRoleDefined:
 		return "role", nil
+	case IsAgentVersionSupported:
+		return "agent", nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected validation id %s", string(v)))
 }
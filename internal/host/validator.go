@@ -4,12 +4,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/thoas/go-funk"
 
 	"github.com/alecthomas/units"
 
+	"github.com/filanov/bm-inventory/internal/connectivity"
 	"github.com/filanov/bm-inventory/internal/network"
 
 	"github.com/filanov/bm-inventory/internal/hardware"
@@ -17,6 +20,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
 
 	"github.com/filanov/bm-inventory/models"
@@ -55,10 +59,6 @@ type validation struct {
 	formatter validationStringFormatter
 }
 
-func gibToBytes(gib int64) int64 {
-	return gib * int64(units.GiB)
-}
-
 func bytesToGiB(bytes int64) int64 {
 	return bytes / int64(units.GiB)
 }
@@ -134,8 +134,9 @@ func boolValue(b bool) validationStatus {
 }
 
 type validator struct {
-	log            logrus.FieldLogger
-	hwValidatorCfg *hardware.ValidatorCfg
+	log             logrus.FieldLogger
+	hwValidatorCfg  *hardware.ValidatorCfg
+	agentVersionCfg *AgentVersionConfig
 }
 
 func (v *validator) isConnected(c *validationContext) validationStatus {
@@ -192,7 +193,7 @@ func (v *validator) hasMinMemory(c *validationContext) validationStatus {
 	if c.inventory == nil {
 		return ValidationPending
 	}
-	return boolValue(c.inventory.Memory.PhysicalBytes >= gibToBytes(v.hwValidatorCfg.MinRamGib))
+	return boolValue(c.inventory.Memory.PhysicalBytes >= hardware.GibToBytes(v.hwValidatorCfg.MinRamGib))
 }
 
 func (v *validator) printHasMinMemory(c *validationContext, status validationStatus) string {
@@ -213,7 +214,7 @@ func (v *validator) hasMinValidDisks(c *validationContext) validationStatus {
 	if c.inventory == nil {
 		return ValidationPending
 	}
-	disks := hardware.ListValidDisks(c.inventory, gibToBytes(v.hwValidatorCfg.MinDiskSizeGb))
+	disks := hardware.ListValidDisks(c.inventory, hardware.GibToBytes(v.hwValidatorCfg.MinDiskSizeGb))
 	return boolValue(len(disks) > 0)
 }
 
@@ -222,7 +223,7 @@ func (v *validator) printHasMinValidDisks(c *validationContext, status validatio
 	case ValidationSuccess:
 		return "Sufficient disk capacity"
 	case ValidationFailure:
-		return fmt.Sprintf("Require a disk of at least %d GB", v.hwValidatorCfg.MinDiskSizeGb)
+		return fmt.Sprintf("Require a disk of at least %d GiB", v.hwValidatorCfg.MinDiskSizeGb)
 	case ValidationPending:
 		return "Missing inventory"
 	default:
@@ -306,9 +307,9 @@ func (v *validator) hasMemoryForRole(c *validationContext) validationStatus {
 	}
 	switch c.host.Role {
 	case models.HostRoleMaster:
-		return boolValue(c.inventory.Memory.PhysicalBytes >= gibToBytes(v.hwValidatorCfg.MinRamGibMaster))
+		return boolValue(c.inventory.Memory.PhysicalBytes >= hardware.GibToBytes(v.hwValidatorCfg.MinRamGibMaster))
 	case models.HostRoleWorker:
-		return boolValue(c.inventory.Memory.PhysicalBytes >= gibToBytes(v.hwValidatorCfg.MinRamGibWorker))
+		return boolValue(c.inventory.Memory.PhysicalBytes >= hardware.GibToBytes(v.hwValidatorCfg.MinRamGibWorker))
 	default:
 		v.log.Errorf("Unexpected role %s", c.host.Role)
 		return ValidationError
@@ -340,19 +341,48 @@ func (v *validator) printHasMemoryForRole(c *validationContext, status validatio
 	}
 }
 
+// holdsVips returns whether the host may hold the VIPs, so it must be in the machine network of the VIPs
+func holdsVips(host *models.Host) bool {
+	return host.Role == models.HostRoleMaster || host.Role == models.HostRoleBootstrap
+}
+
+// joinCidrs joins CIDRs for a message, e.g. "A, B and C"
+func joinCidrs(cidrs []string) string {
+	if len(cidrs) <= 1 {
+		return strings.Join(cidrs, "")
+	}
+	return fmt.Sprintf("%s and %s", strings.Join(cidrs[:len(cidrs)-1], ", "), cidrs[len(cidrs)-1])
+}
+
 func (v *validator) belongsToMachineCidr(c *validationContext) validationStatus {
 	if c.inventory == nil || c.cluster.MachineNetworkCidr == "" {
 		return ValidationPending
 	}
+	if holdsVips(c.host) {
+		return boolValue(network.IsHostInVipNetwork(v.log, c.cluster, c.host))
+	}
 	return boolValue(network.IsHostInMachineNetCidr(v.log, c.cluster, c.host))
 }
 
 func (v *validator) printBelongsToMachineCidr(c *validationContext, status validationStatus) string {
+	machineCidrs := joinCidrs(network.MachineNetworkCidrs(&c.cluster.Cluster))
 	switch status {
 	case ValidationSuccess:
-		return fmt.Sprintf("Host belongs to machine network CIDR %s", c.cluster.MachineNetworkCidr)
+		if c.cluster.AdditionalMachineNetworkCidrs != "" {
+			return fmt.Sprintf("Host belongs to machine network CIDR %s",
+				network.GetHostMachineNetwork(v.log, c.cluster, c.host))
+		}
+		return fmt.Sprintf("Host belongs to machine network CIDR %s", machineCidrs)
 	case ValidationFailure:
-		return fmt.Sprintf("Host does not belong to machine network CIDR %s", c.cluster.MachineNetworkCidr)
+		if holdsVips(c.host) && c.cluster.AdditionalMachineNetworkCidrs != "" {
+			vipCidrs := c.cluster.MachineNetworkCidr
+			if c.cluster.SecondaryMachineNetworkCidr != "" {
+				vipCidrs = fmt.Sprintf("%s and %s", vipCidrs, c.cluster.SecondaryMachineNetworkCidr)
+			}
+			return fmt.Sprintf("Host with role %s does not belong to machine network CIDR %s of the VIPs",
+				c.host.Role, vipCidrs)
+		}
+		return fmt.Sprintf("Host does not belong to machine network CIDR %s", machineCidrs)
 	case ValidationPending:
 		return "Missing inventory or machine network CIDR"
 	default:
@@ -367,12 +397,18 @@ func getRealHostname(host *models.Host, inventory *models.Inventory) string {
 	return inventory.Hostname
 }
 
+// isHostnameUnique compares the hostname with the other hosts of the cluster. The hosts that were flagged as
+// registered again by the machine of another host are not compared, the hostname of their machine is expected to
+// repeat.
 func (v *validator) isHostnameUnique(c *validationContext) validationStatus {
 	if c.inventory == nil {
 		return ValidationPending
 	}
 	realHostname := getRealHostname(c.host, c.inventory)
 	for _, h := range c.cluster.Hosts {
+		if h.DuplicateOf != "" || c.host.DuplicateOf == *h.ID {
+			continue
+		}
 		if h.ID.String() != c.host.ID.String() && h.Inventory != "" {
 			var otherInventory models.Inventory
 			if err := json.Unmarshal([]byte(h.Inventory), &otherInventory); err != nil {
@@ -420,3 +456,379 @@ func (v *validator) printHostnameValid(c *validationContext, status validationSt
 		return fmt.Sprintf("Unexpected status %s", status)
 	}
 }
+
+func (v *validator) getConnectivityEntries(c *validationContext, status string) []*models.ConnectivityMatrixEntry {
+	ret := make([]*models.ConnectivityMatrixEntry, 0)
+	for _, entry := range connectivity.GetHostConnectivity(v.log, c.cluster, c.host) {
+		if entry.Status == status {
+			ret = append(ret, entry)
+		}
+	}
+	return ret
+}
+
+func (v *validator) hasConnectivityToAllHosts(c *validationContext) validationStatus {
+	if c.inventory == nil || c.cluster.MachineNetworkCidr == "" {
+		return ValidationPending
+	}
+	if len(v.getConnectivityEntries(c, connectivity.StatusFailure)) > 0 {
+		return ValidationFailure
+	}
+	if len(v.getConnectivityEntries(c, connectivity.StatusPending)) > 0 {
+		return ValidationPending
+	}
+	return ValidationSuccess
+}
+
+func (v *validator) getConnectivityTargetNames(c *validationContext, status string) string {
+	names := make([]string, 0)
+	for _, entry := range v.getConnectivityEntries(c, status) {
+		for _, h := range c.cluster.Hosts {
+			if h.ID.String() == entry.TargetHostID.String() {
+				names = append(names, common.GetHostnameForMsg(h))
+			}
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func (v *validator) printHasConnectivityToAllHosts(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "Host has connectivity to all hosts in the cluster"
+	case ValidationFailure:
+		return fmt.Sprintf("No connectivity over machine network CIDR %s to hosts: %s",
+			joinCidrs(network.MachineNetworkCidrs(&c.cluster.Cluster)),
+			v.getConnectivityTargetNames(c, connectivity.StatusFailure))
+	case ValidationPending:
+		if c.inventory == nil || c.cluster.MachineNetworkCidr == "" {
+			return "Missing inventory or machine network CIDR"
+		}
+		return fmt.Sprintf("Waiting for an up to date connectivity report to hosts: %s",
+			v.getConnectivityTargetNames(c, connectivity.StatusPending))
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) getInstallationDisk(c *validationContext) *models.Disk {
+	disks := hardware.ListValidDisks(c.inventory, hardware.GibToBytes(v.hwValidatorCfg.MinDiskSizeGb))
+	return hardware.SelectInstallationDisk(disks, c.host.InstallationDiskID)
+}
+
+func (v *validator) hasDiskTypeForRole(c *validationContext) validationStatus {
+	if c.inventory == nil || c.host.Role == "" {
+		return ValidationPending
+	}
+	disk := v.getInstallationDisk(c)
+	if disk == nil {
+		return ValidationPending
+	}
+	// etcd is sensitive to disk latency, therefore masters must not be installed on spinning disks
+	return boolValue(c.host.Role != models.HostRoleMaster || disk.DriveType != "HDD")
+}
+
+func (v *validator) printHasDiskTypeForRole(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return fmt.Sprintf("Installation disk type %s is suitable for role %s", v.getInstallationDisk(c).DriveType, c.host.Role)
+	case ValidationFailure:
+		return fmt.Sprintf("Installation disk %s is an HDD, role %s requires an SSD", v.getInstallationDisk(c).Name, c.host.Role)
+	case ValidationPending:
+		return "Missing inventory, role or valid installation disk"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+// hostMachineCidr returns the machine network of the cluster that the host belongs to, the machine network of the
+// VIPs when it belongs to none
+func (v *validator) hostMachineCidr(c *validationContext) string {
+	if cidr := network.GetHostMachineNetwork(v.log, c.cluster, c.host); cidr != "" {
+		return cidr
+	}
+	return c.cluster.MachineNetworkCidr
+}
+
+// otherInventories returns the inventories of the other hosts of the cluster that the host is compared with, disabled
+// hosts are left out
+func (v *validator) otherInventories(c *validationContext) []*models.Inventory {
+	ret := make([]*models.Inventory, 0)
+	for _, h := range c.cluster.Hosts {
+		if h.ID.String() == c.host.ID.String() || h.Inventory == "" ||
+			swag.StringValue(h.Status) == models.HostStatusDisabled {
+			continue
+		}
+		var otherInventory models.Inventory
+		if err := json.Unmarshal([]byte(h.Inventory), &otherInventory); err != nil {
+			v.log.WithError(err).Warnf("Illegal inventory for host %s", h.ID.String())
+			continue
+		}
+		ret = append(ret, &otherInventory)
+	}
+	return ret
+}
+
+func (v *validator) getMachineCidrMtus(c *validationContext) []int64 {
+	mtus := make([]int64, 0)
+	machineCidr := v.hostMachineCidr(c)
+	addInventory := func(inventory *models.Inventory) {
+		for _, intf := range network.GetMachineCidrInterfaces(inventory, machineCidr) {
+			if intf.Mtu > 0 && !funk.ContainsInt64(mtus, intf.Mtu) {
+				mtus = append(mtus, intf.Mtu)
+			}
+		}
+	}
+	addInventory(c.inventory)
+	if len(mtus) == 0 {
+		// The MTU of this host is unknown, so there is nothing to compare
+		return mtus
+	}
+	for _, otherInventory := range v.otherInventories(c) {
+		addInventory(otherInventory)
+	}
+	sort.Slice(mtus, func(i, j int) bool { return mtus[i] < mtus[j] })
+	return mtus
+}
+
+func (v *validator) isMtuConsistent(c *validationContext) validationStatus {
+	if c.inventory == nil || c.cluster.MachineNetworkCidr == "" {
+		return ValidationPending
+	}
+	return boolValue(len(v.getMachineCidrMtus(c)) <= 1)
+}
+
+func (v *validator) printMtuConsistent(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return fmt.Sprintf("Interfaces on machine network CIDR %s have a consistent MTU", v.hostMachineCidr(c))
+	case ValidationFailure:
+		return fmt.Sprintf("Interfaces on machine network CIDR %s have different MTUs: %v", v.hostMachineCidr(c),
+			v.getMachineCidrMtus(c))
+	case ValidationPending:
+		return "Missing inventory or machine network CIDR"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) getSlowMachineCidrInterfaces(c *validationContext) []string {
+	ret := make([]string, 0)
+	for _, intf := range network.GetMachineCidrInterfaces(c.inventory, v.hostMachineCidr(c)) {
+		// Some NICs (e.g. virtio) do not report their speed, these are not validated
+		if intf.SpeedMbps > 0 && intf.SpeedMbps < v.hwValidatorCfg.MinNicSpeedMbps {
+			ret = append(ret, fmt.Sprintf("%s (%d Mbps)", intf.Name, intf.SpeedMbps))
+		}
+	}
+	return ret
+}
+
+func (v *validator) hasMinNicSpeed(c *validationContext) validationStatus {
+	if c.inventory == nil || c.cluster.MachineNetworkCidr == "" {
+		return ValidationPending
+	}
+	return boolValue(len(v.getSlowMachineCidrInterfaces(c)) == 0)
+}
+
+func (v *validator) printHasMinNicSpeed(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "Sufficient NIC speed on machine network"
+	case ValidationFailure:
+		return fmt.Sprintf("Require NIC speed of at least %d Mbps on machine network, found %s",
+			v.hwValidatorCfg.MinNicSpeedMbps, strings.Join(v.getSlowMachineCidrInterfaces(c), ", "))
+	case ValidationPending:
+		return "Missing inventory or machine network CIDR"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) getOtherPlatforms(c *validationContext) []string {
+	platforms := make([]string, 0)
+	for _, otherInventory := range v.otherInventories(c) {
+		platform := hardware.GetPlatform(otherInventory)
+		if platform != hardware.PlatformUnknown && !funk.ContainsString(platforms, platform) {
+			platforms = append(platforms, platform)
+		}
+	}
+	return platforms
+}
+
+func (v *validator) isPlatformUniform(c *validationContext) validationStatus {
+	if c.inventory == nil {
+		return ValidationPending
+	}
+	platform := hardware.GetPlatform(c.inventory)
+	if platform == hardware.PlatformUnknown {
+		return ValidationSuccess
+	}
+	for _, other := range v.getOtherPlatforms(c) {
+		if other != platform {
+			return ValidationFailure
+		}
+	}
+	return ValidationSuccess
+}
+
+func (v *validator) printPlatformUniform(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return fmt.Sprintf("Host platform %s is uniform in cluster", hardware.GetPlatform(c.inventory))
+	case ValidationFailure:
+		return fmt.Sprintf("Host platform is %s while the cluster contains %s hosts", hardware.GetPlatform(c.inventory),
+			strings.Join(v.getOtherPlatforms(c), ", "))
+	case ValidationPending:
+		return "Missing inventory"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) isInstallationDiskValid(c *validationContext) validationStatus {
+	if c.host.InstallationDiskID == "" {
+		return ValidationSuccess
+	}
+	if c.inventory == nil {
+		return ValidationPending
+	}
+	// The inventory may change after the disk was selected, so the selection is re-validated on every refresh
+	return boolValue(v.getInstallationDisk(c) != nil)
+}
+
+func (v *validator) printInstallationDiskValid(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if c.host.InstallationDiskID == "" {
+			return "Installation disk is selected automatically"
+		}
+		return fmt.Sprintf("Selected installation disk %s is valid", c.host.InstallationDiskID)
+	case ValidationFailure:
+		return fmt.Sprintf("Selected installation disk %s is not one of the valid disks of the host", c.host.InstallationDiskID)
+	case ValidationPending:
+		return "Missing inventory"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) getUnavailableImages(c *validationContext) ([]string, error) {
+	var images []*models.ContainerImageAvailability
+	if err := json.Unmarshal([]byte(c.host.ImagesStatus), &images); err != nil {
+		return nil, err
+	}
+	ret := make([]string, 0)
+	for _, image := range images {
+		if image.Result != models.ContainerImageAvailabilityResultSuccess {
+			ret = append(ret, image.Name)
+		}
+	}
+	return ret, nil
+}
+
+func (v *validator) areContainerImagesAvailable(c *validationContext) validationStatus {
+	if c.host.ImagesStatus == "" {
+		return ValidationPending
+	}
+	images, err := v.getUnavailableImages(c)
+	if err != nil {
+		v.log.WithError(err).Errorf("Failed to parse images status of host %s", c.host.ID.String())
+		return ValidationError
+	}
+	return boolValue(len(images) == 0)
+}
+
+func (v *validator) printContainerImagesAvailable(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		return "All required container images were pulled successfully"
+	case ValidationFailure:
+		images, _ := v.getUnavailableImages(c)
+		return fmt.Sprintf("Failed to pull the container images %s", strings.Join(images, ", "))
+	case ValidationPending:
+		return "Missing container images availability check"
+	case ValidationError:
+		return "Parse error for container images availability"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+func (v *validator) getInstallationDiskSpeed(c *validationContext) *models.DiskSpeed {
+	disk := v.getInstallationDisk(c)
+	if disk == nil {
+		return nil
+	}
+	speeds, err := getDisksSpeed(c.host.DisksSpeed)
+	if err != nil {
+		v.log.WithError(err).Errorf("Failed to parse disks speed of host %s", c.host.ID.String())
+		return nil
+	}
+	return findDiskSpeed(speeds, hardware.DevicePath(disk))
+}
+
+func (v *validator) hasFastEnoughDisk(c *validationContext) validationStatus {
+	if c.inventory == nil || c.host.Role == "" {
+		return ValidationPending
+	}
+	if c.host.Role != models.HostRoleMaster {
+		return ValidationSuccess
+	}
+	speed := v.getInstallationDiskSpeed(c)
+	if speed == nil {
+		return ValidationPending
+	}
+	return boolValue(speed.ExitCode == 0 && speed.IoSyncDuration <= v.hwValidatorCfg.MaxDiskSyncDurationMs)
+}
+
+func (v *validator) printHasFastEnoughDisk(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if c.host.Role != models.HostRoleMaster {
+			return fmt.Sprintf("Installation disk speed is not checked for role %s", c.host.Role)
+		}
+		return "Installation disk is fast enough for role master"
+	case ValidationFailure:
+		speed := v.getInstallationDiskSpeed(c)
+		if speed.ExitCode != 0 {
+			return fmt.Sprintf("Failed to measure the speed of installation disk %s", speed.Path)
+		}
+		return fmt.Sprintf("Installation disk %s is too slow for role master, fsync takes %d ms while at most %d ms are allowed",
+			speed.Path, speed.IoSyncDuration, v.hwValidatorCfg.MaxDiskSyncDurationMs)
+	case ValidationPending:
+		return "Missing inventory, role or installation disk speed"
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
+
+// An agent that could not be upgraded is not trusted for the installation, even when it is supported
+func (v *validator) isAgentUpgradeExhausted(c *validationContext) bool {
+	return v.agentVersionCfg.IsAgentUpgradeNeeded(c.host.DiscoveryAgentVersion) &&
+		c.host.AgentUpgradeAttempts >= maxAgentUpgradeAttempts
+}
+
+func (v *validator) isAgentVersionSupported(c *validationContext) validationStatus {
+	return boolValue(v.agentVersionCfg.GetAgentVersionStatus(c.host.DiscoveryAgentVersion) != models.AgentVersionStatusUnsupported &&
+		!v.isAgentUpgradeExhausted(c))
+}
+
+func (v *validator) printAgentVersionSupported(c *validationContext, status validationStatus) string {
+	switch status {
+	case ValidationSuccess:
+		if v.agentVersionCfg.IsAgentUpgradeNeeded(c.host.DiscoveryAgentVersion) {
+			return fmt.Sprintf("Discovery agent %s is supported and is being upgraded to %s",
+				c.host.DiscoveryAgentVersion, v.agentVersionCfg.AgentDockerImg)
+		}
+		return "Discovery agent version is supported"
+	case ValidationFailure:
+		if v.isAgentUpgradeExhausted(c) {
+			return fmt.Sprintf("Discovery agent %s could not be upgraded to %s after %d attempts",
+				c.host.DiscoveryAgentVersion, v.agentVersionCfg.AgentDockerImg, c.host.AgentUpgradeAttempts)
+		}
+		return fmt.Sprintf("Discovery agent %s is older than the minimum version %s, the host is being upgraded to %s",
+			c.host.DiscoveryAgentVersion, v.agentVersionCfg.MinimumAgentVersion, v.agentVersionCfg.AgentDockerImg)
+	default:
+		return fmt.Sprintf("Unexpected status %s", status)
+	}
+}
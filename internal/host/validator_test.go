@@ -0,0 +1,162 @@
+package host
+
+import (
+	"encoding/json"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("additional machine networks validation", func() {
+	var (
+		v       validator
+		cluster *common.Cluster
+	)
+
+	createContext := func(role models.HostRole, addresses ...string) *validationContext {
+		inventory := &models.Inventory{
+			Interfaces: []*models.Interface{{Name: "eth0", IPV4Addresses: addresses}},
+		}
+		b, err := json.Marshal(inventory)
+		Expect(err).ShouldNot(HaveOccurred())
+		return &validationContext{
+			host:      &models.Host{Role: role, Inventory: string(b)},
+			cluster:   cluster,
+			inventory: inventory,
+		}
+	}
+
+	BeforeEach(func() {
+		v = validator{log: getTestLog(), hwValidatorCfg: createValidatorCfg()}
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			MachineNetworkCidr:            "1.2.3.0/24",
+			AdditionalMachineNetworkCidrs: "1.2.4.0/24,1.2.5.0/24",
+		}}
+	})
+
+	It("worker in an additional machine network", func() {
+		c := createContext(models.HostRoleWorker, "1.2.5.10/24")
+		Expect(v.belongsToMachineCidr(c)).To(Equal(ValidationSuccess))
+		Expect(v.printBelongsToMachineCidr(c, ValidationSuccess)).To(Equal("Host belongs to machine network CIDR 1.2.5.0/24"))
+		Expect(v.hostMachineCidr(c)).To(Equal("1.2.5.0/24"))
+	})
+
+	It("master in an additional machine network", func() {
+		c := createContext(models.HostRoleMaster, "1.2.4.10/24")
+		Expect(v.belongsToMachineCidr(c)).To(Equal(ValidationFailure))
+		Expect(v.printBelongsToMachineCidr(c, ValidationFailure)).To(Equal(
+			"Host with role master does not belong to machine network CIDR 1.2.3.0/24 of the VIPs"))
+	})
+
+	It("master in the machine network of the VIPs", func() {
+		c := createContext(models.HostRoleMaster, "1.2.3.10/24")
+		Expect(v.belongsToMachineCidr(c)).To(Equal(ValidationSuccess))
+		Expect(v.printBelongsToMachineCidr(c, ValidationSuccess)).To(Equal("Host belongs to machine network CIDR 1.2.3.0/24"))
+	})
+
+	It("host in no machine network", func() {
+		c := createContext(models.HostRoleWorker, "1.2.6.10/24")
+		Expect(v.belongsToMachineCidr(c)).To(Equal(ValidationFailure))
+		Expect(v.printBelongsToMachineCidr(c, ValidationFailure)).To(Equal(
+			"Host does not belong to machine network CIDR 1.2.3.0/24, 1.2.4.0/24 and 1.2.5.0/24"))
+		Expect(v.hostMachineCidr(c)).To(Equal("1.2.3.0/24"))
+	})
+
+	It("without additional machine networks", func() {
+		cluster.AdditionalMachineNetworkCidrs = ""
+		c := createContext(models.HostRoleWorker, "1.2.4.10/24")
+		Expect(v.belongsToMachineCidr(c)).To(Equal(ValidationFailure))
+		Expect(v.printBelongsToMachineCidr(c, ValidationFailure)).To(Equal("Host does not belong to machine network CIDR 1.2.3.0/24"))
+	})
+})
+
+var _ = Describe("hostname unique validation", func() {
+	var v validator
+
+	createHost := func(hostname string) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		b, err := json.Marshal(&models.Inventory{Hostname: hostname})
+		Expect(err).ShouldNot(HaveOccurred())
+		return &models.Host{ID: &id, Inventory: string(b)}
+	}
+
+	createContext := func(host *models.Host, hosts ...*models.Host) *validationContext {
+		var inventory models.Inventory
+		Expect(json.Unmarshal([]byte(host.Inventory), &inventory)).ShouldNot(HaveOccurred())
+		return &validationContext{
+			host:      host,
+			cluster:   &common.Cluster{Cluster: models.Cluster{Hosts: append(hosts, host)}},
+			inventory: &inventory,
+		}
+	}
+
+	BeforeEach(func() {
+		v = validator{log: getTestLog(), hwValidatorCfg: createValidatorCfg()}
+	})
+
+	It("same hostname", func() {
+		Expect(v.isHostnameUnique(createContext(createHost("h1"), createHost("h1")))).To(Equal(ValidationFailure))
+	})
+
+	It("flagged duplicate is not compared", func() {
+		original := createHost("h1")
+		duplicate := createHost("h1")
+		duplicate.DuplicateOf = *original.ID
+		Expect(v.isHostnameUnique(createContext(original, duplicate))).To(Equal(ValidationSuccess))
+		Expect(v.isHostnameUnique(createContext(duplicate, original))).To(Equal(ValidationSuccess))
+		Expect(v.isHostnameUnique(createContext(duplicate, original, createHost("h1")))).To(Equal(ValidationFailure))
+	})
+})
+
+var _ = Describe("MTU and platform consistency validations", func() {
+	var v validator
+
+	createHost := func(status string, mtu int64, manufacturer string) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		b, err := json.Marshal(&models.Inventory{
+			Interfaces:   []*models.Interface{{Name: "eth0", IPV4Addresses: []string{"1.2.3.10/24"}, Mtu: mtu}},
+			SystemVendor: &models.SystemVendor{Manufacturer: manufacturer},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		return &models.Host{ID: &id, Status: swag.String(status), Role: models.HostRoleMaster, Inventory: string(b)}
+	}
+
+	createContext := func(host *models.Host, hosts ...*models.Host) *validationContext {
+		var inventory models.Inventory
+		Expect(json.Unmarshal([]byte(host.Inventory), &inventory)).ShouldNot(HaveOccurred())
+		return &validationContext{
+			host: host,
+			cluster: &common.Cluster{Cluster: models.Cluster{MachineNetworkCidr: "1.2.3.0/24",
+				Hosts: append(hosts, host)}},
+			inventory: &inventory,
+		}
+	}
+
+	BeforeEach(func() {
+		v = validator{log: getTestLog(), hwValidatorCfg: createValidatorCfg()}
+	})
+
+	It("different MTU", func() {
+		c := createContext(createHost(models.HostStatusKnown, 1500, "Dell Inc."),
+			createHost(models.HostStatusKnown, 9000, "Dell Inc."))
+		Expect(v.isMtuConsistent(c)).To(Equal(ValidationFailure))
+	})
+
+	It("different platform", func() {
+		c := createContext(createHost(models.HostStatusKnown, 1500, "Dell Inc."),
+			createHost(models.HostStatusKnown, 1500, "QEMU"))
+		Expect(v.isPlatformUniform(c)).To(Equal(ValidationFailure))
+	})
+
+	It("disabled hosts are not compared", func() {
+		c := createContext(createHost(models.HostStatusKnown, 1500, "Dell Inc."),
+			createHost(models.HostStatusDisabled, 9000, "QEMU"), createHost(models.HostStatusKnown, 1500, "Dell Inc."))
+		Expect(v.isMtuConsistent(c)).To(Equal(ValidationSuccess))
+		Expect(v.isPlatformUniform(c)).To(Equal(ValidationSuccess))
+	})
+})
@@ -1,21 +1,24 @@
 package installcfg
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 
 	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/internal/network"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
 
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 )
 
 type bmc struct {
-	Address  string `yaml:"address"`
-	Username string `yaml:"username"`
-	Password string `yaml:"password"`
+	Address  string `yaml:"address,omitempty"`
+	Username string `yaml:"username,omitempty"`
+	Password string `yaml:"password,omitempty"`
 }
 
 type host struct {
@@ -28,7 +31,8 @@ type host struct {
 }
 
 type baremetal struct {
-	ProvisioningNetworkInterface string `yaml:"provisioningNetworkInterface"`
+	// Omitted, the service does not know of a provisioning network on the hosts
+	ProvisioningNetworkInterface string `yaml:"provisioningNetworkInterface,omitempty"`
 	APIVIP                       string `yaml:"apiVIP"`
 	IngressVIP                   string `yaml:"ingressVIP"`
 	DNSVIP                       string `yaml:"dnsVIP"`
@@ -39,6 +43,12 @@ type platform struct {
 	Baremetal baremetal `yaml:"baremetal"`
 }
 
+type proxy struct {
+	HTTPProxy  string `yaml:"httpProxy,omitempty"`
+	HTTPSProxy string `yaml:"httpsProxy,omitempty"`
+	NoProxy    string `yaml:"noProxy,omitempty"`
+}
+
 type InstallerConfigBaremetal struct {
 	APIVersion string `yaml:"apiVersion"`
 	BaseDomain string `yaml:"baseDomain"`
@@ -65,6 +75,7 @@ type InstallerConfigBaremetal struct {
 		Replicas int    `yaml:"replicas"`
 	} `yaml:"controlPlane"`
 	Platform   platform `yaml:"platform"`
+	Proxy      *proxy   `yaml:"proxy,omitempty"`
 	PullSecret string   `yaml:"pullSecret"`
 	SSHKey     string   `yaml:"sshKey"`
 }
@@ -80,7 +91,7 @@ func countHostsByRole(cluster *common.Cluster, role models.HostRole) int {
 }
 
 func getBasicInstallConfig(cluster *common.Cluster) *InstallerConfigBaremetal {
-	return &InstallerConfigBaremetal{
+	cfg := &InstallerConfigBaremetal{
 		APIVersion: "v1",
 		BaseDomain: cluster.BaseDNSDomain,
 		Networking: struct {
@@ -94,7 +105,7 @@ func getBasicInstallConfig(cluster *common.Cluster) *InstallerConfigBaremetal {
 			} `yaml:"machineNetwork"`
 			ServiceNetwork []string `yaml:"serviceNetwork"`
 		}{
-			NetworkType: "OpenShiftSDN",
+			NetworkType: network.GetNetworkType(&cluster.Cluster),
 			ClusterNetwork: []struct {
 				Cidr       string `yaml:"cidr"`
 				HostPrefix int    `yaml:"hostPrefix"`
@@ -132,63 +143,136 @@ func getBasicInstallConfig(cluster *common.Cluster) *InstallerConfigBaremetal {
 		PullSecret: cluster.PullSecret,
 		SSHKey:     cluster.SSHPublicKey,
 	}
+	setAdditionalMachineNetworks(cluster, cfg)
+	setDualStackNetworks(cluster, cfg)
+	setProxy(cluster, cfg)
+	return cfg
+}
+
+// setProxy sets the proxy of the installed cluster, if the cluster has one
+func setProxy(cluster *common.Cluster, cfg *InstallerConfigBaremetal) {
+	if !network.IsProxySet(&cluster.Cluster) {
+		return
+	}
+	cfg.Proxy = &proxy{
+		HTTPProxy:  cluster.HTTPProxy,
+		HTTPSProxy: cluster.HTTPSProxy,
+		NoProxy:    cluster.NoProxy,
+	}
+}
+
+// setAdditionalMachineNetworks adds the machine networks of the hosts in other subnets after the one of the VIPs
+func setAdditionalMachineNetworks(cluster *common.Cluster, cfg *InstallerConfigBaremetal) {
+	for _, cidr := range network.ParseMachineNetworkCidrs(cluster.AdditionalMachineNetworkCidrs) {
+		cfg.Networking.MachineNetwork = append(cfg.Networking.MachineNetwork, struct {
+			Cidr string `yaml:"cidr"`
+		}{Cidr: cidr})
+	}
+}
+
+// setDualStackNetworks adds the networks of the other IP family of a dual-stack cluster after the primary ones
+func setDualStackNetworks(cluster *common.Cluster, cfg *InstallerConfigBaremetal) {
+	if cluster.SecondaryClusterNetworkCidr == "" || cluster.SecondaryServiceNetworkCidr == "" {
+		return
+	}
+	cfg.Networking.ClusterNetwork = append(cfg.Networking.ClusterNetwork, struct {
+		Cidr       string `yaml:"cidr"`
+		HostPrefix int    `yaml:"hostPrefix"`
+	}{Cidr: cluster.SecondaryClusterNetworkCidr, HostPrefix: int(cluster.SecondaryClusterNetworkHostPrefix)})
+	if cluster.SecondaryMachineNetworkCidr != "" {
+		cfg.Networking.MachineNetwork = append(cfg.Networking.MachineNetwork, struct {
+			Cidr string `yaml:"cidr"`
+		}{Cidr: cluster.SecondaryMachineNetworkCidr})
+	}
+	cfg.Networking.ServiceNetwork = append(cfg.Networking.ServiceNetwork, cluster.SecondaryServiceNetworkCidr)
+}
+
+// bmcAddress returns the address of the BMC of a host for the install config, empty when the host did not discover
+// one
+func bmcAddress(inventory *models.Inventory) string {
+	ip := net.ParseIP(inventory.BmcAddress)
+	if ip == nil || ip.IsUnspecified() {
+		return ""
+	}
+	if ip.To4() == nil {
+		return fmt.Sprintf("ipmi://[%s]", ip.String())
+	}
+	return fmt.Sprintf("ipmi://%s", ip.String())
+}
+
+// bootMode returns the boot mode of a host for the install config, UEFI unless the host booted in legacy BIOS mode
+func bootMode(inventory *models.Inventory) string {
+	if inventory.Boot != nil && inventory.Boot.CurrentBootMode == "bios" {
+		return "legacy"
+	}
+	return "UEFI"
+}
+
+// bootInterface returns the interface of a host in its machine network, the one that the host booted from over the
+// network if there are several
+func bootInterface(log logrus.FieldLogger, cluster *common.Cluster, h *models.Host,
+	inventory *models.Inventory) (*models.Interface, error) {
+	machineNetworkCidr := network.GetHostMachineNetwork(log, cluster, h)
+	interfaces := network.GetMachineCidrInterfaces(inventory, machineNetworkCidr)
+	if len(interfaces) == 0 {
+		return nil, fmt.Errorf("host %s has no interface in the machine networks of the cluster",
+			common.GetHostnameForMsg(h))
+	}
+	if inventory.Boot != nil {
+		for _, intf := range interfaces {
+			if intf.Name == inventory.Boot.PxeInterface || intf.MacAddress == inventory.Boot.PxeInterface {
+				return intf, nil
+			}
+		}
+	}
+	return interfaces[0], nil
 }
 
-// [TODO] - remove once we decide to use specific values from the hosts of the cluster
-func getDummyMAC(log logrus.FieldLogger, dummyMAC string, count int) (string, error) {
-	hwMac, err := net.ParseMAC(dummyMAC)
+func getHostInstallConfig(log logrus.FieldLogger, cluster *common.Cluster, h *models.Host) (*host, error) {
+	var inventory models.Inventory
+	if err := json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the inventory of host %s", h.ID.String())
+	}
+	name, err := common.GetCurrentHostName(h)
 	if err != nil {
-		log.Warn("Failed to parse dummyMac")
-		return "", err
+		return nil, err
+	}
+	intf, err := bootInterface(log, cluster, h, &inventory)
+	if err != nil {
+		return nil, err
 	}
-	hwMac[len(hwMac)-1] = hwMac[len(hwMac)-1] + byte(count)
-	return hwMac.String(), nil
+	return &host{
+		Name:            name,
+		Role:            string(h.Role),
+		Bmc:             bmc{Address: bmcAddress(&inventory)},
+		BootMACAddress:  intf.MacAddress,
+		BootMode:        bootMode(&inventory),
+		HardwareProfile: "unknown",
+	}, nil
 }
 
 func setBMPlatformInstallconfig(log logrus.FieldLogger, cluster *common.Cluster, cfg *InstallerConfigBaremetal) error {
-	// set hosts
-	numMasters := countHostsByRole(cluster, models.HostRoleMaster)
-	numWorkers := countHostsByRole(cluster, models.HostRoleWorker)
-	masterCount := 0
-	workerCount := 0
-	hosts := make([]host, numWorkers+numMasters)
-
-	// dummy MAC and port, once we start using real BMH, those values should be set from cluster
-	dummyMAC := "00:aa:39:b3:51:10"
-	dummyPort := 6230
-
-	for i := range hosts {
-		log.Infof("Setting master, host %d, master count %d", i, masterCount)
-		if i >= numMasters {
-			hosts[i].Name = fmt.Sprintf("openshift-worker-%d", workerCount)
-			hosts[i].Role = string(models.HostRoleWorker)
-			workerCount += 1
-		} else {
-			hosts[i].Name = fmt.Sprintf("openshift-master-%d", masterCount)
-			hosts[i].Role = string(models.HostRoleMaster)
-			masterCount += 1
+	// The masters are set before the workers
+	hosts := make([]host, 0, len(cluster.Hosts))
+	for _, role := range []models.HostRole{models.HostRoleMaster, models.HostRoleWorker} {
+		for _, h := range cluster.Hosts {
+			if swag.StringValue(h.Status) == models.HostStatusDisabled || h.Role != role {
+				continue
+			}
+			hostCfg, err := getHostInstallConfig(log, cluster, h)
+			if err != nil {
+				log.WithError(err).Warnf("Failed to set the install config of host %s", h.ID.String())
+				return err
+			}
+			hosts = append(hosts, *hostCfg)
 		}
-		hosts[i].Bmc = bmc{
-			Address:  fmt.Sprintf("ipmi://192.168.111.1:%d", dummyPort+i),
-			Username: "admin",
-			Password: "rackattack",
-		}
-		hwMac, err := getDummyMAC(log, dummyMAC, i)
-		if err != nil {
-			log.Warn("Failed to parse dummyMac")
-			return err
-		}
-		hosts[i].BootMACAddress = hwMac
-		hosts[i].BootMode = "UEFI"
-		hosts[i].HardwareProfile = "unknown"
 	}
 	cfg.Platform = platform{
 		Baremetal: baremetal{
-			ProvisioningNetworkInterface: "ens4",
-			APIVIP:                       cluster.APIVip,
-			IngressVIP:                   cluster.IngressVip,
-			DNSVIP:                       cluster.APIVip,
-			Hosts:                        hosts,
+			APIVIP:     cluster.APIVip,
+			IngressVIP: cluster.IngressVip,
+			DNSVIP:     cluster.APIVip,
+			Hosts:      hosts,
 		},
 	}
 	return nil
@@ -200,5 +284,27 @@ func GetInstallConfig(log logrus.FieldLogger, cluster *common.Cluster) ([]byte,
 	if err != nil {
 		return nil, err
 	}
-	return yaml.Marshal(*cfg)
+	data, err := yaml.Marshal(*cfg)
+	if err != nil {
+		return nil, err
+	}
+	return applyInstallConfigOverrides(cluster, data)
+}
+
+// redacted replaces the secrets of the install config preview
+const redacted = "<redacted>"
+
+// GetRedactedInstallConfig returns the install config of the cluster with the pull secret, the SSH key and the
+// credentials of the proxies redacted
+func GetRedactedInstallConfig(log logrus.FieldLogger, cluster *common.Cluster) ([]byte, error) {
+	c := *cluster
+	c.HTTPProxy = network.RedactProxyURL(c.HTTPProxy)
+	c.HTTPSProxy = network.RedactProxyURL(c.HTTPSProxy)
+	if c.PullSecret != "" {
+		c.PullSecret = redacted
+	}
+	if c.SSHPublicKey != "" {
+		c.SSHPublicKey = redacted
+	}
+	return GetInstallConfig(log, &c)
 }
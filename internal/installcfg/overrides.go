@@ -0,0 +1,182 @@
+package installcfg
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+// protectedFields are the fields of the install config that the service computes, the overrides must not change them
+var protectedFields = []string{
+	"apiVersion",
+	"baseDomain",
+	"metadata.name",
+	"networking",
+	"controlPlane.name",
+	"controlPlane.replicas",
+	"proxy",
+	"pullSecret",
+	"sshKey",
+}
+
+// overridableBaremetalFields are the fields of the baremetal platform that the overrides may set, the rest of the
+// platform is computed by the service
+var overridableBaremetalFields = []string{
+	"bootstrapOSImage",
+	"clusterOSImage",
+	"externalBridge",
+	"provisioningBridge",
+	"provisioningNetwork",
+	"provisioningNetworkCIDR",
+	"provisioningNetworkInterface",
+	"provisioningDHCPRange",
+	"clusterProvisioningIP",
+	"bootstrapProvisioningIP",
+}
+
+// overridableComputeFields are the fields of the compute pools that the overrides may set
+var overridableComputeFields = []string{
+	"hyperthreading",
+}
+
+// ParseInstallConfigOverrides returns the JSON merge patch of install config overrides given as JSON or YAML, empty
+// when there are no overrides
+func ParseInstallConfigOverrides(overrides string) (string, error) {
+	if strings.TrimSpace(overrides) == "" {
+		return "", nil
+	}
+	patch, err := k8syaml.YAMLToJSON([]byte(overrides))
+	if err != nil {
+		return "", errors.Wrap(err, "install config overrides are neither JSON nor YAML")
+	}
+	var fields map[string]interface{}
+	if err = json.Unmarshal(patch, &fields); err != nil || fields == nil {
+		return "", errors.New("install config overrides must be an object")
+	}
+	return string(patch), nil
+}
+
+func fieldValue(doc map[string]interface{}, field string) interface{} {
+	var value interface{} = doc
+	for _, key := range strings.Split(field, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+// withoutFields returns a copy of an object of the install config without the given fields, the value itself when it
+// is not an object
+func withoutFields(value interface{}, fields []string) interface{} {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	ret := make(map[string]interface{}, len(m))
+	for key, v := range m {
+		ret[key] = v
+	}
+	for _, field := range fields {
+		delete(ret, field)
+	}
+	return ret
+}
+
+// protectedPlatform returns the platform of the install config without the fields that the overrides may set
+func protectedPlatform(doc map[string]interface{}) interface{} {
+	platform, ok := doc["platform"].(map[string]interface{})
+	if !ok {
+		return doc["platform"]
+	}
+	ret := withoutFields(platform, nil).(map[string]interface{})
+	if baremetal, ok := platform["baremetal"]; ok {
+		ret["baremetal"] = withoutFields(baremetal, overridableBaremetalFields)
+	}
+	return ret
+}
+
+// protectedCompute returns the compute pools of the install config without the fields that the overrides may set
+func protectedCompute(doc map[string]interface{}) interface{} {
+	pools, ok := doc["compute"].([]interface{})
+	if !ok {
+		return doc["compute"]
+	}
+	ret := make([]interface{}, 0, len(pools))
+	for _, pool := range pools {
+		ret = append(ret, withoutFields(pool, overridableComputeFields))
+	}
+	return ret
+}
+
+func verifyProtectedFields(generated, overridden []byte) error {
+	var generatedDoc, overriddenDoc map[string]interface{}
+	if err := json.Unmarshal(generated, &generatedDoc); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(overridden, &overriddenDoc); err != nil {
+		return err
+	}
+	for _, field := range protectedFields {
+		if !reflect.DeepEqual(fieldValue(generatedDoc, field), fieldValue(overriddenDoc, field)) {
+			return fmt.Errorf("install config overrides must not change %s", field)
+		}
+	}
+	if !reflect.DeepEqual(protectedPlatform(generatedDoc), protectedPlatform(overriddenDoc)) {
+		return fmt.Errorf("install config overrides may change only %s of platform.baremetal",
+			strings.Join(overridableBaremetalFields, ", "))
+	}
+	if !reflect.DeepEqual(protectedCompute(generatedDoc), protectedCompute(overriddenDoc)) {
+		return fmt.Errorf("install config overrides may change only %s of the compute pools",
+			strings.Join(overridableComputeFields, ", "))
+	}
+	return nil
+}
+
+// applyInstallConfigOverrides applies the overrides of the cluster on top of the generated install config, and
+// verifies that they do not change the protected fields
+func applyInstallConfigOverrides(cluster *common.Cluster, cfg []byte) ([]byte, error) {
+	if cluster.InstallConfigOverrides == "" {
+		return cfg, nil
+	}
+	generated, err := k8syaml.YAMLToJSON(cfg)
+	if err != nil {
+		return nil, err
+	}
+	overridden, err := jsonpatch.MergePatch(generated, []byte(cluster.InstallConfigOverrides))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to apply the install config overrides")
+	}
+	if err = verifyProtectedFields(generated, overridden); err != nil {
+		return nil, err
+	}
+	return k8syaml.JSONToYAML(overridden)
+}
+
+// ValidateInstallConfigOverrides verifies that the install config overrides apply to the install config of the
+// cluster in its current state. The hosts are left out of the install config if it cannot be generated for them yet.
+func ValidateInstallConfigOverrides(log logrus.FieldLogger, cluster *common.Cluster, overrides string) error {
+	cfg := getBasicInstallConfig(cluster)
+	if err := setBMPlatformInstallconfig(log, cluster, cfg); err != nil {
+		log.WithError(err).Infof("Validating the install config overrides of cluster %s without its hosts",
+			cluster.ID.String())
+	}
+	data, err := yaml.Marshal(*cfg)
+	if err != nil {
+		return err
+	}
+	c := *cluster
+	c.InstallConfigOverrides = overrides
+	_, err = applyInstallConfigOverrides(&c, data)
+	return err
+}
@@ -0,0 +1,234 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/filanov/bm-inventory/internal/common"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
+	"github.com/sirupsen/logrus"
+)
+
+// The number of nodes a cluster network is planned for when the cluster has fewer hosts, the masters of the cluster
+const minPlannedHosts = 3
+
+// The minimal number of addresses of the network of a node out of the cluster network
+const minHostNetworkAddressBits = 7
+
+type namedNetwork struct {
+	name  string
+	ipnet *net.IPNet
+}
+
+// VerifyCIDR verifies that a CIDR is well-formed and that its address is the address of the network
+func VerifyCIDR(name string, cidr string) (*net.IPNet, error) {
+	ip, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("%s <%s> is not a valid CIDR", name, cidr)
+	}
+	if !ip.Equal(ipnet.IP) {
+		return nil, fmt.Errorf("%s <%s> is not the address of a network, did you mean <%s>?", name, cidr, ipnet.String())
+	}
+	return ipnet, nil
+}
+
+func overlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+/*
+ * Verify that the cluster network leaves a network of the host prefix to each of the planned nodes, and that each of
+ * these networks has enough addresses for the pods of the node.
+ */
+func VerifyClusterCIDRSize(name string, clusterNetworkCidr string, hostPrefix int64, plannedHosts int) error {
+	ipnet, err := VerifyCIDR(name, clusterNetworkCidr)
+	if err != nil {
+		return err
+	}
+	prefix, bits := ipnet.Mask.Size()
+	if hostPrefix < int64(prefix) {
+		return fmt.Errorf("Host prefix %d of %s <%s> must not be smaller than the prefix of the network",
+			hostPrefix, name, clusterNetworkCidr)
+	}
+	if hostPrefix > int64(bits-minHostNetworkAddressBits) {
+		return fmt.Errorf("Host prefix %d of %s <%s> must not be larger than %d to leave at least %d addresses to each node",
+			hostPrefix, name, clusterNetworkCidr, bits-minHostNetworkAddressBits, 1<<minHostNetworkAddressBits)
+	}
+	if plannedHosts < minPlannedHosts {
+		plannedHosts = minPlannedHosts
+	}
+	// The shift is bounded so that it does not overflow, such networks are large enough for any cluster
+	if shift := hostPrefix - int64(prefix); shift < 31 && int64(1)<<shift < int64(plannedHosts) {
+		return fmt.Errorf("%s <%s> with host prefix %d has room for %d nodes, fewer than the %d nodes of the cluster",
+			name, clusterNetworkCidr, hostPrefix, int64(1)<<shift, plannedHosts)
+	}
+	return nil
+}
+
+// hostNetworks returns the networks of the interfaces of the hosts, each with the name of its first host
+func hostNetworks(hosts []*models.Host, log logrus.FieldLogger) []namedNetwork {
+	ret := make([]namedNetwork, 0)
+	seen := make(map[string]bool)
+	for _, h := range hosts {
+		if h.Inventory == "" || swag.StringValue(h.Status) == models.HostStatusDisabled {
+			continue
+		}
+		var inventory models.Inventory
+		if err := json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+			log.WithError(err).Warnf("Error unmarshalling host %s inventory %s", h.ID, h.Inventory)
+			continue
+		}
+		for _, intf := range inventory.Interfaces {
+			for _, addr := range interfaceAddresses(intf) {
+				_, ipnet, err := net.ParseCIDR(addr)
+				if err != nil || ipnet.IP.IsLinkLocalUnicast() || seen[ipnet.String()] {
+					continue
+				}
+				seen[ipnet.String()] = true
+				ret = append(ret, namedNetwork{
+					name:  fmt.Sprintf("network of interface %s of host %s", intf.Name, common.GetHostnameForMsg(h)),
+					ipnet: ipnet,
+				})
+			}
+		}
+	}
+	return ret
+}
+
+// PlannedHosts returns the number of hosts a cluster network must have room for, the hosts that take part in the
+// installation
+func PlannedHosts(hosts []*models.Host) int {
+	ret := 0
+	for _, h := range hosts {
+		if swag.StringValue(h.Status) != models.HostStatusDisabled {
+			ret++
+		}
+	}
+	if ret < minPlannedHosts {
+		return minPlannedHosts
+	}
+	return ret
+}
+
+// VerifyClusterNetworkPrefixes verifies that the cluster networks of a cluster have room for its nodes
+func VerifyClusterNetworkPrefixes(cluster *models.Cluster, hosts []*models.Host) error {
+	plannedHosts := PlannedHosts(hosts)
+	prefixes := []struct {
+		name       string
+		cidr       string
+		hostPrefix int64
+	}{
+		{"cluster-network-cidr", cluster.ClusterNetworkCidr, cluster.ClusterNetworkHostPrefix},
+		{"secondary-cluster-network-cidr", cluster.SecondaryClusterNetworkCidr, cluster.SecondaryClusterNetworkHostPrefix},
+	}
+	for _, p := range prefixes {
+		if p.cidr == "" {
+			continue
+		}
+		if err := VerifyClusterCIDRSize(p.name, p.cidr, p.hostPrefix, plannedHosts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+ * Verify that the cluster and service networks of a cluster are well-formed, and that they overlap neither each
+ * other, nor the machine networks, nor the network of any interface of the hosts.  Empty networks are not verified.
+ * The additional machine networks are verified as well.
+ */
+func VerifyNetworksNotOverlapping(cluster *models.Cluster, hosts []*models.Host, log logrus.FieldLogger) error {
+	if err := VerifyAdditionalMachineNetworks(cluster); err != nil {
+		return err
+	}
+	networks := make([]namedNetwork, 0)
+	for _, n := range []struct {
+		name string
+		cidr string
+	}{
+		{"cluster-network-cidr", cluster.ClusterNetworkCidr},
+		{"service-network-cidr", cluster.ServiceNetworkCidr},
+		{"secondary-cluster-network-cidr", cluster.SecondaryClusterNetworkCidr},
+		{"secondary-service-network-cidr", cluster.SecondaryServiceNetworkCidr},
+	} {
+		if n.cidr == "" {
+			continue
+		}
+		ipnet, err := VerifyCIDR(n.name, n.cidr)
+		if err != nil {
+			return err
+		}
+		for _, other := range networks {
+			if overlap(ipnet, other.ipnet) {
+				return fmt.Errorf("%s <%s> overlaps %s <%s>", n.name, n.cidr, other.name, other.ipnet)
+			}
+		}
+		networks = append(networks, namedNetwork{name: n.name, ipnet: ipnet})
+	}
+
+	others := append(namedMachineNetworks(cluster), hostNetworks(hosts, log)...)
+	for _, n := range networks {
+		for _, other := range others {
+			if overlap(n.ipnet, other.ipnet) {
+				return fmt.Errorf("%s <%s> overlaps the %s <%s>", n.name, n.ipnet, other.name, other.ipnet)
+			}
+		}
+	}
+	return nil
+}
+
+// namedMachineNetworks returns the machine networks of the cluster that can be parsed, each with its parameter name
+func namedMachineNetworks(cluster *models.Cluster) []namedNetwork {
+	ret := make([]namedNetwork, 0)
+	add := func(name, cidr string) {
+		if _, ipnet, err := net.ParseCIDR(cidr); err == nil {
+			ret = append(ret, namedNetwork{name: name, ipnet: ipnet})
+		}
+	}
+	add("machine-network-cidr", cluster.MachineNetworkCidr)
+	for _, cidr := range ParseMachineNetworkCidrs(cluster.AdditionalMachineNetworkCidrs) {
+		add("additional machine network", cidr)
+	}
+	add("secondary-machine-network-cidr", cluster.SecondaryMachineNetworkCidr)
+	return ret
+}
+
+/*
+ * Verify that the additional machine networks of a cluster are well-formed, and that they overlap neither each other
+ * nor the machine network of the VIPs.  A VIP in an additional machine network makes it the machine network of the
+ * cluster, which then overlaps it.
+ */
+func VerifyAdditionalMachineNetworks(cluster *models.Cluster) error {
+	networks := make([]namedNetwork, 0)
+	add := func(name, cidr string) {
+		if _, ipnet, err := net.ParseCIDR(cidr); err == nil {
+			networks = append(networks, namedNetwork{name: name, ipnet: ipnet})
+		}
+	}
+	add("machine-network-cidr", cluster.MachineNetworkCidr)
+	add("secondary-machine-network-cidr", cluster.SecondaryMachineNetworkCidr)
+	for _, cidr := range ParseMachineNetworkCidrs(cluster.AdditionalMachineNetworkCidrs) {
+		ipnet, err := VerifyCIDR("additional machine network", cidr)
+		if err != nil {
+			return err
+		}
+		for _, other := range networks {
+			if overlap(ipnet, other.ipnet) {
+				return fmt.Errorf("additional machine network <%s> overlaps %s <%s>", cidr, other.name, other.ipnet)
+			}
+		}
+		networks = append(networks, namedNetwork{name: "additional machine network", ipnet: ipnet})
+	}
+	return nil
+}
+
+// VerifyClusterNetworks verifies the machine, cluster and service networks of a cluster against each other and the
+// hosts
+func VerifyClusterNetworks(cluster *models.Cluster, hosts []*models.Host, log logrus.FieldLogger) error {
+	if err := VerifyNetworksNotOverlapping(cluster, hosts, log); err != nil {
+		return err
+	}
+	return VerifyClusterNetworkPrefixes(cluster, hosts)
+}
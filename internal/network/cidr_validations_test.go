@@ -0,0 +1,155 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("cluster network validations", func() {
+	var log logrus.FieldLogger
+
+	BeforeEach(func() {
+		log = logrus.New()
+	})
+
+	createHost := func(hostname string, addresses ...string) *models.Host {
+		b, err := json.Marshal(&models.Inventory{
+			Hostname:   hostname,
+			Interfaces: []*models.Interface{{Name: "eth0", IPV4Addresses: addresses}},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		return &models.Host{Status: swag.String(models.HostStatusKnown), Inventory: string(b)}
+	}
+
+	createCluster := func() *models.Cluster {
+		return &models.Cluster{
+			ClusterNetworkCidr:       "10.128.0.0/14",
+			ClusterNetworkHostPrefix: 23,
+			ServiceNetworkCidr:       "172.30.0.0/16",
+			MachineNetworkCidr:       "1.2.3.0/24",
+		}
+	}
+
+	Context("VerifyCIDR", func() {
+		It("valid", func() {
+			ipnet, err := VerifyCIDR("cluster-network-cidr", "fd01::/48")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipnet.String()).To(Equal("fd01::/48"))
+		})
+		It("malformed", func() {
+			_, err := VerifyCIDR("cluster-network-cidr", "10.128.0.0/33")
+			Expect(err).To(MatchError("cluster-network-cidr <10.128.0.0/33> is not a valid CIDR"))
+		})
+		It("not a network address", func() {
+			_, err := VerifyCIDR("cluster-network-cidr", "10.128.0.1/14")
+			Expect(err).To(MatchError("cluster-network-cidr <10.128.0.1/14> is not the address of a network, did you mean <10.128.0.0/14>?"))
+		})
+	})
+
+	Context("VerifyClusterCIDRSize", func() {
+		It("valid", func() {
+			Expect(VerifyClusterCIDRSize("cluster-network-cidr", "10.128.0.0/14", 23, 3)).To(Succeed())
+			Expect(VerifyClusterCIDRSize("cluster-network-cidr", "fd01::/48", 64, 100)).To(Succeed())
+			Expect(VerifyClusterCIDRSize("cluster-network-cidr", "fd00::/8", 64, 100)).To(Succeed())
+		})
+		It("host prefix smaller than the network prefix", func() {
+			Expect(VerifyClusterCIDRSize("cluster-network-cidr", "10.128.0.0/14", 13, 3)).ToNot(Succeed())
+		})
+		It("host prefix too large", func() {
+			Expect(VerifyClusterCIDRSize("cluster-network-cidr", "10.128.0.0/14", 26, 3)).To(MatchError(
+				"Host prefix 26 of cluster-network-cidr <10.128.0.0/14> must not be larger than 25 to leave at least 128 addresses to each node"))
+		})
+		It("no room for the nodes", func() {
+			Expect(VerifyClusterCIDRSize("cluster-network-cidr", "10.128.0.0/22", 23, 1)).To(MatchError(
+				"cluster-network-cidr <10.128.0.0/22> with host prefix 23 has room for 2 nodes, fewer than the 3 nodes of the cluster"))
+			Expect(VerifyClusterCIDRSize("cluster-network-cidr", "10.128.0.0/20", 23, 9)).ToNot(Succeed())
+			Expect(VerifyClusterCIDRSize("cluster-network-cidr", "10.128.0.0/20", 23, 8)).To(Succeed())
+		})
+	})
+
+	Context("PlannedHosts", func() {
+		It("at least the masters", func() {
+			Expect(PlannedHosts(nil)).To(Equal(3))
+		})
+		It("disabled hosts are not counted", func() {
+			hosts := []*models.Host{createHost("h1"), createHost("h2"), createHost("h3"), createHost("h4"), createHost("h5")}
+			hosts[4].Status = swag.String(models.HostStatusDisabled)
+			Expect(PlannedHosts(hosts)).To(Equal(4))
+		})
+	})
+
+	Context("VerifyClusterNetworks", func() {
+		It("valid", func() {
+			Expect(VerifyClusterNetworks(createCluster(), []*models.Host{createHost("h1", "1.2.3.4/24", "10.0.0.4/16")}, log)).
+				To(Succeed())
+		})
+		It("empty networks are not verified", func() {
+			Expect(VerifyClusterNetworks(&models.Cluster{}, nil, log)).To(Succeed())
+		})
+		It("service network overlaps cluster network", func() {
+			c := createCluster()
+			c.ServiceNetworkCidr = "10.128.0.0/16"
+			Expect(VerifyClusterNetworks(c, nil, log)).To(MatchError(
+				"service-network-cidr <10.128.0.0/16> overlaps cluster-network-cidr <10.128.0.0/14>"))
+		})
+		It("cluster network overlaps the machine network", func() {
+			c := createCluster()
+			c.ClusterNetworkCidr = "1.0.0.0/14"
+			Expect(VerifyClusterNetworks(c, nil, log)).To(MatchError(
+				"cluster-network-cidr <1.0.0.0/14> overlaps the machine-network-cidr <1.2.3.0/24>"))
+		})
+		It("service network overlaps another network of a host", func() {
+			Expect(VerifyClusterNetworks(createCluster(), []*models.Host{createHost("h1", "1.2.3.4/24", "172.30.5.4/24")}, log)).
+				To(MatchError("service-network-cidr <172.30.0.0/16> overlaps the network of interface eth0 of host h1 <172.30.5.0/24>"))
+		})
+		It("disabled hosts are ignored", func() {
+			h := createHost("h1", "1.2.3.4/24", "172.30.5.4/24")
+			h.Status = swag.String(models.HostStatusDisabled)
+			Expect(VerifyClusterNetworks(createCluster(), []*models.Host{h}, log)).To(Succeed())
+		})
+		It("additional machine networks", func() {
+			c := createCluster()
+			c.AdditionalMachineNetworkCidrs = "1.2.4.0/24,1.2.5.0/24"
+			Expect(VerifyClusterNetworks(c, nil, log)).To(Succeed())
+			c.ServiceNetworkCidr = "1.2.0.0/16"
+			Expect(VerifyClusterNetworks(c, nil, log)).To(MatchError(
+				"service-network-cidr <1.2.0.0/16> overlaps the machine-network-cidr <1.2.3.0/24>"))
+		})
+		It("additional machine network overlaps the machine network", func() {
+			c := createCluster()
+			c.AdditionalMachineNetworkCidrs = "1.2.4.0/24,1.2.0.0/16"
+			Expect(VerifyClusterNetworks(c, nil, log)).To(MatchError(
+				"additional machine network <1.2.0.0/16> overlaps machine-network-cidr <1.2.3.0/24>"))
+		})
+		It("additional machine networks overlap", func() {
+			c := createCluster()
+			c.AdditionalMachineNetworkCidrs = "1.2.4.0/24,1.2.4.0/25"
+			Expect(VerifyAdditionalMachineNetworks(c)).To(MatchError(
+				"additional machine network <1.2.4.0/25> overlaps additional machine network <1.2.4.0/24>"))
+		})
+		It("additional machine network malformed", func() {
+			c := createCluster()
+			c.AdditionalMachineNetworkCidrs = "1.2.4.1/24"
+			Expect(VerifyAdditionalMachineNetworks(c)).To(HaveOccurred())
+			c.AdditionalMachineNetworkCidrs = "rack2"
+			Expect(VerifyAdditionalMachineNetworks(c)).To(MatchError("additional machine network <rack2> is not a valid CIDR"))
+		})
+		It("dual-stack", func() {
+			c := createCluster()
+			c.SecondaryClusterNetworkCidr = "fd01::/48"
+			c.SecondaryClusterNetworkHostPrefix = 64
+			c.SecondaryServiceNetworkCidr = "fd01::/112"
+			Expect(VerifyClusterNetworks(c, nil, log)).To(MatchError(
+				"secondary-service-network-cidr <fd01::/112> overlaps secondary-cluster-network-cidr <fd01::/48>"))
+			c.SecondaryServiceNetworkCidr = "fd02::/112"
+			Expect(VerifyClusterNetworks(c, nil, log)).To(Succeed())
+			c.SecondaryClusterNetworkHostPrefix = 47
+			Expect(VerifyClusterNetworks(c, nil, log)).ToNot(Succeed())
+		})
+	})
+})
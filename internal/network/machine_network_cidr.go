@@ -1,14 +1,15 @@
 package network
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 
 	"github.com/go-openapi/swag"
 
-	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 
 	"github.com/filanov/bm-inventory/internal/common"
@@ -17,6 +18,35 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// IsIPv4 returns whether the address, or the address part of a CIDR, is an IPv4 address
+func IsIPv4(addr string) bool {
+	if i := strings.Index(addr, "/"); i >= 0 {
+		addr = addr[:i]
+	}
+	ip := net.ParseIP(addr)
+	return ip != nil && ip.To4() != nil
+}
+
+// SameFamily returns whether both addresses or CIDRs belong to the same IP family
+func SameFamily(addr1, addr2 string) bool {
+	return IsIPv4(addr1) == IsIPv4(addr2)
+}
+
+// DefaultHostPrefix returns the default per node subnet prefix length of a cluster network CIDR
+func DefaultHostPrefix(clusterNetworkCidr string) int64 {
+	if IsIPv4(clusterNetworkCidr) {
+		return 23
+	}
+	return 64
+}
+
+// interfaceAddresses returns the IPv4 and IPv6 addresses, in CIDR notation, of an interface
+func interfaceAddresses(intf *models.Interface) []string {
+	ret := make([]string, 0, len(intf.IPV4Addresses)+len(intf.IPV6Addresses))
+	ret = append(ret, intf.IPV4Addresses...)
+	return append(ret, intf.IPV6Addresses...)
+}
+
 /*
  * Calculate the machine network CIDR from the one of (ApiVip, IngressVip) and the ip addresses of the hosts.
  * The ip addresses of the host appear with CIDR notation. Therefore, the network can be calculated from it.
@@ -46,8 +76,8 @@ func CalculateMachineNetworkCIDR(apiVip string, ingressVip string, hosts []*mode
 			continue
 		}
 		for _, intf := range inventory.Interfaces {
-			for _, ipv4addr := range intf.IPV4Addresses {
-				_, ipnet, err := net.ParseCIDR(ipv4addr)
+			for _, addr := range interfaceAddresses(intf) {
+				_, ipnet, err := net.ParseCIDR(addr)
 				if err != nil {
 					continue
 				}
@@ -60,6 +90,79 @@ func CalculateMachineNetworkCIDR(apiVip string, ingressVip string, hosts []*mode
 	return "", fmt.Errorf("No suitable matching CIDR found for VIP %s", ip)
 }
 
+/*
+ * Calculate the machine network CIDR of the other IP family of a dual-stack cluster.  It is the network of the first
+ * address of the other family, that is not link-local, of an interface that has an address in the machine network.
+ */
+func CalculateSecondaryMachineNetworkCIDR(machineNetworkCidr string, hosts []*models.Host) (string, error) {
+	_, machineIpnet, err := net.ParseCIDR(machineNetworkCidr)
+	if err != nil {
+		return "", fmt.Errorf("Could not parse machine network CIDR %s", machineNetworkCidr)
+	}
+	isIPv4 := machineIpnet.IP.To4() != nil
+	for _, h := range hosts {
+		if swag.StringValue(h.Status) == models.HostStatusDisabled {
+			continue
+		}
+		var inventory models.Inventory
+		if err = json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+			continue
+		}
+		for _, intf := range GetMachineCidrInterfaces(&inventory, machineNetworkCidr) {
+			for _, addr := range interfaceAddresses(intf) {
+				ip, ipnet, err := net.ParseCIDR(addr)
+				if err != nil || (ip.To4() != nil) == isIPv4 || ip.IsLinkLocalUnicast() {
+					continue
+				}
+				return ipnet.String(), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("No host has an address of the other IP family on the interface of machine network CIDR %s",
+		machineNetworkCidr)
+}
+
+// IsDualStack returns whether the cluster networks of both IP families are set
+func IsDualStack(cluster *models.Cluster) bool {
+	return cluster.SecondaryClusterNetworkCidr != "" && cluster.SecondaryServiceNetworkCidr != ""
+}
+
+// differentFamilies returns whether both addresses or CIDRs are set and belong to different IP families
+func differentFamilies(addr1, addr2 string) bool {
+	return addr1 != "" && addr2 != "" && !SameFamily(addr1, addr2)
+}
+
+/*
+ * Verify that the cluster, service and machine networks of a cluster are consistent: the secondary networks are set
+ * together and belong to the other IP family than the primary ones, and every kind of network covers the same families.
+ */
+func VerifyNetworkFamilies(cluster *models.Cluster) error {
+	if (cluster.SecondaryClusterNetworkCidr == "") != (cluster.SecondaryServiceNetworkCidr == "") {
+		return errors.New("secondary-cluster-network-cidr and secondary-service-network-cidr must be set together")
+	}
+	if differentFamilies(cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr) {
+		return fmt.Errorf("cluster-network-cidr <%s> and service-network-cidr <%s> must belong to the same IP family",
+			cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr)
+	}
+	if !IsDualStack(cluster) {
+		if differentFamilies(cluster.MachineNetworkCidr, cluster.ClusterNetworkCidr) {
+			return fmt.Errorf("machine-network-cidr <%s> and cluster-network-cidr <%s> must belong to the same IP family",
+				cluster.MachineNetworkCidr, cluster.ClusterNetworkCidr)
+		}
+		return nil
+	}
+	if !differentFamilies(cluster.ClusterNetworkCidr, cluster.SecondaryClusterNetworkCidr) {
+		return fmt.Errorf("secondary-cluster-network-cidr <%s> must belong to the other IP family than cluster-network-cidr <%s>",
+			cluster.SecondaryClusterNetworkCidr, cluster.ClusterNetworkCidr)
+	}
+	if !differentFamilies(cluster.ServiceNetworkCidr, cluster.SecondaryServiceNetworkCidr) {
+		return fmt.Errorf("secondary-service-network-cidr <%s> must belong to the other IP family than service-network-cidr <%s>",
+			cluster.SecondaryServiceNetworkCidr, cluster.ServiceNetworkCidr)
+	}
+	// The VIPs, and so the machine network, of a dual-stack cluster may belong to either family
+	return nil
+}
+
 func ipInCidr(ipStr, cidrStr string) bool {
 	ip := net.ParseIP(ipStr)
 	if ip == nil {
@@ -111,10 +214,10 @@ func belongsToNetwork(log logrus.FieldLogger, h *models.Host, machineIpnet *net.
 		return false
 	}
 	for _, intf := range inventory.Interfaces {
-		for _, ipv4addr := range intf.IPV4Addresses {
-			ip, _, err := net.ParseCIDR(ipv4addr)
+		for _, addr := range interfaceAddresses(intf) {
+			ip, _, err := net.ParseCIDR(addr)
 			if err != nil {
-				log.WithError(err).Warnf("Could not parse cidr %s", ipv4addr)
+				log.WithError(err).Warnf("Could not parse cidr %s", addr)
 				continue
 			}
 			if machineIpnet.Contains(ip) {
@@ -129,31 +232,165 @@ func GetMachineCIDRHosts(log logrus.FieldLogger, cluster *common.Cluster) ([]*mo
 	if cluster.MachineNetworkCidr == "" {
 		return nil, errors.New("Machine network CIDR was not set in cluster")
 	}
-	_, machineIpnet, err := net.ParseCIDR(cluster.MachineNetworkCidr)
+	machineIpnets, err := machineNetworks(cluster)
 	if err != nil {
 		return nil, err
 	}
 	ret := make([]*models.Host, 0)
 	for _, h := range cluster.Hosts {
-		if belongsToNetwork(log, h, machineIpnet) {
+		if belongsToNetworks(log, h, machineIpnets) {
 			ret = append(ret, h)
 		}
 	}
 	return ret, nil
 }
 
+// ParseMachineNetworkCidrs parses a comma-separated list of machine network CIDRs
+func ParseMachineNetworkCidrs(cidrs string) []string {
+	ret := make([]string, 0)
+	for _, cidr := range strings.Split(cidrs, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr != "" {
+			ret = append(ret, cidr)
+		}
+	}
+	return ret
+}
+
+// MachineNetworkCidrs returns the machine networks of the cluster: the one of the VIPs, the additional ones of the
+// hosts in other subnets, and the secondary one of a dual-stack cluster
+func MachineNetworkCidrs(cluster *models.Cluster) []string {
+	ret := make([]string, 0)
+	if cluster.MachineNetworkCidr != "" {
+		ret = append(ret, cluster.MachineNetworkCidr)
+	}
+	ret = append(ret, ParseMachineNetworkCidrs(cluster.AdditionalMachineNetworkCidrs)...)
+	if cluster.SecondaryMachineNetworkCidr != "" {
+		ret = append(ret, cluster.SecondaryMachineNetworkCidr)
+	}
+	return ret
+}
+
+// vipNetworkCidrs returns the machine networks that the VIPs may move across, the machine network of the cluster and
+// the secondary one of a dual-stack cluster
+func vipNetworkCidrs(cluster *models.Cluster) []string {
+	ret := make([]string, 0, 2)
+	for _, cidr := range []string{cluster.MachineNetworkCidr, cluster.SecondaryMachineNetworkCidr} {
+		if cidr != "" {
+			ret = append(ret, cidr)
+		}
+	}
+	return ret
+}
+
+func parseNetworks(cidrs []string) ([]*net.IPNet, error) {
+	ret := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, ipnet)
+	}
+	return ret, nil
+}
+
+// machineNetworks returns all the machine networks of the cluster
+func machineNetworks(cluster *common.Cluster) ([]*net.IPNet, error) {
+	return parseNetworks(MachineNetworkCidrs(&cluster.Cluster))
+}
+
+// belongsToNetworks returns whether the host has an address in one of the networks of each IP family
+func belongsToNetworks(log logrus.FieldLogger, h *models.Host, ipnets []*net.IPNet) bool {
+	for _, isIPv4 := range []bool{true, false} {
+		found, familyExists := false, false
+		for _, ipnet := range ipnets {
+			if (ipnet.IP.To4() != nil) != isIPv4 {
+				continue
+			}
+			familyExists = true
+			if belongsToNetwork(log, h, ipnet) {
+				found = true
+				break
+			}
+		}
+		if familyExists && !found {
+			return false
+		}
+	}
+	return true
+}
+
+// IsHostInMachineNetCidr returns whether the host has an address in one of the machine networks, and in the
+// secondary one of a dual-stack cluster
 func IsHostInMachineNetCidr(log logrus.FieldLogger, cluster *common.Cluster, host *models.Host) bool {
-	_, machineIpnet, err := net.ParseCIDR(cluster.MachineNetworkCidr)
-	if err != nil {
+	machineIpnets, err := machineNetworks(cluster)
+	if err != nil || len(machineIpnets) == 0 {
 		return false
 	}
-	return belongsToNetwork(log, host, machineIpnet)
+	return belongsToNetworks(log, host, machineIpnets)
 }
 
-type IPSet map[strfmt.IPv4]struct{}
+// IsHostInVipNetwork returns whether the host has an address in the machine network of the VIPs, and in the secondary
+// one of a dual-stack cluster, as the masters that hold the VIPs must
+func IsHostInVipNetwork(log logrus.FieldLogger, cluster *common.Cluster, host *models.Host) bool {
+	ipnets, err := parseNetworks(vipNetworkCidrs(&cluster.Cluster))
+	if err != nil || len(ipnets) == 0 {
+		return false
+	}
+	return belongsToNetworks(log, host, ipnets)
+}
 
-func (s IPSet) Add(str strfmt.IPv4) {
-	s[str] = struct{}{}
+// GetHostMachineNetwork returns the first machine network of the cluster that the host has an address in, empty
+// when there is none
+func GetHostMachineNetwork(log logrus.FieldLogger, cluster *common.Cluster, host *models.Host) string {
+	if host.Inventory == "" {
+		return ""
+	}
+	for _, cidr := range MachineNetworkCidrs(&cluster.Cluster) {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err == nil && belongsToNetwork(log, host, ipnet) {
+			return ipnet.String()
+		}
+	}
+	return ""
+}
+
+// GetMachineCidrInterfaces returns the interfaces of the inventory that have an address in the machine network CIDR
+func GetMachineCidrInterfaces(inventory *models.Inventory, machineNetworkCidr string) []*models.Interface {
+	ret := make([]*models.Interface, 0)
+	_, machineIpnet, err := net.ParseCIDR(machineNetworkCidr)
+	if err != nil {
+		return ret
+	}
+	for _, intf := range inventory.Interfaces {
+		for _, addr := range interfaceAddresses(intf) {
+			ip, _, err := net.ParseCIDR(addr)
+			if err == nil && machineIpnet.Contains(ip) {
+				ret = append(ret, intf)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// IPSet is a set of IPv4 or IPv6 addresses, keyed by their canonical string form
+type IPSet map[string]struct{}
+
+func canonicalIP(str string) string {
+	if ip := net.ParseIP(str); ip != nil {
+		return ip.String()
+	}
+	return str
+}
+
+func (s IPSet) Add(str string) {
+	s[canonicalIP(str)] = struct{}{}
+}
+
+func (s IPSet) Contains(str string) bool {
+	_, ok := s[canonicalIP(str)]
+	return ok
 }
 
 func (s IPSet) Intersect(other IPSet) IPSet {
@@ -176,7 +413,7 @@ func freeAddressesUnmarshal(network, freeAddressesStr string, prefix *string) (I
 		if f.Network == network {
 			ret := make(IPSet)
 			for _, a := range f.FreeAddresses {
-				if prefix == nil || strings.HasPrefix(a.String(), *prefix) {
+				if prefix == nil || strings.HasPrefix(a, *prefix) {
 					ret.Add(a)
 				}
 			}
@@ -227,7 +464,18 @@ func IpInFreeList(hosts []*models.Host, vipIPStr, network string, log logrus.Fie
 	isFree := true
 	freeSet := MakeFreeAddressesSet(hosts, network, nil, log)
 	if len(freeSet) > 0 {
-		_, isFree = freeSet[strfmt.IPv4(vipIPStr)]
+		isFree = freeSet.Contains(vipIPStr)
 	}
 	return isFree
 }
+
+// SortIPs sorts addresses by their numeric value, the IPv4 addresses before the IPv6 ones
+func SortIPs(ips []string) {
+	sort.Slice(ips, func(i, j int) bool {
+		ip1, ip2 := net.ParseIP(ips[i]).To16(), net.ParseIP(ips[j]).To16()
+		if isIPv4, otherIsIPv4 := ip1.To4() != nil, ip2.To4() != nil; isIPv4 != otherIsIPv4 {
+			return isIPv4
+		}
+		return bytes.Compare(ip1, ip2) < 0
+	})
+}
@@ -23,6 +23,13 @@ var _ = Describe("inventory", func() {
 		}
 	}
 
+	createDualStackInterface := func(ipv4Address string, ipv6Addresses ...string) *models.Interface {
+		return &models.Interface{
+			IPV4Addresses: []string{ipv4Address},
+			IPV6Addresses: append([]string{}, ipv6Addresses...),
+		}
+	}
+
 	createInventory := func(interfaces ...*models.Interface) string {
 		inventory := models.Inventory{Interfaces: interfaces}
 		ret, _ := json.Marshal(&inventory)
@@ -95,6 +102,13 @@ var _ = Describe("inventory", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(cidr).To(Equal(""))
 		})
+		It("IPv6", func() {
+			cluster := createCluster("fd00:1::20", "",
+				createInventory(createDualStackInterface("1.2.5.7/23", "fe80::5054:ff:fe01:2/64", "fd00:1::7/64")))
+			cidr, err := CalculateMachineNetworkCIDR(cluster.APIVip, cluster.IngressVip, cluster.Hosts)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(cidr).To(Equal("fd00:1::/64"))
+		})
 		It("Bad inventory", func() {
 			cluster := createCluster("1.2.5.6", "",
 				"Bad inventory",
@@ -105,6 +119,29 @@ var _ = Describe("inventory", func() {
 			Expect(cidr).To(Equal("1.2.4.0/23"))
 		})
 	})
+	Context("CalculateSecondaryMachineNetworkCIDR", func() {
+		It("happy flow", func() {
+			cluster := createCluster("1.2.5.6", "1.2.4.0/23",
+				createInventory(createDualStackInterface("3.3.3.3/16", "fd00:3::3/64")),
+				createInventory(createDualStackInterface("1.2.5.7/23", "fe80::5054:ff:fe01:2/64", "fd00:1::7/64")))
+			cidr, err := CalculateSecondaryMachineNetworkCIDR(cluster.MachineNetworkCidr, cluster.Hosts)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(cidr).To(Equal("fd00:1::/64"))
+		})
+		It("IPv6 machine network", func() {
+			cluster := createCluster("fd00:1::20", "fd00:1::/64",
+				createInventory(createDualStackInterface("1.2.5.7/23", "fd00:1::7/64")))
+			cidr, err := CalculateSecondaryMachineNetworkCIDR(cluster.MachineNetworkCidr, cluster.Hosts)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(cidr).To(Equal("1.2.4.0/23"))
+		})
+		It("Only link-local", func() {
+			cluster := createCluster("1.2.5.6", "1.2.4.0/23",
+				createInventory(createDualStackInterface("1.2.5.7/23", "fe80::5054:ff:fe01:2/64")))
+			_, err := CalculateSecondaryMachineNetworkCIDR(cluster.MachineNetworkCidr, cluster.Hosts)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 	Context("GetMachineCIDRHosts", func() {
 		It("No Machine CIDR", func() {
 			cluster := createCluster("1.2.5.6", "",
@@ -134,6 +171,72 @@ var _ = Describe("inventory", func() {
 			}))
 
 		})
+		It("Dual-stack", func() {
+			cluster := createCluster("1.2.5.6", "1.2.4.0/23",
+				createInventory(createDualStackInterface("1.2.5.7/23", "fd00:1::7/64")),
+				createInventory(createInterface("1.2.4.79/23")),
+				createInventory(createDualStackInterface("1.2.5.8/23", "fd00:2::8/64")))
+			cluster.SecondaryMachineNetworkCidr = "fd00:1::/64"
+			hosts, err := GetMachineCIDRHosts(logrus.New(), cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(hosts).To(Equal([]*models.Host{cluster.Hosts[0]}))
+			Expect(IsHostInMachineNetCidr(logrus.New(), cluster, cluster.Hosts[0])).To(BeTrue())
+			Expect(IsHostInMachineNetCidr(logrus.New(), cluster, cluster.Hosts[1])).To(BeFalse())
+		})
+		It("Additional machine networks", func() {
+			cluster := createCluster("1.2.5.6", "1.2.4.0/23",
+				createInventory(createInterface("1.2.5.7/23")),
+				createInventory(createInterface("1.2.8.7/24")),
+				createInventory(createInterface("1.2.9.7/24")),
+				createInventory(createInterface("1.2.10.7/24")))
+			cluster.AdditionalMachineNetworkCidrs = "1.2.8.0/24, 1.2.9.0/24"
+			hosts, err := GetMachineCIDRHosts(logrus.New(), cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(hosts).To(Equal(cluster.Hosts[:3]))
+			Expect(IsHostInMachineNetCidr(logrus.New(), cluster, cluster.Hosts[2])).To(BeTrue())
+			Expect(IsHostInVipNetwork(logrus.New(), cluster, cluster.Hosts[0])).To(BeTrue())
+			Expect(IsHostInVipNetwork(logrus.New(), cluster, cluster.Hosts[2])).To(BeFalse())
+			Expect(GetHostMachineNetwork(logrus.New(), cluster, cluster.Hosts[0])).To(Equal("1.2.4.0/23"))
+			Expect(GetHostMachineNetwork(logrus.New(), cluster, cluster.Hosts[1])).To(Equal("1.2.8.0/24"))
+			Expect(GetHostMachineNetwork(logrus.New(), cluster, cluster.Hosts[3])).To(BeEmpty())
+		})
+		It("Dual-stack with additional machine networks", func() {
+			cluster := createCluster("1.2.5.6", "1.2.4.0/23",
+				createInventory(createDualStackInterface("1.2.5.7/23", "fd00:1::7/64")),
+				createInventory(createDualStackInterface("1.2.8.7/24", "fd00:1::8/64")),
+				createInventory(createDualStackInterface("1.2.8.8/24", "fd00:2::8/64")),
+				createInventory(createDualStackInterface("1.2.8.9/24", "fd00:3::9/64")))
+			cluster.SecondaryMachineNetworkCidr = "fd00:1::/64"
+			cluster.AdditionalMachineNetworkCidrs = "1.2.8.0/24,fd00:2::/64"
+			Expect(MachineNetworkCidrs(&cluster.Cluster)).To(Equal([]string{"1.2.4.0/23", "1.2.8.0/24", "fd00:2::/64", "fd00:1::/64"}))
+			hosts, err := GetMachineCIDRHosts(logrus.New(), cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(hosts).To(Equal(cluster.Hosts[:3]))
+			Expect(IsHostInVipNetwork(logrus.New(), cluster, cluster.Hosts[1])).To(BeFalse())
+		})
+	})
+	Context("ParseMachineNetworkCidrs", func() {
+		It("Comma-separated", func() {
+			Expect(ParseMachineNetworkCidrs(" 1.2.8.0/24,, fd00:2::/64 ")).To(Equal([]string{"1.2.8.0/24", "fd00:2::/64"}))
+			Expect(ParseMachineNetworkCidrs("")).To(BeEmpty())
+		})
+	})
+	Context("GetMachineCidrInterfaces", func() {
+		It("Some matched", func() {
+			inventory := models.Inventory{Interfaces: []*models.Interface{
+				{Name: "eth0", IPV4Addresses: []string{"3.3.3.3/16"}},
+				{Name: "eth1", IPV4Addresses: []string{"8.8.8.8/8", "1.2.5.7/23"}},
+				{Name: "eth2", IPV4Addresses: []string{"1.2.4.79/23"}},
+			}}
+			interfaces := GetMachineCidrInterfaces(&inventory, "1.2.4.0/23")
+			Expect(interfaces).To(Equal([]*models.Interface{inventory.Interfaces[1], inventory.Interfaces[2]}))
+		})
+		It("Illegal machine CIDR", func() {
+			inventory := models.Inventory{Interfaces: []*models.Interface{
+				{Name: "eth0", IPV4Addresses: []string{"1.2.4.79/23"}},
+			}}
+			Expect(GetMachineCidrInterfaces(&inventory, "")).To(BeEmpty())
+		})
 	})
 	Context("VerifyVips", func() {
 		var log logrus.FieldLogger
@@ -212,6 +315,21 @@ var _ = Describe("inventory", func() {
 			err = VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
 			Expect(err).ToNot(HaveOccurred())
 		})
+		It("IPv6 free", func() {
+			cluster := createCluster("fd00:1::6", "fd00:1::/120",
+				createInventory(createDualStackInterface("1.2.5.7/23", "fd00:1::7/120")))
+			cluster.IngressVip = "fd00:1:0::8"
+			cluster.Hosts = []*models.Host{
+				{
+					FreeAddresses: "[{\"network\":\"fd00:1::/120\",\"free_addresses\":[\"fd00:1::6\",\"fd00:1:0:0::8\"]}]",
+				},
+			}
+			err := VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			Expect(err).ToNot(HaveOccurred())
+			cluster.IngressVip = "fd00:1::9"
+			err = VerifyVips(cluster.Hosts, cluster.MachineNetworkCidr, cluster.APIVip, cluster.IngressVip, true, log)
+			Expect(err).To(HaveOccurred())
+		})
 		It("Free", func() {
 			cluster := createCluster("1.2.5.6", "1.2.4.0/23",
 				createInventory(createInterface("1.2.5.7/23")))
@@ -227,6 +345,56 @@ var _ = Describe("inventory", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+	Context("VerifyNetworkFamilies", func() {
+		createNetworks := func(machine, clusterNet, service, secondaryClusterNet, secondaryService string) *models.Cluster {
+			return &models.Cluster{
+				MachineNetworkCidr:          machine,
+				ClusterNetworkCidr:          clusterNet,
+				ServiceNetworkCidr:          service,
+				SecondaryClusterNetworkCidr: secondaryClusterNet,
+				SecondaryServiceNetworkCidr: secondaryService,
+			}
+		}
+		It("IPv4", func() {
+			Expect(VerifyNetworkFamilies(createNetworks("1.2.4.0/23", "10.128.0.0/14", "172.30.0.0/16", "", ""))).To(Succeed())
+		})
+		It("IPv6", func() {
+			Expect(VerifyNetworkFamilies(createNetworks("fd00:1::/64", "fd01::/48", "fd02::/112", "", ""))).To(Succeed())
+		})
+		It("Dual-stack", func() {
+			Expect(VerifyNetworkFamilies(createNetworks("1.2.4.0/23", "10.128.0.0/14", "172.30.0.0/16", "fd01::/48", "fd02::/112"))).To(Succeed())
+			Expect(VerifyNetworkFamilies(createNetworks("fd00:1::/64", "10.128.0.0/14", "172.30.0.0/16", "fd01::/48", "fd02::/112"))).To(Succeed())
+		})
+		It("Machine network of another family", func() {
+			Expect(VerifyNetworkFamilies(createNetworks("fd00:1::/64", "10.128.0.0/14", "172.30.0.0/16", "", ""))).ToNot(Succeed())
+		})
+		It("Cluster and service networks of different families", func() {
+			Expect(VerifyNetworkFamilies(createNetworks("", "fd01::/48", "172.30.0.0/16", "", ""))).ToNot(Succeed())
+		})
+		It("Secondary networks of the same family", func() {
+			Expect(VerifyNetworkFamilies(createNetworks("", "10.128.0.0/14", "172.30.0.0/16", "10.200.0.0/14", "fd02::/112"))).ToNot(Succeed())
+			Expect(VerifyNetworkFamilies(createNetworks("", "10.128.0.0/14", "172.30.0.0/16", "fd01::/48", "172.31.0.0/16"))).ToNot(Succeed())
+		})
+		It("Secondary networks set apart", func() {
+			Expect(VerifyNetworkFamilies(createNetworks("", "10.128.0.0/14", "172.30.0.0/16", "fd01::/48", ""))).ToNot(Succeed())
+		})
+	})
+	Context("MakeFreeAddressesSet", func() {
+		It("IPv6 canonical form", func() {
+			hosts := []*models.Host{
+				{FreeAddresses: "[{\"network\":\"fd00:1::/120\",\"free_addresses\":[\"fd00:1::6\",\"FD00:1::7\"]}]"},
+				{FreeAddresses: "[{\"network\":\"fd00:1::/120\",\"free_addresses\":[\"fd00:1:0:0:0:0:0:7\"]}]"},
+			}
+			set := MakeFreeAddressesSet(hosts, "fd00:1::/120", nil, logrus.New())
+			Expect(set).To(HaveLen(1))
+			Expect(set.Contains("fd00:1::7")).To(BeTrue())
+		})
+	})
+	It("SortIPs", func() {
+		ips := []string{"fd00::10", "10.0.10.1", "fd00::9", "10.0.9.250", "255.255.255.255"}
+		SortIPs(ips)
+		Expect(ips).To(Equal([]string{"10.0.9.250", "10.0.10.1", "255.255.255.255", "fd00::9", "fd00::10"}))
+	})
 })
 
 func TestMachineNetworkCidr(t *testing.T) {
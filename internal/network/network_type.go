@@ -0,0 +1,69 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/filanov/bm-inventory/models"
+)
+
+// supportedNetworkTypes are the cluster network providers that each OpenShift version supports
+var supportedNetworkTypes = map[string][]string{
+	"4.5": {models.ClusterNetworkTypeOpenShiftSDN, models.ClusterNetworkTypeOVNKubernetes},
+}
+
+// SupportedNetworkTypes returns the network types that an OpenShift version supports, nothing for an unknown version
+func SupportedNetworkTypes(openshiftVersion string) []string {
+	return supportedNetworkTypes[openshiftVersion]
+}
+
+// hasIPv6Networks returns whether any network of the cluster belongs to the IPv6 family
+func hasIPv6Networks(cluster *models.Cluster) bool {
+	if IsDualStack(cluster) {
+		return true
+	}
+	for _, cidr := range []string{cluster.ClusterNetworkCidr, cluster.ServiceNetworkCidr, cluster.MachineNetworkCidr} {
+		if cidr != "" && !IsIPv4(cidr) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultNetworkType returns the network type of a cluster that does not select one, according to its networks
+func DefaultNetworkType(cluster *models.Cluster) string {
+	if hasIPv6Networks(cluster) {
+		return models.ClusterNetworkTypeOVNKubernetes
+	}
+	return models.ClusterNetworkTypeOpenShiftSDN
+}
+
+// GetNetworkType returns the network type of the cluster, the default one when it is not set
+func GetNetworkType(cluster *models.Cluster) string {
+	if cluster.NetworkType != "" {
+		return cluster.NetworkType
+	}
+	return DefaultNetworkType(cluster)
+}
+
+/*
+ * Verify that the OpenShift version of the cluster supports its network type, and that the network type supports the
+ * networks of the cluster. Nothing is verified when the network type is not set, the default one is used.
+ */
+func VerifyNetworkType(cluster *models.Cluster) error {
+	if cluster.NetworkType == "" {
+		return nil
+	}
+	supported := false
+	for _, networkType := range SupportedNetworkTypes(cluster.OpenshiftVersion) {
+		supported = supported || networkType == cluster.NetworkType
+	}
+	if !supported {
+		return fmt.Errorf("network-type %s is not supported by OpenShift version %s", cluster.NetworkType,
+			cluster.OpenshiftVersion)
+	}
+	if cluster.NetworkType != models.ClusterNetworkTypeOVNKubernetes && hasIPv6Networks(cluster) {
+		return fmt.Errorf("network-type %s does not support IPv6 and dual-stack networks, use %s", cluster.NetworkType,
+			models.ClusterNetworkTypeOVNKubernetes)
+	}
+	return nil
+}
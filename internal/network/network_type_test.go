@@ -0,0 +1,60 @@
+package network
+
+import (
+	"github.com/filanov/bm-inventory/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("network type", func() {
+	var cluster *models.Cluster
+
+	BeforeEach(func() {
+		cluster = &models.Cluster{
+			OpenshiftVersion:   "4.5",
+			ClusterNetworkCidr: "10.128.0.0/14",
+			ServiceNetworkCidr: "172.30.0.0/16",
+			MachineNetworkCidr: "1.2.3.0/24",
+		}
+	})
+
+	It("default", func() {
+		Expect(DefaultNetworkType(cluster)).To(Equal(models.ClusterNetworkTypeOpenShiftSDN))
+		Expect(GetNetworkType(cluster)).To(Equal(models.ClusterNetworkTypeOpenShiftSDN))
+		cluster.SecondaryClusterNetworkCidr = "fd01::/48"
+		cluster.SecondaryServiceNetworkCidr = "fd02::/112"
+		Expect(DefaultNetworkType(cluster)).To(Equal(models.ClusterNetworkTypeOVNKubernetes))
+	})
+	It("default IPv6", func() {
+		cluster.MachineNetworkCidr = "fd00:1::/64"
+		Expect(DefaultNetworkType(cluster)).To(Equal(models.ClusterNetworkTypeOVNKubernetes))
+	})
+	It("set", func() {
+		cluster.NetworkType = models.ClusterNetworkTypeOVNKubernetes
+		Expect(GetNetworkType(cluster)).To(Equal(models.ClusterNetworkTypeOVNKubernetes))
+		Expect(VerifyNetworkType(cluster)).To(Succeed())
+	})
+	It("not set", func() {
+		cluster.ClusterNetworkCidr = "fd01::/48"
+		Expect(VerifyNetworkType(cluster)).To(Succeed())
+	})
+	It("not supported by the version", func() {
+		cluster.OpenshiftVersion = "4.4"
+		cluster.NetworkType = models.ClusterNetworkTypeOVNKubernetes
+		Expect(VerifyNetworkType(cluster)).NotTo(Succeed())
+	})
+	It("OpenShiftSDN with IPv6 networks", func() {
+		cluster.NetworkType = models.ClusterNetworkTypeOpenShiftSDN
+		Expect(VerifyNetworkType(cluster)).To(Succeed())
+		cluster.ServiceNetworkCidr = "fd02::/112"
+		Expect(VerifyNetworkType(cluster)).NotTo(Succeed())
+	})
+	It("OpenShiftSDN with dual-stack networks", func() {
+		cluster.NetworkType = models.ClusterNetworkTypeOpenShiftSDN
+		cluster.SecondaryClusterNetworkCidr = "fd01::/48"
+		cluster.SecondaryServiceNetworkCidr = "fd02::/112"
+		Expect(VerifyNetworkType(cluster)).NotTo(Succeed())
+		cluster.NetworkType = models.ClusterNetworkTypeOVNKubernetes
+		Expect(VerifyNetworkType(cluster)).To(Succeed())
+	})
+})
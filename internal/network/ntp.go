@@ -0,0 +1,93 @@
+package network
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ChronyConfPath = "/etc/chrony.conf"
+
+	chronyConfMode = 420
+)
+
+var ntpHostnameRegex = regexp.MustCompile(`^([a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`)
+
+// The roles of the installed nodes that get the chrony configuration
+var chronyRoles = []string{"master", "worker"}
+
+const chronyMachineConfigFormat = `apiVersion: machineconfiguration.openshift.io/v1
+kind: MachineConfig
+metadata:
+  labels:
+    machineconfiguration.openshift.io/role: %[1]s
+  name: 50-%[1]s-chrony
+spec:
+  config:
+    ignition:
+      version: 2.2.0
+    storage:
+      files:
+      - contents:
+          source: data:text/plain;charset=utf-8;base64,%[2]s
+        filesystem: root
+        mode: %[3]d
+        path: %[4]s
+`
+
+// ParseNtpServers returns the servers of a comma-separated list of NTP servers, ignoring empty entries
+func ParseNtpServers(ntpServers string) []string {
+	servers := make([]string, 0)
+	for _, server := range strings.Split(ntpServers, ",") {
+		if server = strings.TrimSpace(server); server != "" {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
+// ValidateNtpServers checks that every server of a comma-separated list of NTP servers is an IP address or a
+// hostname, and that no server is listed twice
+func ValidateNtpServers(ntpServers string) error {
+	seen := make(map[string]bool)
+	for _, server := range ParseNtpServers(ntpServers) {
+		if net.ParseIP(server) == nil && (len(server) > 253 || !ntpHostnameRegex.MatchString(server)) {
+			return errors.Errorf("NTP server %s is neither an IP address nor a hostname", server)
+		}
+		if seen[strings.ToLower(server)] {
+			return errors.Errorf("NTP server %s is listed more than once", server)
+		}
+		seen[strings.ToLower(server)] = true
+	}
+	return nil
+}
+
+// ChronyConf returns the chrony configuration that synchronizes the clock with the given servers
+func ChronyConf(servers []string) string {
+	var conf strings.Builder
+	for _, server := range servers {
+		fmt.Fprintf(&conf, "server %s iburst\n", server)
+	}
+	conf.WriteString("driftfile /var/lib/chrony/drift\nmakestep 1.0 3\nrtcsync\nlogdir /var/log/chrony\n")
+	return conf.String()
+}
+
+// ChronyMachineConfigs returns the manifests, by file name, of the machine configs that set the chrony
+// configuration of the installed nodes, nothing if there are no servers
+func ChronyMachineConfigs(servers []string) map[string]string {
+	manifests := make(map[string]string)
+	if len(servers) == 0 {
+		return manifests
+	}
+	contents := base64.StdEncoding.EncodeToString([]byte(ChronyConf(servers)))
+	for _, role := range chronyRoles {
+		manifests[fmt.Sprintf("50-%s-chrony.yaml", role)] =
+			fmt.Sprintf(chronyMachineConfigFormat, role, contents, chronyConfMode, ChronyConfPath)
+	}
+	return manifests
+}
@@ -0,0 +1,85 @@
+package network
+
+import (
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("ntp servers", func() {
+	It("parse", func() {
+		Expect(ParseNtpServers(" clock.example.com, ,10.0.0.1,")).To(Equal([]string{"clock.example.com", "10.0.0.1"}))
+		Expect(ParseNtpServers("")).To(BeEmpty())
+	})
+
+	It("valid servers", func() {
+		Expect(ValidateNtpServers("")).ShouldNot(HaveOccurred())
+		Expect(ValidateNtpServers("clock.example.com,10.0.0.1,fd00::1,ntp1")).ShouldNot(HaveOccurred())
+	})
+
+	tests := []struct {
+		name       string
+		ntpServers string
+	}{
+		{name: "invalid hostname", ntpServers: "clock_1.example.com"},
+		{name: "hostname with trailing dash", ntpServers: "clock-.example.com"},
+		{name: "url", ntpServers: "ntp://clock.example.com"},
+		{name: "duplicate server", ntpServers: "clock.example.com,Clock.example.com"},
+	}
+
+	for i := range tests {
+		t := tests[i]
+		It(t.name, func() {
+			Expect(ValidateNtpServers(t.ntpServers)).Should(HaveOccurred())
+		})
+	}
+
+	It("chrony configuration", func() {
+		Expect(ChronyConf([]string{"clock.example.com", "10.0.0.1"})).To(Equal("server clock.example.com iburst\n" +
+			"server 10.0.0.1 iburst\ndriftfile /var/lib/chrony/drift\nmakestep 1.0 3\nrtcsync\nlogdir /var/log/chrony\n"))
+	})
+
+	It("machine configs", func() {
+		manifests := ChronyMachineConfigs([]string{"clock.example.com"})
+		Expect(manifests).To(HaveLen(2))
+		for _, role := range []string{"master", "worker"} {
+			manifest, ok := manifests["50-"+role+"-chrony.yaml"]
+			Expect(ok).To(BeTrue())
+			var mc struct {
+				Metadata struct {
+					Name   string            `yaml:"name"`
+					Labels map[string]string `yaml:"labels"`
+				} `yaml:"metadata"`
+				Spec struct {
+					Config struct {
+						Storage struct {
+							Files []struct {
+								Path     string `yaml:"path"`
+								Contents struct {
+									Source string `yaml:"source"`
+								} `yaml:"contents"`
+							} `yaml:"files"`
+						} `yaml:"storage"`
+					} `yaml:"config"`
+				} `yaml:"spec"`
+			}
+			Expect(yaml.Unmarshal([]byte(manifest), &mc)).ShouldNot(HaveOccurred())
+			Expect(mc.Metadata.Name).To(Equal("50-" + role + "-chrony"))
+			Expect(mc.Metadata.Labels["machineconfiguration.openshift.io/role"]).To(Equal(role))
+			Expect(mc.Spec.Config.Storage.Files).To(HaveLen(1))
+			Expect(mc.Spec.Config.Storage.Files[0].Path).To(Equal(ChronyConfPath))
+			source := mc.Spec.Config.Storage.Files[0].Contents.Source
+			Expect(strings.HasPrefix(source, "data:text/plain;charset=utf-8;base64,")).To(BeTrue())
+			conf, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(source, "data:text/plain;charset=utf-8;base64,"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(conf)).To(Equal(ChronyConf([]string{"clock.example.com"})))
+		}
+	})
+
+	It("no machine configs without servers", func() {
+		Expect(ChronyMachineConfigs(nil)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,118 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+)
+
+// IsProxySet returns whether the hosts and the installed cluster access the network through a proxy
+func IsProxySet(cluster *models.Cluster) bool {
+	return cluster.HTTPProxy != "" || cluster.HTTPSProxy != ""
+}
+
+// RedactProxyURL returns the proxy URL without the user name and password that it may contain, it is shown in events
+// and previews
+func RedactProxyURL(proxyURL string) string {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		if i := strings.LastIndex(proxyURL, "@"); i >= 0 {
+			return proxyURL[i+1:]
+		}
+		return proxyURL
+	}
+	u.User = nil
+	return u.String()
+}
+
+// ParseNoProxy returns the entries of a comma-separated no-proxy list, ignoring empty entries
+func ParseNoProxy(noProxy string) []string {
+	entries := make([]string, 0)
+	for _, entry := range strings.Split(noProxy, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// APIDomains returns the domains of the API of the cluster, nothing if its name or base domain are not set
+func APIDomains(cluster *models.Cluster) []string {
+	if cluster.Name == "" || cluster.BaseDNSDomain == "" {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("api.%s.%s", cluster.Name, cluster.BaseDNSDomain),
+		fmt.Sprintf("api-int.%s.%s", cluster.Name, cluster.BaseDNSDomain),
+	}
+}
+
+// noProxyContainsNetwork returns whether a CIDR of the no-proxy list contains the whole network
+func noProxyContainsNetwork(entries []string, ipnet *net.IPNet) bool {
+	ones, bits := ipnet.Mask.Size()
+	for _, entry := range entries {
+		_, n, err := net.ParseCIDR(entry)
+		if err != nil {
+			continue
+		}
+		if entryOnes, entryBits := n.Mask.Size(); entryBits == bits && entryOnes <= ones && n.Contains(ipnet.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// noProxyContainsDomain returns whether a domain of the no-proxy list is the domain or one of its parents
+func noProxyContainsDomain(entries []string, domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	for _, entry := range entries {
+		entry = strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(entry, "."), "."))
+		if domain == entry || strings.HasSuffix(domain, "."+entry) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+ * Verify that the hosts and the installed cluster access their own networks and API without the proxy: when a proxy
+ * is set, the no-proxy list must contain the machine, cluster and service networks and the API domains of the
+ * cluster, unless it is '*'.  Networks that are not set are not verified.
+ */
+func VerifyNoProxy(cluster *models.Cluster) error {
+	if !IsProxySet(cluster) {
+		return nil
+	}
+	entries := ParseNoProxy(cluster.NoProxy)
+	if len(entries) == 1 && entries[0] == "*" {
+		return nil
+	}
+	networks := namedMachineNetworks(cluster)
+	for _, n := range []struct {
+		name string
+		cidr string
+	}{
+		{"cluster-network-cidr", cluster.ClusterNetworkCidr},
+		{"service-network-cidr", cluster.ServiceNetworkCidr},
+		{"secondary-cluster-network-cidr", cluster.SecondaryClusterNetworkCidr},
+		{"secondary-service-network-cidr", cluster.SecondaryServiceNetworkCidr},
+	} {
+		if _, ipnet, err := net.ParseCIDR(n.cidr); err == nil {
+			networks = append(networks, namedNetwork{name: n.name, ipnet: ipnet})
+		}
+	}
+	for _, n := range networks {
+		if !noProxyContainsNetwork(entries, n.ipnet) {
+			return fmt.Errorf("no-proxy does not contain the %s <%s>", n.name, n.ipnet)
+		}
+	}
+	for _, domain := range APIDomains(cluster) {
+		if !noProxyContainsDomain(entries, domain) {
+			return fmt.Errorf("no-proxy does not contain the API domain %s", domain)
+		}
+	}
+	return nil
+}
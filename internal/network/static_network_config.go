@@ -0,0 +1,413 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	staticNetworkConfigDir = "/etc/assisted/network"
+	nmConnectionsDir       = "/etc/NetworkManager/system-connections"
+
+	StaticNetworkScriptPath = "/usr/local/bin/apply-static-network.sh"
+	StaticNetworkUnitName   = "static-network.service"
+)
+
+// The script copies the connection profiles that belong to the current host, identified by the MAC addresses of
+// its interfaces, before NetworkManager starts
+var staticNetworkScript = fmt.Sprintf(`#!/bin/bash
+for dir in %[1]s/*/; do
+  [ -f "${dir}mac" ] || continue
+  mac=$(cat "${dir}mac")
+  if grep -qix "${mac}" /sys/class/net/*/address; then
+    cp "${dir}"*.nmconnection %[2]s/
+    chmod 600 %[2]s/*.nmconnection
+  fi
+done
+`, staticNetworkConfigDir, nmConnectionsDir)
+
+var StaticNetworkUnitContents = fmt.Sprintf("[Unit]\nDescription=Apply static network configuration\n"+
+	"Wants=network-pre.target\nBefore=network-pre.target NetworkManager.service\n\n"+
+	"[Service]\nType=oneshot\nExecStart=%s\n\n[Install]\nWantedBy=multi-user.target\n", StaticNetworkScriptPath)
+
+type StaticNetworkFile struct {
+	Path     string
+	Contents string
+	Mode     int
+}
+
+type nmstateAddress struct {
+	IP           string `yaml:"ip"`
+	PrefixLength int    `yaml:"prefix-length"`
+}
+
+type nmstateIP struct {
+	Enabled  bool             `yaml:"enabled"`
+	DHCP     bool             `yaml:"dhcp"`
+	Autoconf bool             `yaml:"autoconf"`
+	Address  []nmstateAddress `yaml:"address"`
+}
+
+type nmstateLinkAggregation struct {
+	Mode    string            `yaml:"mode"`
+	Options map[string]string `yaml:"options"`
+	Port    []string          `yaml:"port"`
+	Slaves  []string          `yaml:"slaves"`
+}
+
+type nmstateVlan struct {
+	BaseIface string `yaml:"base-iface"`
+	ID        *int   `yaml:"id"`
+}
+
+type nmstateInterface struct {
+	Name            string                  `yaml:"name"`
+	Type            string                  `yaml:"type"`
+	State           string                  `yaml:"state"`
+	MacAddress      string                  `yaml:"mac-address"`
+	MTU             int                     `yaml:"mtu"`
+	IPv4            *nmstateIP              `yaml:"ipv4"`
+	IPv6            *nmstateIP              `yaml:"ipv6"`
+	LinkAggregation *nmstateLinkAggregation `yaml:"link-aggregation"`
+	Vlan            *nmstateVlan            `yaml:"vlan"`
+}
+
+type nmstateRoute struct {
+	Destination      string `yaml:"destination"`
+	NextHopAddress   string `yaml:"next-hop-address"`
+	NextHopInterface string `yaml:"next-hop-interface"`
+	Metric           *int   `yaml:"metric"`
+}
+
+type nmstateConfig struct {
+	Interfaces  []*nmstateInterface `yaml:"interfaces"`
+	DNSResolver struct {
+		Config struct {
+			Server []string `yaml:"server"`
+		} `yaml:"config"`
+	} `yaml:"dns-resolver"`
+	Routes struct {
+		Config []*nmstateRoute `yaml:"config"`
+	} `yaml:"routes"`
+}
+
+func (i *nmstateInterface) ports() []string {
+	if i.LinkAggregation == nil {
+		return nil
+	}
+	return append(append([]string{}, i.LinkAggregation.Port...), i.LinkAggregation.Slaves...)
+}
+
+func isIPv4(ip net.IP) bool {
+	return ip.To4() != nil
+}
+
+func validateIP(ipConfig *nmstateIP, v4 bool, name string) error {
+	if ipConfig == nil || !ipConfig.Enabled || ipConfig.DHCP || ipConfig.Autoconf {
+		return nil
+	}
+	family := "ipv6"
+	maxPrefix := 128
+	if v4 {
+		family = "ipv4"
+		maxPrefix = 32
+	}
+	if len(ipConfig.Address) == 0 {
+		return errors.Errorf("Interface %s has static %s configuration without addresses", name, family)
+	}
+	for _, address := range ipConfig.Address {
+		ip := net.ParseIP(address.IP)
+		if ip == nil || isIPv4(ip) != v4 {
+			return errors.Errorf("Invalid %s address %q of interface %s", family, address.IP, name)
+		}
+		if address.PrefixLength < 1 || address.PrefixLength > maxPrefix {
+			return errors.Errorf("Invalid prefix length %d of address %s of interface %s", address.PrefixLength, address.IP, name)
+		}
+	}
+	return nil
+}
+
+func validateInterface(intf *nmstateInterface) error {
+	if intf.Name == "" {
+		return errors.New("Interface name is missing")
+	}
+	switch intf.State {
+	case "", "up", "down":
+	default:
+		return errors.Errorf("Unsupported state %q of interface %s", intf.State, intf.Name)
+	}
+	if intf.MacAddress != "" {
+		if _, err := net.ParseMAC(intf.MacAddress); err != nil {
+			return errors.Errorf("Invalid MAC address %q of interface %s", intf.MacAddress, intf.Name)
+		}
+	}
+	if intf.MTU < 0 {
+		return errors.Errorf("Invalid MTU %d of interface %s", intf.MTU, intf.Name)
+	}
+	switch intf.Type {
+	case "ethernet":
+	case "bond":
+		if intf.LinkAggregation == nil || intf.LinkAggregation.Mode == "" {
+			return errors.Errorf("Bond %s is missing the link aggregation mode", intf.Name)
+		}
+		if len(intf.ports()) == 0 {
+			return errors.Errorf("Bond %s has no ports", intf.Name)
+		}
+	case "vlan":
+		if intf.Vlan == nil || intf.Vlan.BaseIface == "" || intf.Vlan.ID == nil {
+			return errors.Errorf("VLAN %s is missing the base interface or the VLAN id", intf.Name)
+		}
+		if *intf.Vlan.ID < 0 || *intf.Vlan.ID > 4094 {
+			return errors.Errorf("Invalid id %d of VLAN %s", *intf.Vlan.ID, intf.Name)
+		}
+	default:
+		return errors.Errorf("Unsupported type %q of interface %s, must be one of ethernet, bond or vlan", intf.Type, intf.Name)
+	}
+	if err := validateIP(intf.IPv4, true, intf.Name); err != nil {
+		return err
+	}
+	return validateIP(intf.IPv6, false, intf.Name)
+}
+
+func parseNetworkYaml(networkYaml string) (*nmstateConfig, error) {
+	var config nmstateConfig
+	if err := yaml.UnmarshalStrict([]byte(networkYaml), &config); err != nil {
+		return nil, errors.Wrap(err, "Failed to parse network YAML")
+	}
+	if len(config.Interfaces) == 0 {
+		return nil, errors.New("Network YAML must contain at least one interface")
+	}
+	names := make(map[string]*nmstateInterface)
+	for _, intf := range config.Interfaces {
+		if err := validateInterface(intf); err != nil {
+			return nil, err
+		}
+		if _, ok := names[intf.Name]; ok {
+			return nil, errors.Errorf("Interface %s is defined more than once", intf.Name)
+		}
+		names[intf.Name] = intf
+	}
+	for _, intf := range config.Interfaces {
+		if intf.Type == "vlan" {
+			if base, ok := names[intf.Vlan.BaseIface]; ok && base.Type == "vlan" {
+				return nil, errors.Errorf("Base interface %s of VLAN %s can not be a VLAN", base.Name, intf.Name)
+			}
+		}
+		for _, port := range intf.ports() {
+			if p, ok := names[port]; ok && p.Type != "ethernet" {
+				return nil, errors.Errorf("Port %s of bond %s must be an ethernet interface", port, intf.Name)
+			}
+		}
+	}
+	for _, server := range config.DNSResolver.Config.Server {
+		if net.ParseIP(server) == nil {
+			return nil, errors.Errorf("Invalid DNS server %q", server)
+		}
+	}
+	for _, route := range config.Routes.Config {
+		_, destination, err := net.ParseCIDR(route.Destination)
+		if err != nil {
+			return nil, errors.Errorf("Invalid route destination %q", route.Destination)
+		}
+		nextHop := net.ParseIP(route.NextHopAddress)
+		if nextHop == nil || isIPv4(nextHop) != isIPv4(destination.IP) {
+			return nil, errors.Errorf("Invalid next hop address %q of route to %s", route.NextHopAddress, route.Destination)
+		}
+		if _, ok := names[route.NextHopInterface]; !ok {
+			return nil, errors.Errorf("Next hop interface %q of route to %s is not defined", route.NextHopInterface, route.Destination)
+		}
+	}
+	return &config, nil
+}
+
+func normalizeMac(mac string) (string, error) {
+	hw, err := net.ParseMAC(mac)
+	if err != nil {
+		return "", errors.Errorf("Invalid MAC address %q", mac)
+	}
+	return hw.String(), nil
+}
+
+// ValidateStaticNetworkConfig verifies that the configuration of each host is valid and that each host is
+// identified by a different MAC address
+func ValidateStaticNetworkConfig(configs []*models.HostStaticNetworkConfig) error {
+	macs := make(map[string]bool)
+	for _, config := range configs {
+		mac, err := normalizeMac(swag.StringValue(config.MacAddress))
+		if err != nil {
+			return err
+		}
+		if macs[mac] {
+			return errors.Errorf("MAC address %s appears in more than one static network configuration", mac)
+		}
+		macs[mac] = true
+		if _, err = parseNetworkYaml(swag.StringValue(config.NetworkYaml)); err != nil {
+			return errors.Wrapf(err, "Invalid static network configuration of host with MAC address %s", mac)
+		}
+	}
+	return nil
+}
+
+func ipSection(ipConfig *nmstateIP, v4 bool, dnsServers []string, routes []*nmstateRoute) string {
+	family := "ipv6"
+	if v4 {
+		family = "ipv4"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n[%s]\n", family)
+	switch {
+	case ipConfig == nil || !ipConfig.Enabled:
+		if v4 {
+			b.WriteString("method=disabled\n")
+		} else {
+			b.WriteString("method=ignore\n")
+		}
+		return b.String()
+	case ipConfig.DHCP && !v4:
+		b.WriteString("method=dhcp\n")
+	case ipConfig.DHCP || ipConfig.Autoconf:
+		b.WriteString("method=auto\n")
+	default:
+		b.WriteString("method=manual\n")
+	}
+	for i, address := range ipConfig.Address {
+		fmt.Fprintf(&b, "address%d=%s/%d\n", i+1, address.IP, address.PrefixLength)
+	}
+	var servers []string
+	for _, server := range dnsServers {
+		if isIPv4(net.ParseIP(server)) == v4 {
+			servers = append(servers, server)
+		}
+	}
+	if len(servers) > 0 {
+		fmt.Fprintf(&b, "dns=%s;\n", strings.Join(servers, ";"))
+	}
+	index := 1
+	for _, route := range routes {
+		if isIPv4(net.ParseIP(route.NextHopAddress)) != v4 {
+			continue
+		}
+		fmt.Fprintf(&b, "route%d=%s,%s", index, route.Destination, route.NextHopAddress)
+		if route.Metric != nil {
+			fmt.Fprintf(&b, ",%d", *route.Metric)
+		}
+		b.WriteString("\n")
+		index++
+	}
+	return b.String()
+}
+
+func nmConnection(intf *nmstateInterface, master string, config *nmstateConfig) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[connection]\nid=%s\ntype=%s\ninterface-name=%s\nautoconnect=%t\n",
+		intf.Name, intf.Type, intf.Name, intf.State != "down")
+	if master != "" {
+		fmt.Fprintf(&b, "master=%s\nslave-type=bond\n", master)
+	}
+	if intf.Type != "vlan" && (intf.MacAddress != "" || intf.MTU > 0) {
+		b.WriteString("\n[ethernet]\n")
+		if intf.MacAddress != "" {
+			// The MAC address of a bond is assigned rather than matched
+			key := "mac-address"
+			if intf.Type == "bond" {
+				key = "cloned-mac-address"
+			}
+			fmt.Fprintf(&b, "%s=%s\n", key, strings.ToUpper(intf.MacAddress))
+		}
+		if intf.MTU > 0 {
+			fmt.Fprintf(&b, "mtu=%d\n", intf.MTU)
+		}
+	}
+	switch intf.Type {
+	case "bond":
+		fmt.Fprintf(&b, "\n[bond]\nmode=%s\n", intf.LinkAggregation.Mode)
+		keys := make([]string, 0, len(intf.LinkAggregation.Options))
+		for key := range intf.LinkAggregation.Options {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(&b, "%s=%s\n", key, intf.LinkAggregation.Options[key])
+		}
+	case "vlan":
+		fmt.Fprintf(&b, "\n[vlan]\nparent=%s\nid=%d\n", intf.Vlan.BaseIface, *intf.Vlan.ID)
+		if intf.MTU > 0 {
+			fmt.Fprintf(&b, "mtu=%d\n", intf.MTU)
+		}
+	}
+	if master != "" {
+		return b.String()
+	}
+	var routes []*nmstateRoute
+	for _, route := range config.Routes.Config {
+		if route.NextHopInterface == intf.Name {
+			routes = append(routes, route)
+		}
+	}
+	b.WriteString(ipSection(intf.IPv4, true, config.DNSResolver.Config.Server, routes))
+	b.WriteString(ipSection(intf.IPv6, false, config.DNSResolver.Config.Server, routes))
+	return b.String()
+}
+
+func hostConnections(config *nmstateConfig) map[string]string {
+	masters := make(map[string]string)
+	for _, intf := range config.Interfaces {
+		for _, port := range intf.ports() {
+			masters[port] = intf.Name
+		}
+	}
+	connections := make(map[string]string)
+	for _, intf := range config.Interfaces {
+		connections[intf.Name] = nmConnection(intf, masters[intf.Name], config)
+	}
+	// Bond ports that are not defined explicitly only need to be enslaved
+	for port, master := range masters {
+		if _, ok := connections[port]; !ok {
+			connections[port] = nmConnection(&nmstateInterface{Name: port, Type: "ethernet"}, master, config)
+		}
+	}
+	return connections
+}
+
+// GenerateStaticNetworkFiles renders the configuration of the hosts into NetworkManager connection profiles,
+// a directory per host, together with the script that applies the profiles of the current host on boot
+func GenerateStaticNetworkFiles(configs []*models.HostStaticNetworkConfig) ([]StaticNetworkFile, error) {
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	files := []StaticNetworkFile{{Path: StaticNetworkScriptPath, Contents: staticNetworkScript, Mode: 0755}}
+	for _, config := range configs {
+		mac, err := normalizeMac(swag.StringValue(config.MacAddress))
+		if err != nil {
+			return nil, err
+		}
+		parsed, err := parseNetworkYaml(swag.StringValue(config.NetworkYaml))
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid static network configuration of host with MAC address %s", mac)
+		}
+		dir := path.Join(staticNetworkConfigDir, strings.ReplaceAll(mac, ":", "-"))
+		files = append(files, StaticNetworkFile{Path: path.Join(dir, "mac"), Contents: mac + "\n", Mode: 0644})
+		connections := hostConnections(parsed)
+		names := make([]string, 0, len(connections))
+		for name := range connections {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			files = append(files, StaticNetworkFile{
+				Path:     path.Join(dir, name+".nmconnection"),
+				Contents: connections[name],
+				Mode:     0600,
+			})
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,134 @@
+package network
+
+import (
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const bondVlanYaml = `
+interfaces:
+  - name: eth0
+    type: ethernet
+    state: up
+    mac-address: 52:54:00:aa:bb:01
+  - name: bond0
+    type: bond
+    state: up
+    mtu: 9000
+    link-aggregation:
+      mode: active-backup
+      options:
+        miimon: 100
+      port:
+        - eth0
+        - eth1
+  - name: bond0.100
+    type: vlan
+    state: up
+    vlan:
+      base-iface: bond0
+      id: 100
+    ipv4:
+      enabled: true
+      address:
+        - ip: 192.168.100.10
+          prefix-length: 24
+dns-resolver:
+  config:
+    server:
+      - 192.168.100.1
+routes:
+  config:
+    - destination: 0.0.0.0/0
+      next-hop-address: 192.168.100.254
+      next-hop-interface: bond0.100
+`
+
+var _ = Describe("static network config", func() {
+	createConfig := func(mac, networkYaml string) *models.HostStaticNetworkConfig {
+		return &models.HostStaticNetworkConfig{MacAddress: swag.String(mac), NetworkYaml: swag.String(networkYaml)}
+	}
+
+	It("valid configuration", func() {
+		Expect(ValidateStaticNetworkConfig([]*models.HostStaticNetworkConfig{
+			createConfig("52:54:00:aa:bb:01", bondVlanYaml),
+		})).ShouldNot(HaveOccurred())
+	})
+
+	tests := []struct {
+		name        string
+		mac         string
+		networkYaml string
+	}{
+		{name: "invalid mac", mac: "52:54:00:aa:bb", networkYaml: bondVlanYaml},
+		{name: "invalid yaml", mac: "52:54:00:aa:bb:01", networkYaml: "interfaces: ["},
+		{name: "unknown field", mac: "52:54:00:aa:bb:01", networkYaml: "interfaces:\n  - name: eth0\n    type: ethernet\n    speed: 10"},
+		{name: "no interfaces", mac: "52:54:00:aa:bb:01", networkYaml: "routes: {}"},
+		{name: "unsupported type", mac: "52:54:00:aa:bb:01", networkYaml: "interfaces:\n  - name: br0\n    type: linux-bridge"},
+		{name: "static ip without addresses", mac: "52:54:00:aa:bb:01",
+			networkYaml: "interfaces:\n  - name: eth0\n    type: ethernet\n    ipv4:\n      enabled: true"},
+		{name: "invalid prefix length", mac: "52:54:00:aa:bb:01",
+			networkYaml: "interfaces:\n  - name: eth0\n    type: ethernet\n    ipv4:\n      enabled: true\n      address:\n        - ip: 10.0.0.1\n          prefix-length: 33"},
+		{name: "ipv6 address in ipv4 section", mac: "52:54:00:aa:bb:01",
+			networkYaml: "interfaces:\n  - name: eth0\n    type: ethernet\n    ipv4:\n      enabled: true\n      address:\n        - ip: fe80::1\n          prefix-length: 64"},
+		{name: "vlan without id", mac: "52:54:00:aa:bb:01",
+			networkYaml: "interfaces:\n  - name: eth0.5\n    type: vlan\n    vlan:\n      base-iface: eth0"},
+		{name: "bond without ports", mac: "52:54:00:aa:bb:01",
+			networkYaml: "interfaces:\n  - name: bond0\n    type: bond\n    link-aggregation:\n      mode: 802.3ad"},
+		{name: "route through unknown interface", mac: "52:54:00:aa:bb:01",
+			networkYaml: "interfaces:\n  - name: eth0\n    type: ethernet\nroutes:\n  config:\n    - destination: 0.0.0.0/0\n      next-hop-address: 10.0.0.1\n      next-hop-interface: eth1"},
+		{name: "invalid dns server", mac: "52:54:00:aa:bb:01",
+			networkYaml: "interfaces:\n  - name: eth0\n    type: ethernet\ndns-resolver:\n  config:\n    server:\n      - dns.example.com"},
+	}
+
+	for i := range tests {
+		t := tests[i]
+		It(t.name, func() {
+			Expect(ValidateStaticNetworkConfig([]*models.HostStaticNetworkConfig{createConfig(t.mac, t.networkYaml)})).
+				Should(HaveOccurred())
+		})
+	}
+
+	It("duplicate mac", func() {
+		Expect(ValidateStaticNetworkConfig([]*models.HostStaticNetworkConfig{
+			createConfig("52:54:00:aa:bb:01", bondVlanYaml),
+			createConfig("52:54:00:AA:BB:01", bondVlanYaml),
+		})).Should(HaveOccurred())
+	})
+
+	It("generate files", func() {
+		files, err := GenerateStaticNetworkFiles([]*models.HostStaticNetworkConfig{
+			createConfig("52:54:00:AA:BB:01", bondVlanYaml),
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(files).To(HaveLen(6))
+		Expect(files[0].Path).To(Equal(StaticNetworkScriptPath))
+		Expect(files[0].Mode).To(Equal(0755))
+
+		contents := make(map[string]string)
+		for _, f := range files[1:] {
+			contents[f.Path] = f.Contents
+		}
+		dir := "/etc/assisted/network/52-54-00-aa-bb-01/"
+		Expect(contents[dir+"mac"]).To(Equal("52:54:00:aa:bb:01\n"))
+		Expect(contents[dir+"eth0.nmconnection"]).To(Equal("[connection]\nid=eth0\ntype=ethernet\ninterface-name=eth0\n" +
+			"autoconnect=true\nmaster=bond0\nslave-type=bond\n\n[ethernet]\nmac-address=52:54:00:AA:BB:01\n"))
+		Expect(contents[dir+"eth1.nmconnection"]).To(Equal("[connection]\nid=eth1\ntype=ethernet\ninterface-name=eth1\n" +
+			"autoconnect=true\nmaster=bond0\nslave-type=bond\n"))
+		Expect(contents[dir+"bond0.nmconnection"]).To(Equal("[connection]\nid=bond0\ntype=bond\ninterface-name=bond0\n" +
+			"autoconnect=true\n\n[ethernet]\nmtu=9000\n\n[bond]\nmode=active-backup\nmiimon=100\n\n" +
+			"[ipv4]\nmethod=disabled\n\n[ipv6]\nmethod=ignore\n"))
+		Expect(contents[dir+"bond0.100.nmconnection"]).To(Equal("[connection]\nid=bond0.100\ntype=vlan\n" +
+			"interface-name=bond0.100\nautoconnect=true\n\n[vlan]\nparent=bond0\nid=100\n\n" +
+			"[ipv4]\nmethod=manual\naddress1=192.168.100.10/24\ndns=192.168.100.1;\nroute1=0.0.0.0/0,192.168.100.254\n\n" +
+			"[ipv6]\nmethod=ignore\n"))
+	})
+
+	It("no configuration", func() {
+		files, err := GenerateStaticNetworkFiles(nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(files).To(BeEmpty())
+	})
+})
@@ -0,0 +1,183 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/sirupsen/logrus"
+)
+
+// IPRange is an inclusive range of addresses of the same IP family
+type IPRange struct {
+	Start net.IP
+	End   net.IP
+}
+
+func (r IPRange) Contains(ip net.IP) bool {
+	ip = ip.To16()
+	return ip != nil && bytes.Compare(ip, r.Start) >= 0 && bytes.Compare(ip, r.End) <= 0
+}
+
+func (r IPRange) String() string {
+	if r.Start.Equal(r.End) {
+		return r.Start.String()
+	}
+	return fmt.Sprintf("%s-%s", r.Start, r.End)
+}
+
+func networkRange(ipnet *net.IPNet) IPRange {
+	end := make(net.IP, len(ipnet.IP))
+	for i := range ipnet.IP {
+		end[i] = ipnet.IP[i] | ^ipnet.Mask[i]
+	}
+	return IPRange{Start: ipnet.IP.To16(), End: end.To16()}
+}
+
+func parseIPRange(str string) (IPRange, error) {
+	if strings.Contains(str, "/") {
+		_, ipnet, err := net.ParseCIDR(str)
+		if err != nil {
+			return IPRange{}, fmt.Errorf("Invalid CIDR %s", str)
+		}
+		return networkRange(ipnet), nil
+	}
+	first, last := str, str
+	if i := strings.Index(str, "-"); i >= 0 {
+		first, last = strings.TrimSpace(str[:i]), strings.TrimSpace(str[i+1:])
+	}
+	start, end := net.ParseIP(first), net.ParseIP(last)
+	if start == nil || end == nil {
+		return IPRange{}, fmt.Errorf("Invalid address range %s", str)
+	}
+	if (start.To4() == nil) != (end.To4() == nil) || bytes.Compare(start.To16(), end.To16()) > 0 {
+		return IPRange{}, fmt.Errorf("Invalid address range %s, the last address must follow the first one", str)
+	}
+	return IPRange{Start: start.To16(), End: end.To16()}, nil
+}
+
+// ParseIPRanges parses a comma-separated list of addresses, address ranges (first-last) and CIDRs
+func ParseIPRanges(str string) ([]IPRange, error) {
+	ret := make([]IPRange, 0)
+	for _, entry := range strings.Split(str, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		r, err := parseIPRange(entry)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, r)
+	}
+	return ret, nil
+}
+
+func inRanges(ip net.IP, ranges []IPRange) bool {
+	for _, r := range ranges {
+		if r.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAllocatable returns whether a free address of the machine network can be allocated as a VIP
+func isAllocatable(ipStr string, ipnet *net.IPNet, exclusions []IPRange, reserved []string) bool {
+	ip := net.ParseIP(ipStr)
+	if ip == nil || !ipnet.Contains(ip) || ip.Equal(ipnet.IP) || inRanges(ip, exclusions) {
+		return false
+	}
+	// The broadcast address of an IPv4 network
+	if ip.To4() != nil && ip.Equal(networkRange(ipnet).End) {
+		return false
+	}
+	for _, r := range reserved {
+		if ip.Equal(net.ParseIP(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+ * Allocate the API and Ingress VIPs out of the addresses of the machine network that all the hosts found free.  The
+ * addresses of the exclusion ranges and the reserved ones, which are the VIPs of other clusters, are skipped.  The
+ * lowest addresses are allocated so that the allocation is stable as long as they remain free.
+ */
+func AllocateVips(hosts []*models.Host, machineNetworkCidr string, exclusionRanges string, reserved []string,
+	log logrus.FieldLogger) (string, string, error) {
+	_, ipnet, err := net.ParseCIDR(machineNetworkCidr)
+	if err != nil {
+		return "", "", fmt.Errorf("Could not parse machine network CIDR %s", machineNetworkCidr)
+	}
+	exclusions, err := ParseIPRanges(exclusionRanges)
+	if err != nil {
+		return "", "", err
+	}
+	candidates := make([]string, 0)
+	for a := range MakeFreeAddressesSet(hosts, ipnet.String(), nil, log) {
+		if isAllocatable(a, ipnet, exclusions, reserved) {
+			candidates = append(candidates, a)
+		}
+	}
+	if len(candidates) < 2 {
+		return "", "", fmt.Errorf("Found %d free addresses out of the 2 VIPs in machine network %s", len(candidates),
+			machineNetworkCidr)
+	}
+	SortIPs(candidates)
+	return candidates[0], candidates[1], nil
+}
+
+// VerifyAllocatedVips verifies that the VIPs that were allocated are still free, unlike the VIPs of the user that
+// are verified only when the hosts found free addresses
+func VerifyAllocatedVips(hosts []*models.Host, machineNetworkCidr string, exclusionRanges string, reserved []string,
+	apiVip string, ingressVip string, log logrus.FieldLogger) error {
+	if err := VerifyVips(hosts, machineNetworkCidr, apiVip, ingressVip, true, log); err != nil {
+		return err
+	}
+	_, ipnet, err := net.ParseCIDR(machineNetworkCidr)
+	if err != nil {
+		return fmt.Errorf("Could not parse machine network CIDR %s", machineNetworkCidr)
+	}
+	exclusions, err := ParseIPRanges(exclusionRanges)
+	if err != nil {
+		return err
+	}
+	freeSet := MakeFreeAddressesSet(hosts, ipnet.String(), nil, log)
+	for _, vip := range []string{apiVip, ingressVip} {
+		if !freeSet.Contains(vip) {
+			return fmt.Errorf("Allocated VIP <%s> is no longer free in machine network %s", vip, machineNetworkCidr)
+		}
+		if !isAllocatable(vip, ipnet, exclusions, reserved) {
+			return fmt.Errorf("Allocated VIP <%s> is excluded from the allocation or used by another cluster", vip)
+		}
+	}
+	return nil
+}
+
+// IsHostNetwork returns whether one of the hosts has an interface in exactly the given network
+func IsHostNetwork(hosts []*models.Host, cidr string, log logrus.FieldLogger) bool {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+	for _, h := range hosts {
+		var inventory models.Inventory
+		if err = json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+			log.WithError(err).Warnf("Error unmarshalling host %s inventory %s", h.ID, h.Inventory)
+			continue
+		}
+		for _, intf := range inventory.Interfaces {
+			for _, addr := range interfaceAddresses(intf) {
+				if _, n, err := net.ParseCIDR(addr); err == nil && n.String() == ipnet.String() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
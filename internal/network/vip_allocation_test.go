@@ -0,0 +1,114 @@
+package network
+
+import (
+	"encoding/json"
+	"net"
+
+	"github.com/filanov/bm-inventory/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("VIP allocation", func() {
+	var log logrus.FieldLogger
+
+	BeforeEach(func() {
+		log = logrus.New()
+	})
+
+	createHost := func(network string, freeAddresses ...string) *models.Host {
+		b, err := json.Marshal(models.FreeNetworksAddresses{{Network: network, FreeAddresses: freeAddresses}})
+		Expect(err).ShouldNot(HaveOccurred())
+		return &models.Host{FreeAddresses: string(b)}
+	}
+
+	Context("ParseIPRanges", func() {
+		It("addresses, ranges and CIDRs", func() {
+			ranges, err := ParseIPRanges("1.2.4.10, 1.2.4.20-1.2.4.30,1.2.5.0/28,fd00::1-fd00::ff")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ranges).To(HaveLen(4))
+			Expect(ranges[0].String()).To(Equal("1.2.4.10"))
+			Expect(ranges[2].String()).To(Equal("1.2.5.0-1.2.5.15"))
+			Expect(inRanges(net.ParseIP("1.2.4.25"), ranges)).To(BeTrue())
+			Expect(inRanges(net.ParseIP("1.2.5.15"), ranges)).To(BeTrue())
+			Expect(inRanges(net.ParseIP("1.2.5.16"), ranges)).To(BeFalse())
+			Expect(inRanges(net.ParseIP("fd00::80"), ranges)).To(BeTrue())
+		})
+		It("empty", func() {
+			ranges, err := ParseIPRanges("")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ranges).To(BeEmpty())
+		})
+		It("invalid", func() {
+			for _, str := range []string{"1.2.4", "1.2.4.30-1.2.4.20", "1.2.4.1-fd00::1", "1.2.4.0/33"} {
+				_, err := ParseIPRanges(str)
+				Expect(err).To(HaveOccurred(), str)
+			}
+		})
+	})
+
+	Context("AllocateVips", func() {
+		It("lowest free addresses", func() {
+			hosts := []*models.Host{
+				createHost("1.2.4.0/24", "1.2.4.0", "1.2.4.12", "1.2.4.3", "1.2.4.5", "1.2.4.255"),
+				createHost("1.2.4.0/24", "1.2.4.0", "1.2.4.12", "1.2.4.5", "1.2.4.255"),
+			}
+			apiVip, ingressVip, err := AllocateVips(hosts, "1.2.4.0/24", "", nil, log)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiVip).To(Equal("1.2.4.5"))
+			Expect(ingressVip).To(Equal("1.2.4.12"))
+		})
+		It("exclusion ranges and reserved", func() {
+			hosts := []*models.Host{createHost("1.2.4.0/24", "1.2.4.5", "1.2.4.12", "1.2.4.20", "1.2.4.40", "1.2.4.50")}
+			apiVip, ingressVip, err := AllocateVips(hosts, "1.2.4.0/24", "1.2.4.1-1.2.4.15", []string{"1.2.4.20"}, log)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiVip).To(Equal("1.2.4.40"))
+			Expect(ingressVip).To(Equal("1.2.4.50"))
+		})
+		It("IPv6", func() {
+			hosts := []*models.Host{createHost("fd00:1::/120", "fd00:1::10", "fd00:1::9")}
+			apiVip, ingressVip, err := AllocateVips(hosts, "fd00:1::/120", "", nil, log)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiVip).To(Equal("fd00:1::9"))
+			Expect(ingressVip).To(Equal("fd00:1::10"))
+		})
+		It("not enough free addresses", func() {
+			hosts := []*models.Host{createHost("1.2.4.0/24", "1.2.4.5", "1.2.4.12")}
+			_, _, err := AllocateVips(hosts, "1.2.4.0/24", "1.2.4.12", nil, log)
+			Expect(err).To(HaveOccurred())
+			_, _, err = AllocateVips(hosts, "1.2.5.0/24", "", nil, log)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("VerifyAllocatedVips", func() {
+		var hosts []*models.Host
+
+		BeforeEach(func() {
+			hosts = []*models.Host{createHost("1.2.4.0/24", "1.2.4.5", "1.2.4.12", "1.2.4.20")}
+		})
+		It("free", func() {
+			Expect(VerifyAllocatedVips(hosts, "1.2.4.0/24", "", nil, "1.2.4.5", "1.2.4.12", log)).To(Succeed())
+		})
+		It("no longer free", func() {
+			Expect(VerifyAllocatedVips(hosts, "1.2.4.0/24", "", nil, "1.2.4.5", "1.2.4.13", log)).ToNot(Succeed())
+			Expect(VerifyAllocatedVips([]*models.Host{{}}, "1.2.4.0/24", "", nil, "1.2.4.5", "1.2.4.12", log)).ToNot(Succeed())
+		})
+		It("excluded or reserved", func() {
+			Expect(VerifyAllocatedVips(hosts, "1.2.4.0/24", "1.2.4.12", nil, "1.2.4.5", "1.2.4.12", log)).ToNot(Succeed())
+			Expect(VerifyAllocatedVips(hosts, "1.2.4.0/24", "", []string{"1.2.4.5"}, "1.2.4.5", "1.2.4.12", log)).ToNot(Succeed())
+		})
+	})
+
+	It("IsHostNetwork", func() {
+		inventory, err := json.Marshal(&models.Inventory{Interfaces: []*models.Interface{
+			{IPV4Addresses: []string{"1.2.4.7/24"}, IPV6Addresses: []string{"fd00:1::7/64"}},
+		}})
+		Expect(err).ShouldNot(HaveOccurred())
+		hosts := []*models.Host{{Inventory: string(inventory)}}
+		Expect(IsHostNetwork(hosts, "1.2.4.0/24", log)).To(BeTrue())
+		Expect(IsHostNetwork(hosts, "fd00:1::/64", log)).To(BeTrue())
+		Expect(IsHostNetwork(hosts, "1.2.0.0/16", log)).To(BeFalse())
+	})
+})
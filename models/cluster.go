@@ -20,19 +20,25 @@ import (
 // swagger:model cluster
 type Cluster struct {
 
+	// Comma-separated list of the machine network CIDRs of the hosts that are not in machine_network_cidr, such as the subnets of the other racks of a routed network. The VIPs are in machine_network_cidr, so the masters must be in it.
+	AdditionalMachineNetworkCidrs string `json:"additional_machine_network_cidrs,omitempty"`
+
+	// agent version drift
+	AgentVersionDrift *AgentVersionDrift `json:"agent_version_drift,omitempty" gorm:"-"`
+
 	// Virtual IP used to reach the OpenShift cluster API.
-	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3})?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$
 	APIVip string `json:"api_vip,omitempty"`
 
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
 	// IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
 	ClusterNetworkCidr string `json:"cluster_network_cidr,omitempty"`
 
 	// The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.
-	// Maximum: 32
+	// Maximum: 128
 	// Minimum: 1
 	ClusterNetworkHostPrefix int64 `json:"cluster_network_host_prefix,omitempty"`
 
@@ -50,6 +56,12 @@ type Cluster struct {
 	// Required: true
 	Href *string `json:"href"`
 
+	// The URL of the proxy for the HTTP requests of the hosts and of the installed cluster, http://\<user\>:\<password\>@\<server\>:\<port\>/
+	HTTPProxy string `json:"http_proxy,omitempty"`
+
+	// The URL of the proxy for the HTTPS requests of the hosts and of the installed cluster, http://\<user\>:\<password\>@\<server\>:\<port\>/
+	HTTPSProxy string `json:"https_proxy,omitempty"`
+
 	// Unique identifier of the object.
 	// Required: true
 	// Format: uuid
@@ -63,13 +75,16 @@ type Cluster struct {
 	ImageInfo *ImageInfo `json:"image_info" gorm:"embedded;embedded_prefix:image_"`
 
 	// Virtual IP used for cluster ingress traffic.
-	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3})?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$
 	IngressVip string `json:"ingress_vip,omitempty"`
 
 	// The time that this cluster completed installation.
 	// Format: date-time
 	InstallCompletedAt strfmt.DateTime `json:"install_completed_at,omitempty" gorm:"type:timestamp with time zone;default:'2000-01-01 00:00:00z'"`
 
+	// JSON merge patch that is applied on top of the generated install config of the cluster.
+	InstallConfigOverrides string `json:"install_config_overrides,omitempty" gorm:"type:text"`
+
 	// The time that this cluster began installation.
 	// Format: date-time
 	InstallStartedAt strfmt.DateTime `json:"install_started_at,omitempty" gorm:"type:timestamp with time zone;default:'2000-01-01 00:00:00z'"`
@@ -80,12 +95,22 @@ type Cluster struct {
 	Kind *string `json:"kind"`
 
 	// A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
 	MachineNetworkCidr string `json:"machine_network_cidr,omitempty"`
 
 	// Name of the OpenShift cluster.
 	Name string `json:"name,omitempty"`
 
+	// The cluster network provider of the OpenShift cluster, among the ones supported by its version. IPv6 and dual-stack networks require OVNKubernetes. Empty when it is not selected, the cluster is then installed with OVNKubernetes for IPv6 and dual-stack networks, otherwise with OpenShiftSDN.
+	// Enum: [OpenShiftSDN OVNKubernetes]
+	NetworkType string `json:"network_type,omitempty"`
+
+	// Comma-separated list of the domains, IP addresses and CIDRs that are not accessed through the proxy. When a proxy is set, it must contain the machine, cluster and service networks and the API domains of the cluster, or '*'.
+	NoProxy string `json:"no_proxy,omitempty"`
+
+	// Comma-separated list of the NTP servers, hostnames or IP addresses, that the hosts synchronize their clocks with. When empty, the default servers of the operating system are used.
+	NtpServers string `json:"ntp_servers,omitempty"`
+
 	// Version of the OpenShift cluster.
 	// Enum: [4.5]
 	OpenshiftVersion string `json:"openshift_version,omitempty"`
@@ -96,8 +121,25 @@ type Cluster struct {
 	// True if the pull-secret has been added to the cluster
 	PullSecretSet bool `json:"pull_secret_set,omitempty"`
 
+	// IP address block, of the other IP family than cluster_network_cidr, from which the Pod IPs of a dual-stack cluster are allocated. The cluster is dual-stack when it is set together with secondary_service_network_cidr.
+	// Pattern: ^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
+	SecondaryClusterNetworkCidr string `json:"secondary_cluster_network_cidr,omitempty"`
+
+	// The subnet prefix length to assign to each individual node out of secondary_cluster_network_cidr. Defaults to 23 for IPv4 and 64 for IPv6.
+	// Maximum: 128
+	// Minimum: 0
+	SecondaryClusterNetworkHostPrefix int64 `json:"secondary_cluster_network_host_prefix,omitempty"`
+
+	// The CIDR, of the other IP family than machine_network_cidr, of the network that the hosts of a dual-stack cluster have on the interface of the machine network.
+	// Pattern: ^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
+	SecondaryMachineNetworkCidr string `json:"secondary_machine_network_cidr,omitempty"`
+
+	// The IP address pool, of the other IP family than service_network_cidr, to use for the service IP addresses of a dual-stack cluster.
+	// Pattern: ^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
+	SecondaryServiceNetworkCidr string `json:"secondary_service_network_cidr,omitempty"`
+
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
 	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
 
 	// SSH public key for debugging OpenShift nodes.
@@ -122,12 +164,25 @@ type Cluster struct {
 
 	// user id
 	UserID string `json:"user_id,omitempty"`
+
+	// Json formatted string containing the cluster validations results for each validation id grouped by category (hosts-data, etc.)
+	ValidationsInfo string `json:"validations_info,omitempty" gorm:"type:text"`
+
+	// Whether the service allocates the API and Ingress VIPs out of the free addresses that the hosts discovered in the machine network, instead of the user.
+	VipAllocation bool `json:"vip_allocation,omitempty"`
+
+	// Comma-separated list of addresses, address ranges (first-last) or CIDRs of the machine network that the service does not allocate as VIPs, such as the pool of a DHCP server.
+	VipExclusionRanges string `json:"vip_exclusion_ranges,omitempty"`
 }
 
 // Validate validates this cluster
 func (m *Cluster) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAgentVersionDrift(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateAPIVip(formats); err != nil {
 		res = append(res, err)
 	}
@@ -184,10 +239,30 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateNetworkType(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOpenshiftVersion(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateSecondaryClusterNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryClusterNetworkHostPrefix(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryMachineNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryServiceNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -214,13 +289,31 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateAgentVersionDrift(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AgentVersionDrift) { // not required
+		return nil
+	}
+
+	if m.AgentVersionDrift != nil {
+		if err := m.AgentVersionDrift.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("agent_version_drift")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateAPIVip(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.APIVip) { // not required
 		return nil
 	}
 
-	if err := validate.Pattern("api_vip", "body", string(m.APIVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3})?$`); err != nil {
+	if err := validate.Pattern("api_vip", "body", string(m.APIVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$`); err != nil {
 		return err
 	}
 
@@ -233,7 +326,7 @@ func (m *Cluster) validateClusterNetworkCidr(formats strfmt.Registry) error {
 		return nil
 	}
 
-	if err := validate.Pattern("cluster_network_cidr", "body", string(m.ClusterNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("cluster_network_cidr", "body", string(m.ClusterNetworkCidr), `^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
 		return err
 	}
 
@@ -250,7 +343,7 @@ func (m *Cluster) validateClusterNetworkHostPrefix(formats strfmt.Registry) erro
 		return err
 	}
 
-	if err := validate.MaximumInt("cluster_network_host_prefix", "body", int64(m.ClusterNetworkHostPrefix), 32, false); err != nil {
+	if err := validate.MaximumInt("cluster_network_host_prefix", "body", int64(m.ClusterNetworkHostPrefix), 128, false); err != nil {
 		return err
 	}
 
@@ -366,7 +459,7 @@ func (m *Cluster) validateIngressVip(formats strfmt.Registry) error {
 		return nil
 	}
 
-	if err := validate.Pattern("ingress_vip", "body", string(m.IngressVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3})?$`); err != nil {
+	if err := validate.Pattern("ingress_vip", "body", string(m.IngressVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$`); err != nil {
 		return err
 	}
 
@@ -445,7 +538,50 @@ func (m *Cluster) validateMachineNetworkCidr(formats strfmt.Registry) error {
 		return nil
 	}
 
-	if err := validate.Pattern("machine_network_cidr", "body", string(m.MachineNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("machine_network_cidr", "body", string(m.MachineNetworkCidr), `^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var clusterTypeNetworkTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["OpenShiftSDN","OVNKubernetes"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterTypeNetworkTypePropEnum = append(clusterTypeNetworkTypePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterNetworkTypeOpenShiftSDN captures enum value "OpenShiftSDN"
+	ClusterNetworkTypeOpenShiftSDN string = "OpenShiftSDN"
+
+	// ClusterNetworkTypeOVNKubernetes captures enum value "OVNKubernetes"
+	ClusterNetworkTypeOVNKubernetes string = "OVNKubernetes"
+)
+
+// prop value enum
+func (m *Cluster) validateNetworkTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterTypeNetworkTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Cluster) validateNetworkType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NetworkType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateNetworkTypeEnum("network_type", "body", m.NetworkType); err != nil {
 		return err
 	}
 
@@ -492,13 +628,69 @@ func (m *Cluster) validateOpenshiftVersion(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateSecondaryClusterNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryClusterNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("secondary_cluster_network_cidr", "body", string(m.SecondaryClusterNetworkCidr), `^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cluster) validateSecondaryClusterNetworkHostPrefix(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryClusterNetworkHostPrefix) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("secondary_cluster_network_host_prefix", "body", int64(m.SecondaryClusterNetworkHostPrefix), 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("secondary_cluster_network_host_prefix", "body", int64(m.SecondaryClusterNetworkHostPrefix), 128, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cluster) validateSecondaryMachineNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryMachineNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("secondary_machine_network_cidr", "body", string(m.SecondaryMachineNetworkCidr), `^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cluster) validateSecondaryServiceNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryServiceNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("secondary_service_network_cidr", "body", string(m.SecondaryServiceNetworkCidr), `^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateServiceNetworkCidr(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
 	}
 
-	if err := validate.Pattern("service_network_cidr", "body", string(m.ServiceNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("service_network_cidr", "body", string(m.ServiceNetworkCidr), `^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
 		return err
 	}
 
@@ -19,26 +19,45 @@ import (
 // swagger:model cluster-create-params
 type ClusterCreateParams struct {
 
+	// Comma-separated list of the machine network CIDRs of the hosts that are not in machine_network_cidr, such as the subnets of the other racks of a routed network. The VIPs are in machine_network_cidr, so the masters must be in it.
+	AdditionalMachineNetworkCidrs string `json:"additional_machine_network_cidrs,omitempty"`
+
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
 	// IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
 	ClusterNetworkCidr *string `json:"cluster_network_cidr,omitempty"`
 
 	// The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.
-	// Maximum: 32
+	// Maximum: 128
 	// Minimum: 1
 	ClusterNetworkHostPrefix int64 `json:"cluster_network_host_prefix,omitempty"`
 
+	// The URL of the proxy for the HTTP requests of the hosts and of the installed cluster, http://\<user\>:\<password\>@\<server\>:\<port\>/
+	HTTPProxy string `json:"http_proxy,omitempty"`
+
+	// The URL of the proxy for the HTTPS requests of the hosts and of the installed cluster, http://\<user\>:\<password\>@\<server\>:\<port\>/
+	HTTPSProxy string `json:"https_proxy,omitempty"`
+
 	// Virtual IP used for cluster ingress traffic.
-	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3})?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$
 	IngressVip string `json:"ingress_vip,omitempty"`
 
 	// Name of the OpenShift cluster.
 	// Required: true
 	Name *string `json:"name"`
 
+	// The cluster network provider of the OpenShift cluster, among the ones supported by its version. IPv6 and dual-stack networks require OVNKubernetes. Defaults to OVNKubernetes for IPv6 and dual-stack networks, otherwise to OpenShiftSDN.
+	// Enum: [OpenShiftSDN OVNKubernetes]
+	NetworkType string `json:"network_type,omitempty"`
+
+	// Comma-separated list of the domains, IP addresses and CIDRs that are not accessed through the proxy. When a proxy is set, it must contain the machine, cluster and service networks and the API domains of the cluster, or '*'.
+	NoProxy string `json:"no_proxy,omitempty"`
+
+	// Comma-separated list of the NTP servers, hostnames or IP addresses, that the hosts synchronize their clocks with. When empty, the default servers of the operating system are used.
+	NtpServers string `json:"ntp_servers,omitempty"`
+
 	// Version of the OpenShift cluster.
 	// Required: true
 	// Enum: [4.5]
@@ -47,12 +66,31 @@ type ClusterCreateParams struct {
 	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
 	PullSecret string `json:"pull_secret,omitempty"`
 
+	// IP address block, of the other IP family than cluster_network_cidr, from which the Pod IPs of a dual-stack cluster are allocated. The cluster is dual-stack when it is set together with secondary_service_network_cidr.
+	// Pattern: ^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
+	SecondaryClusterNetworkCidr string `json:"secondary_cluster_network_cidr,omitempty"`
+
+	// The subnet prefix length to assign to each individual node out of secondary_cluster_network_cidr. Defaults to 23 for IPv4 and 64 for IPv6.
+	// Maximum: 128
+	// Minimum: 0
+	SecondaryClusterNetworkHostPrefix int64 `json:"secondary_cluster_network_host_prefix,omitempty"`
+
+	// The IP address pool, of the other IP family than service_network_cidr, to use for the service IP addresses of a dual-stack cluster.
+	// Pattern: ^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
+	SecondaryServiceNetworkCidr string `json:"secondary_service_network_cidr,omitempty"`
+
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
 	ServiceNetworkCidr *string `json:"service_network_cidr,omitempty"`
 
 	// SSH public key for debugging OpenShift nodes.
 	SSHPublicKey string `json:"ssh_public_key,omitempty"`
+
+	// Whether the service allocates the API and Ingress VIPs out of the free addresses that the hosts discovered in the machine network, instead of the user.
+	VipAllocation bool `json:"vip_allocation,omitempty"`
+
+	// Comma-separated list of addresses, address ranges (first-last) or CIDRs of the machine network that the service does not allocate as VIPs, such as the pool of a DHCP server.
+	VipExclusionRanges string `json:"vip_exclusion_ranges,omitempty"`
 }
 
 // Validate validates this cluster create params
@@ -75,10 +113,26 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateNetworkType(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOpenshiftVersion(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateSecondaryClusterNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryClusterNetworkHostPrefix(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryServiceNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -95,7 +149,7 @@ func (m *ClusterCreateParams) validateClusterNetworkCidr(formats strfmt.Registry
 		return nil
 	}
 
-	if err := validate.Pattern("cluster_network_cidr", "body", string(*m.ClusterNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("cluster_network_cidr", "body", string(*m.ClusterNetworkCidr), `^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
 		return err
 	}
 
@@ -112,7 +166,7 @@ func (m *ClusterCreateParams) validateClusterNetworkHostPrefix(formats strfmt.Re
 		return err
 	}
 
-	if err := validate.MaximumInt("cluster_network_host_prefix", "body", int64(m.ClusterNetworkHostPrefix), 32, false); err != nil {
+	if err := validate.MaximumInt("cluster_network_host_prefix", "body", int64(m.ClusterNetworkHostPrefix), 128, false); err != nil {
 		return err
 	}
 
@@ -125,7 +179,7 @@ func (m *ClusterCreateParams) validateIngressVip(formats strfmt.Registry) error
 		return nil
 	}
 
-	if err := validate.Pattern("ingress_vip", "body", string(m.IngressVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3})?$`); err != nil {
+	if err := validate.Pattern("ingress_vip", "body", string(m.IngressVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$`); err != nil {
 		return err
 	}
 
@@ -141,6 +195,49 @@ func (m *ClusterCreateParams) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+var clusterCreateParamsTypeNetworkTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["OpenShiftSDN","OVNKubernetes"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterCreateParamsTypeNetworkTypePropEnum = append(clusterCreateParamsTypeNetworkTypePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterCreateParamsNetworkTypeOpenShiftSDN captures enum value "OpenShiftSDN"
+	ClusterCreateParamsNetworkTypeOpenShiftSDN string = "OpenShiftSDN"
+
+	// ClusterCreateParamsNetworkTypeOVNKubernetes captures enum value "OVNKubernetes"
+	ClusterCreateParamsNetworkTypeOVNKubernetes string = "OVNKubernetes"
+)
+
+// prop value enum
+func (m *ClusterCreateParams) validateNetworkTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterCreateParamsTypeNetworkTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterCreateParams) validateNetworkType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NetworkType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateNetworkTypeEnum("network_type", "body", m.NetworkType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var clusterCreateParamsTypeOpenshiftVersionPropEnum []interface{}
 
 func init() {
@@ -181,13 +278,56 @@ func (m *ClusterCreateParams) validateOpenshiftVersion(formats strfmt.Registry)
 	return nil
 }
 
+func (m *ClusterCreateParams) validateSecondaryClusterNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryClusterNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("secondary_cluster_network_cidr", "body", string(m.SecondaryClusterNetworkCidr), `^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterCreateParams) validateSecondaryClusterNetworkHostPrefix(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryClusterNetworkHostPrefix) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("secondary_cluster_network_host_prefix", "body", int64(m.SecondaryClusterNetworkHostPrefix), 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("secondary_cluster_network_host_prefix", "body", int64(m.SecondaryClusterNetworkHostPrefix), 128, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterCreateParams) validateSecondaryServiceNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryServiceNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("secondary_service_network_cidr", "body", string(m.SecondaryServiceNetworkCidr), `^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateServiceNetworkCidr(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
 	}
 
-	if err := validate.Pattern("service_network_cidr", "body", string(*m.ServiceNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("service_network_cidr", "body", string(*m.ServiceNetworkCidr), `^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
 		return err
 	}
 
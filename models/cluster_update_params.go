@@ -6,6 +6,7 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
 	"strconv"
 
 	"github.com/go-openapi/errors"
@@ -19,44 +20,95 @@ import (
 // swagger:model cluster-update-params
 type ClusterUpdateParams struct {
 
+	// Comma-separated list of the machine network CIDRs of the hosts that are not in machine_network_cidr, such as the subnets of the other racks of a routed network. The VIPs are in machine_network_cidr, so the masters must be in it.
+	AdditionalMachineNetworkCidrs *string `json:"additional_machine_network_cidrs,omitempty"`
+
 	// Virtual IP used to reach the OpenShift cluster API.
-	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3})?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$
 	APIVip *string `json:"api_vip,omitempty"`
 
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain *string `json:"base_dns_domain,omitempty"`
 
 	// IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
 	ClusterNetworkCidr *string `json:"cluster_network_cidr,omitempty"`
 
 	// The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.
-	// Maximum: 32
+	// Maximum: 128
 	// Minimum: 1
 	ClusterNetworkHostPrefix *int64 `json:"cluster_network_host_prefix,omitempty"`
 
+	// The desired installation disk for hosts associated with the cluster.
+	HostsInstallationDisks []*ClusterUpdateParamsHostsInstallationDisksItems0 `json:"hosts_installation_disks"`
+
+	// The user-defined labels of hosts associated with the cluster, replacing their current labels.
+	HostsLabels []*ClusterUpdateParamsHostsLabelsItems0 `json:"hosts_labels"`
+
 	// The desired hostname for hosts associated with the cluster.
 	HostsNames []*ClusterUpdateParamsHostsNamesItems0 `json:"hosts_names" gorm:"type:varchar(64)[]"`
 
+	// The user notes of hosts associated with the cluster.
+	HostsNotes []*ClusterUpdateParamsHostsNotesItems0 `json:"hosts_notes"`
+
 	// The desired role for hosts associated with the cluster.
 	HostsRoles []*ClusterUpdateParamsHostsRolesItems0 `json:"hosts_roles" gorm:"type:varchar(64)[]"`
 
+	// The URL of the proxy for the HTTP requests of the hosts and of the installed cluster, http://\<user\>:\<password\>@\<server\>:\<port\>/
+	HTTPProxy *string `json:"http_proxy,omitempty"`
+
+	// The URL of the proxy for the HTTPS requests of the hosts and of the installed cluster, http://\<user\>:\<password\>@\<server\>:\<port\>/
+	HTTPSProxy *string `json:"https_proxy,omitempty"`
+
 	// Virtual IP used for cluster ingress traffic.
-	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3})?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$
 	IngressVip *string `json:"ingress_vip,omitempty"`
 
+	// The machine network, one of the host networks, that the VIPs are allocated in. It can be set only when vip_allocation is set, otherwise it is calculated from the VIPs.
+	// Pattern: ^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
+	MachineNetworkCidr *string `json:"machine_network_cidr,omitempty"`
+
 	// OpenShift cluster name
 	Name *string `json:"name,omitempty"`
 
+	// The cluster network provider of the OpenShift cluster, among the ones supported by its version. IPv6 and dual-stack networks require OVNKubernetes.
+	// Enum: [OpenShiftSDN OVNKubernetes]
+	NetworkType *string `json:"network_type,omitempty"`
+
+	// Comma-separated list of the domains, IP addresses and CIDRs that are not accessed through the proxy. When a proxy is set, it must contain the machine, cluster and service networks and the API domains of the cluster, or '*'.
+	NoProxy *string `json:"no_proxy,omitempty"`
+
+	// Comma-separated list of the NTP servers, hostnames or IP addresses, that the hosts synchronize their clocks with. When empty, the default servers of the operating system are used.
+	NtpServers *string `json:"ntp_servers,omitempty"`
+
 	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
 	PullSecret *string `json:"pull_secret,omitempty"`
 
+	// IP address block, of the other IP family than cluster_network_cidr, from which the Pod IPs of a dual-stack cluster are allocated. The cluster is dual-stack when it is set together with secondary_service_network_cidr.
+	// Pattern: ^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
+	SecondaryClusterNetworkCidr *string `json:"secondary_cluster_network_cidr,omitempty"`
+
+	// The subnet prefix length to assign to each individual node out of secondary_cluster_network_cidr. Defaults to 23 for IPv4 and 64 for IPv6.
+	// Maximum: 128
+	// Minimum: 0
+	SecondaryClusterNetworkHostPrefix *int64 `json:"secondary_cluster_network_host_prefix,omitempty"`
+
+	// The IP address pool, of the other IP family than service_network_cidr, to use for the service IP addresses of a dual-stack cluster.
+	// Pattern: ^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
+	SecondaryServiceNetworkCidr *string `json:"secondary_service_network_cidr,omitempty"`
+
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
 	ServiceNetworkCidr *string `json:"service_network_cidr,omitempty"`
 
 	// SSH public key for debugging OpenShift nodes.
 	SSHPublicKey *string `json:"ssh_public_key,omitempty"`
+
+	// Whether the service allocates the API and Ingress VIPs out of the free addresses that the hosts discovered in the machine network, instead of the user.
+	VipAllocation *bool `json:"vip_allocation,omitempty"`
+
+	// Comma-separated list of addresses, address ranges (first-last) or CIDRs of the machine network that the service does not allocate as VIPs, such as the pool of a DHCP server.
+	VipExclusionRanges *string `json:"vip_exclusion_ranges,omitempty"`
 }
 
 // Validate validates this cluster update params
@@ -75,10 +127,22 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHostsInstallationDisks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHostsLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHostsNames(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateHostsNotes(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHostsRoles(formats); err != nil {
 		res = append(res, err)
 	}
@@ -87,6 +151,26 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMachineNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNetworkType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryClusterNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryClusterNetworkHostPrefix(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecondaryServiceNetworkCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -103,7 +187,7 @@ func (m *ClusterUpdateParams) validateAPIVip(formats strfmt.Registry) error {
 		return nil
 	}
 
-	if err := validate.Pattern("api_vip", "body", string(*m.APIVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3})?$`); err != nil {
+	if err := validate.Pattern("api_vip", "body", string(*m.APIVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$`); err != nil {
 		return err
 	}
 
@@ -116,7 +200,7 @@ func (m *ClusterUpdateParams) validateClusterNetworkCidr(formats strfmt.Registry
 		return nil
 	}
 
-	if err := validate.Pattern("cluster_network_cidr", "body", string(*m.ClusterNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("cluster_network_cidr", "body", string(*m.ClusterNetworkCidr), `^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
 		return err
 	}
 
@@ -133,13 +217,63 @@ func (m *ClusterUpdateParams) validateClusterNetworkHostPrefix(formats strfmt.Re
 		return err
 	}
 
-	if err := validate.MaximumInt("cluster_network_host_prefix", "body", int64(*m.ClusterNetworkHostPrefix), 32, false); err != nil {
+	if err := validate.MaximumInt("cluster_network_host_prefix", "body", int64(*m.ClusterNetworkHostPrefix), 128, false); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateHostsInstallationDisks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostsInstallationDisks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostsInstallationDisks); i++ {
+		if swag.IsZero(m.HostsInstallationDisks[i]) { // not required
+			continue
+		}
+
+		if m.HostsInstallationDisks[i] != nil {
+			if err := m.HostsInstallationDisks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts_installation_disks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterUpdateParams) validateHostsLabels(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostsLabels) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostsLabels); i++ {
+		if swag.IsZero(m.HostsLabels[i]) { // not required
+			continue
+		}
+
+		if m.HostsLabels[i] != nil {
+			if err := m.HostsLabels[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts_labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateHostsNames(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.HostsNames) { // not required
@@ -165,6 +299,31 @@ func (m *ClusterUpdateParams) validateHostsNames(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateHostsNotes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostsNotes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostsNotes); i++ {
+		if swag.IsZero(m.HostsNotes[i]) { // not required
+			continue
+		}
+
+		if m.HostsNotes[i] != nil {
+			if err := m.HostsNotes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts_notes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateHostsRoles(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.HostsRoles) { // not required
@@ -196,7 +355,106 @@ func (m *ClusterUpdateParams) validateIngressVip(formats strfmt.Registry) error
 		return nil
 	}
 
-	if err := validate.Pattern("ingress_vip", "body", string(*m.IngressVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3})?$`); err != nil {
+	if err := validate.Pattern("ingress_vip", "body", string(*m.IngressVip), `^(([0-9]{1,3}\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterUpdateParams) validateMachineNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MachineNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("machine_network_cidr", "body", string(*m.MachineNetworkCidr), `^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var clusterUpdateParamsTypeNetworkTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["OpenShiftSDN","OVNKubernetes"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterUpdateParamsTypeNetworkTypePropEnum = append(clusterUpdateParamsTypeNetworkTypePropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterUpdateParamsNetworkTypeOpenShiftSDN captures enum value "OpenShiftSDN"
+	ClusterUpdateParamsNetworkTypeOpenShiftSDN string = "OpenShiftSDN"
+
+	// ClusterUpdateParamsNetworkTypeOVNKubernetes captures enum value "OVNKubernetes"
+	ClusterUpdateParamsNetworkTypeOVNKubernetes string = "OVNKubernetes"
+)
+
+// prop value enum
+func (m *ClusterUpdateParams) validateNetworkTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterUpdateParamsTypeNetworkTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterUpdateParams) validateNetworkType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NetworkType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateNetworkTypeEnum("network_type", "body", *m.NetworkType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterUpdateParams) validateSecondaryClusterNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryClusterNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("secondary_cluster_network_cidr", "body", string(*m.SecondaryClusterNetworkCidr), `^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterUpdateParams) validateSecondaryClusterNetworkHostPrefix(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryClusterNetworkHostPrefix) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("secondary_cluster_network_host_prefix", "body", int64(*m.SecondaryClusterNetworkHostPrefix), 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("secondary_cluster_network_host_prefix", "body", int64(*m.SecondaryClusterNetworkHostPrefix), 128, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterUpdateParams) validateSecondaryServiceNetworkCidr(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SecondaryServiceNetworkCidr) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("secondary_service_network_cidr", "body", string(*m.SecondaryServiceNetworkCidr), `^(|([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
 		return err
 	}
 
@@ -209,7 +467,7 @@ func (m *ClusterUpdateParams) validateServiceNetworkCidr(formats strfmt.Registry
 		return nil
 	}
 
-	if err := validate.Pattern("service_network_cidr", "body", string(*m.ServiceNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("service_network_cidr", "body", string(*m.ServiceNetworkCidr), `^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
 		return err
 	}
 
@@ -234,6 +492,122 @@ func (m *ClusterUpdateParams) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+// ClusterUpdateParamsHostsInstallationDisksItems0 cluster update params hosts installation disks items0
+//
+// swagger:model ClusterUpdateParamsHostsInstallationDisksItems0
+type ClusterUpdateParamsHostsInstallationDisksItems0 struct {
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// The serial, WWN or by-path identifier of the disk. An empty value clears the selection.
+	InstallationDiskID string `json:"installation_disk_id,omitempty"`
+}
+
+// Validate validates this cluster update params hosts installation disks items0
+func (m *ClusterUpdateParamsHostsInstallationDisksItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterUpdateParamsHostsInstallationDisksItems0) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterUpdateParamsHostsInstallationDisksItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterUpdateParamsHostsInstallationDisksItems0) UnmarshalBinary(b []byte) error {
+	var res ClusterUpdateParamsHostsInstallationDisksItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// ClusterUpdateParamsHostsLabelsItems0 cluster update params hosts labels items0
+//
+// swagger:model ClusterUpdateParamsHostsLabelsItems0
+type ClusterUpdateParamsHostsLabelsItems0 struct {
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// labels
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Validate validates this cluster update params hosts labels items0
+func (m *ClusterUpdateParamsHostsLabelsItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterUpdateParamsHostsLabelsItems0) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterUpdateParamsHostsLabelsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterUpdateParamsHostsLabelsItems0) UnmarshalBinary(b []byte) error {
+	var res ClusterUpdateParamsHostsLabelsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
 // ClusterUpdateParamsHostsNamesItems0 cluster update params hosts names items0
 //
 // swagger:model ClusterUpdateParamsHostsNamesItems0
@@ -292,6 +666,64 @@ func (m *ClusterUpdateParamsHostsNamesItems0) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+// ClusterUpdateParamsHostsNotesItems0 cluster update params hosts notes items0
+//
+// swagger:model ClusterUpdateParamsHostsNotesItems0
+type ClusterUpdateParamsHostsNotesItems0 struct {
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// notes
+	Notes string `json:"notes,omitempty"`
+}
+
+// Validate validates this cluster update params hosts notes items0
+func (m *ClusterUpdateParamsHostsNotesItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterUpdateParamsHostsNotesItems0) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterUpdateParamsHostsNotesItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterUpdateParamsHostsNotesItems0) UnmarshalBinary(b []byte) error {
+	var res ClusterUpdateParamsHostsNotesItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
 // ClusterUpdateParamsHostsRolesItems0 cluster update params hosts roles items0
 //
 // swagger:model ClusterUpdateParamsHostsRolesItems0
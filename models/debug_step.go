@@ -20,6 +20,11 @@ type DebugStep struct {
 	// command
 	// Required: true
 	Command *string `json:"command"`
+
+	// Seconds after which the command is stopped, a default timeout is used when not set.
+	// Maximum: 86400
+	// Minimum: 0
+	Timeout int64 `json:"timeout,omitempty"`
 }
 
 // Validate validates this debug step
@@ -30,6 +35,10 @@ func (m *DebugStep) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTimeout(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -45,6 +54,23 @@ func (m *DebugStep) validateCommand(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *DebugStep) validateTimeout(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Timeout) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("timeout", "body", int64(m.Timeout), 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("timeout", "body", int64(m.Timeout), 86400, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *DebugStep) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -6,32 +6,15 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
-	"strconv"
-
-	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/validate"
 )
 
 // FreeAddressesList free addresses list
 //
 // swagger:model free-addresses-list
-type FreeAddressesList []strfmt.IPv4
+type FreeAddressesList []string
 
 // Validate validates this free addresses list
 func (m FreeAddressesList) Validate(formats strfmt.Registry) error {
-	var res []error
-
-	for i := 0; i < len(m); i++ {
-
-		if err := validate.FormatOf(strconv.Itoa(i), "body", "ipv4", m[i].String(), formats); err != nil {
-			return err
-		}
-
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
 	return nil
 }
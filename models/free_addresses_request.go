@@ -24,7 +24,7 @@ func (m FreeAddressesRequest) Validate(formats strfmt.Registry) error {
 
 	for i := 0; i < len(m); i++ {
 
-		if err := validate.Pattern(strconv.Itoa(i), "body", string(m[i]), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+		if err := validate.Pattern(strconv.Itoa(i), "body", string(m[i]), `^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
 			return err
 		}
 
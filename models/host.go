@@ -20,6 +20,12 @@ import (
 // swagger:model host
 type Host struct {
 
+	// The number of times the discovery agent of the host was asked to upgrade since it registered.
+	AgentUpgradeAttempts int64 `json:"agent_upgrade_attempts,omitempty"`
+
+	// agent version status
+	AgentVersionStatus AgentVersionStatus `json:"agent_version_status,omitempty" gorm:"-"`
+
 	// bootstrap
 	Bootstrap bool `json:"bootstrap,omitempty"`
 
@@ -34,6 +40,10 @@ type Host struct {
 	// connectivity
 	Connectivity string `json:"connectivity,omitempty" gorm:"type:text"`
 
+	// The last time the host's agent reported its connectivity to the other hosts.
+	// Format: date-time
+	ConnectivityUpdatedAt strfmt.DateTime `json:"connectivity_updated_at,omitempty" gorm:"type:timestamp with time zone"`
+
 	// created at
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -41,6 +51,13 @@ type Host struct {
 	// discovery agent version
 	DiscoveryAgentVersion string `json:"discovery_agent_version,omitempty"`
 
+	// JSON-formatted list of the fsync latency measurements of the host disks.
+	DisksSpeed string `json:"disks_speed,omitempty" gorm:"type:text"`
+
+	// A host of the cluster that was registered before by the same machine, as identified by the system serial number and an installation disk serial or a MAC address of their inventories.
+	// Format: uuid
+	DuplicateOf strfmt.UUID `json:"duplicate_of,omitempty"`
+
 	// free addresses
 	FreeAddresses string `json:"free_addresses,omitempty" gorm:"type:text"`
 
@@ -53,6 +70,12 @@ type Host struct {
 	// Format: uuid
 	ID *strfmt.UUID `json:"id" gorm:"primary_key"`
 
+	// JSON-formatted list of the container images that were checked by the host and whether they could be pulled.
+	ImagesStatus string `json:"images_status,omitempty" gorm:"type:text"`
+
+	// The disk selected by the user for the installation, identified by its serial, WWN or by-path identifier. When empty, the installation disk is chosen automatically.
+	InstallationDiskID string `json:"installation_disk_id,omitempty"`
+
 	// Installer version
 	InstallerVersion string `json:"installer_version,omitempty"`
 
@@ -64,6 +87,27 @@ type Host struct {
 	// Enum: [Host]
 	Kind *string `json:"kind"`
 
+	// JSON-formatted map of the user-defined labels of the host.
+	Labels string `json:"labels,omitempty" gorm:"type:text"`
+
+	// The last time the host's agent uploaded the host logs.
+	// Format: date-time
+	LogsCollectedAt strfmt.DateTime `json:"logs_collected_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// The machine network of the cluster that the host belongs to, filled when the cluster is queried.
+	MachineNetworkCidr string `json:"machine_network_cidr,omitempty" gorm:"-"`
+
+	// The time at which the periodic steps of the host are due again.
+	// Format: date-time
+	NextStepsAt strfmt.DateTime `json:"next_steps_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// Free-form user notes about the host.
+	Notes string `json:"notes,omitempty" gorm:"type:text"`
+
+	// The cluster the host was moved from by the last rebind operation.
+	// Format: uuid
+	PreviousClusterID strfmt.UUID `json:"previous_cluster_id,omitempty"`
+
 	// progress
 	Progress *HostProgressInfo `json:"progress,omitempty" gorm:"embedded;embedded_prefix:progress_"`
 
@@ -97,6 +141,13 @@ type Host struct {
 	// Format: date-time
 	StatusUpdatedAt strfmt.DateTime `json:"status_updated_at,omitempty" gorm:"type:timestamp with time zone"`
 
+	// The last time the periodic steps of the host were sent to its agent.
+	// Format: date-time
+	StepsIssuedAt strfmt.DateTime `json:"steps_issued_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// JSON-formatted clock offset of the host from the service and the time sources of the host.
+	TimeSync string `json:"time_sync,omitempty" gorm:"type:text"`
+
 	// updated at
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -109,6 +160,10 @@ type Host struct {
 func (m *Host) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAgentVersionStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCheckedInAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -117,10 +172,18 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateConnectivityUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCreatedAt(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateDuplicateOf(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHref(formats); err != nil {
 		res = append(res, err)
 	}
@@ -133,6 +196,18 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLogsCollectedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextStepsAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePreviousClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProgress(formats); err != nil {
 		res = append(res, err)
 	}
@@ -165,6 +240,10 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStepsIssuedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUpdatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -175,6 +254,22 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateAgentVersionStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AgentVersionStatus) { // not required
+		return nil
+	}
+
+	if err := m.AgentVersionStatus.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("agent_version_status")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *Host) validateCheckedInAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.CheckedInAt) { // not required
@@ -201,6 +296,19 @@ func (m *Host) validateClusterID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateConnectivityUpdatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ConnectivityUpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("connectivity_updated_at", "body", "date-time", m.ConnectivityUpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Host) validateCreatedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.CreatedAt) { // not required
@@ -214,6 +322,19 @@ func (m *Host) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateDuplicateOf(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DuplicateOf) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("duplicate_of", "body", "uuid", m.DuplicateOf.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Host) validateHref(formats strfmt.Registry) error {
 
 	if err := validate.Required("href", "body", m.Href); err != nil {
@@ -276,6 +397,45 @@ func (m *Host) validateKind(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateLogsCollectedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LogsCollectedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("logs_collected_at", "body", "date-time", m.LogsCollectedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Host) validateNextStepsAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NextStepsAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("next_steps_at", "body", "date-time", m.NextStepsAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Host) validatePreviousClusterID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PreviousClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("previous_cluster_id", "body", "uuid", m.PreviousClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Host) validateProgress(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Progress) { // not required
@@ -457,6 +617,19 @@ func (m *Host) validateStatusUpdatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateStepsIssuedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.StepsIssuedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("steps_issued_at", "body", "date-time", m.StepsIssuedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Host) validateUpdatedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.UpdatedAt) { // not required
@@ -55,6 +55,33 @@ const (
 
 	// HostValidationIDBelongsToMachineCidr captures enum value "belongs-to-machine-cidr"
 	HostValidationIDBelongsToMachineCidr HostValidationID = "belongs-to-machine-cidr"
+
+	// HostValidationIDHasConnectivityToAllHosts captures enum value "has-connectivity-to-all-hosts"
+	HostValidationIDHasConnectivityToAllHosts HostValidationID = "has-connectivity-to-all-hosts"
+
+	// HostValidationIDHasDiskTypeForRole captures enum value "has-disk-type-for-role"
+	HostValidationIDHasDiskTypeForRole HostValidationID = "has-disk-type-for-role"
+
+	// HostValidationIDMtuConsistent captures enum value "mtu-consistent"
+	HostValidationIDMtuConsistent HostValidationID = "mtu-consistent"
+
+	// HostValidationIDHasMinNicSpeed captures enum value "has-min-nic-speed"
+	HostValidationIDHasMinNicSpeed HostValidationID = "has-min-nic-speed"
+
+	// HostValidationIDPlatformUniform captures enum value "platform-uniform"
+	HostValidationIDPlatformUniform HostValidationID = "platform-uniform"
+
+	// HostValidationIDValidInstallationDisk captures enum value "valid-installation-disk"
+	HostValidationIDValidInstallationDisk HostValidationID = "valid-installation-disk"
+
+	// HostValidationIDContainerImagesAvailable captures enum value "container-images-available"
+	HostValidationIDContainerImagesAvailable HostValidationID = "container-images-available"
+
+	// HostValidationIDSufficientInstallationDiskSpeed captures enum value "sufficient-installation-disk-speed"
+	HostValidationIDSufficientInstallationDiskSpeed HostValidationID = "sufficient-installation-disk-speed"
+
+	// HostValidationIDAgentVersionSupported captures enum value "agent-version-supported"
+	HostValidationIDAgentVersionSupported HostValidationID = "agent-version-supported"
 )
 
 // for schema
@@ -62,7 +89,7 @@ var hostValidationIdEnum []interface{}
 
 func init() {
 	var res []HostValidationID
-	if err := json.Unmarshal([]byte(`["connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","role-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","role-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr","has-connectivity-to-all-hosts","has-disk-type-for-role","mtu-consistent","has-min-nic-speed","platform-uniform","valid-installation-disk","container-images-available","sufficient-installation-disk-speed","agent-version-supported"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
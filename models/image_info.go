@@ -24,6 +24,18 @@ type ImageInfo struct {
 	// Image generator version
 	GeneratorVersion string `json:"generator_version,omitempty"`
 
+	// The HTTP proxy of the cluster when the image was generated.
+	HTTPProxy string `json:"http_proxy,omitempty"`
+
+	// The HTTPS proxy of the cluster when the image was generated.
+	HTTPSProxy string `json:"https_proxy,omitempty"`
+
+	// The no-proxy list of the cluster when the image was generated.
+	NoProxy string `json:"no_proxy,omitempty"`
+
+	// The NTP servers of the cluster when the image was generated.
+	NtpServers string `json:"ntp_servers,omitempty"`
+
 	// The URL of the HTTP/S proxy that agents should use to access the discovery service
 	// http://\<user\>:\<password\>@\<server\>:\<port\>/
 	//
@@ -31,6 +43,9 @@ type ImageInfo struct {
 
 	// SSH public key for debugging the installation
 	SSHPublicKey string `json:"ssh_public_key,omitempty" gorm:"type:varchar(1024)"`
+
+	// JSON-formatted list of static network configurations of the hosts.
+	StaticNetworkConfig string `json:"static_network_config,omitempty" gorm:"type:text"`
 }
 
 // Validate validates this image info
@@ -37,6 +37,21 @@ const (
 
 	// StepTypeResetInstallation captures enum value "reset-installation"
 	StepTypeResetInstallation StepType = "reset-installation"
+
+	// StepTypeLogsGather captures enum value "logs-gather"
+	StepTypeLogsGather StepType = "logs-gather"
+
+	// StepTypeContainerImageAvailability captures enum value "container-image-availability"
+	StepTypeContainerImageAvailability StepType = "container-image-availability"
+
+	// StepTypeDiskSpeedCheck captures enum value "disk-speed-check"
+	StepTypeDiskSpeedCheck StepType = "disk-speed-check"
+
+	// StepTypeTimeSync captures enum value "time-sync"
+	StepTypeTimeSync StepType = "time-sync"
+
+	// StepTypeUpgradeAgent captures enum value "upgrade-agent"
+	StepTypeUpgradeAgent StepType = "upgrade-agent"
 )
 
 // for schema
@@ -44,7 +59,7 @@ var stepTypeEnum []interface{}
 
 func init() {
 	var res []StepType
-	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","reset-installation"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","reset-installation","logs-gather","container-image-availability","disk-speed-check","time-sync","upgrade-agent"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -11,6 +11,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Steps steps
@@ -18,6 +19,10 @@ import (
 // swagger:model steps
 type Steps struct {
 
+	// The cluster the host is bound to. When it differs from the cluster the steps were requested for, the host was moved to another cluster and the agent should use this cluster ID in all subsequent requests.
+	// Format: uuid
+	ClusterID strfmt.UUID `json:"cluster_id,omitempty"`
+
 	// instructions
 	Instructions []*Step `json:"instructions"`
 
@@ -29,6 +34,10 @@ type Steps struct {
 func (m *Steps) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInstructions(formats); err != nil {
 		res = append(res, err)
 	}
@@ -39,6 +48,19 @@ func (m *Steps) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Steps) validateClusterID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Steps) validateInstructions(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Instructions) { // not required
@@ -1,7 +1,14 @@
 package app
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
@@ -18,6 +25,31 @@ func WithMetricsResponderMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// WithRequestSizeLimitMiddleware returns middleware which rejects requests of the given method and path whose body is
+// larger than maxBytes with 413, and stops reading bodies of unknown length once they pass maxBytes
+func WithRequestSizeLimitMiddleware(method string, path *regexp.Regexp, maxBytes int64, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method || !path.MatchString(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.ContentLength > maxBytes {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			_ = json.NewEncoder(w).Encode(&models.Error{
+				Code:   swag.String(strconv.Itoa(http.StatusRequestEntityTooLarge)),
+				Href:   swag.String(""),
+				ID:     swag.Int32(http.StatusRequestEntityTooLarge),
+				Kind:   swag.String("Error"),
+				Reason: swag.String(fmt.Sprintf("request body is larger than %d bytes", maxBytes)),
+			})
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+		next.ServeHTTP(w, r)
+	})
+}
+
 // WithHealthMiddleware returns middleware which responds to the /health endpoint
 func WithHealthMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
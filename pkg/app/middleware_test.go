@@ -0,0 +1,54 @@
+package app
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestSizeLimitMiddleware(t *testing.T) {
+	t.Parallel()
+	path := regexp.MustCompile(`^/upload$`)
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		unknownSize bool
+		status      int
+		read        string
+	}{
+		{name: "small body", method: http.MethodPost, path: "/upload", body: "logs", status: http.StatusOK, read: "logs"},
+		{name: "large body", method: http.MethodPost, path: "/upload", body: "large logs", status: http.StatusRequestEntityTooLarge},
+		{name: "large body of unknown size", method: http.MethodPost, path: "/upload", body: "large logs",
+			unknownSize: true, status: http.StatusOK, read: "large"},
+		{name: "other path", method: http.MethodPost, path: "/other", body: "large logs", status: http.StatusOK,
+			read: "large logs"},
+		{name: "other method", method: http.MethodPut, path: "/upload", body: "large logs", status: http.StatusOK,
+			read: "large logs"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var read string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, _ := ioutil.ReadAll(r.Body)
+				read = string(b)
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.unknownSize {
+				req.ContentLength = -1
+			}
+			w := httptest.NewRecorder()
+			WithRequestSizeLimitMiddleware(http.MethodPost, path, 5, next).ServeHTTP(w, req)
+			assert.Equal(t, tt.status, w.Code)
+			assert.Equal(t, tt.read, read)
+		})
+	}
+}
@@ -60,6 +60,9 @@ type InstallerAPI interface {
 	/* DownloadClusterKubeconfig Downloads the kubeconfig file for this cluster. */
 	DownloadClusterKubeconfig(ctx context.Context, params installer.DownloadClusterKubeconfigParams) middleware.Responder
 
+	/* DownloadHostLogs Downloads the latest logs tarball uploaded by the host. */
+	DownloadHostLogs(ctx context.Context, params installer.DownloadHostLogsParams) middleware.Responder
+
 	/* EnableHost Enables a host for inclusion in the cluster. */
 	EnableHost(ctx context.Context, params installer.EnableHostParams) middleware.Responder
 
@@ -69,15 +72,30 @@ type InstallerAPI interface {
 	/* GetCluster Retrieves the details of the OpenShift bare metal cluster. */
 	GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder
 
+	/* GetClusterConnectivity Retrieves the connectivity matrix between the hosts of the cluster. */
+	GetClusterConnectivity(ctx context.Context, params installer.GetClusterConnectivityParams) middleware.Responder
+
+	/* GetClusterInstallConfig Renders the install config that the installation would generate for the cluster in its current state, with the pull secret and the SSH key redacted. */
+	GetClusterInstallConfig(ctx context.Context, params installer.GetClusterInstallConfigParams) middleware.Responder
+
+	/* GetClusterNetworkTopology Retrieves the networks of the hosts of the cluster, with their interfaces and the reachability between the hosts over each network. */
+	GetClusterNetworkTopology(ctx context.Context, params installer.GetClusterNetworkTopologyParams) middleware.Responder
+
 	/* GetCredentials Get the the cluster admin credentials. */
 	GetCredentials(ctx context.Context, params installer.GetCredentialsParams) middleware.Responder
 
+	/* GetDebugStep Retrieves a debug step of the host with its status and output. */
+	GetDebugStep(ctx context.Context, params installer.GetDebugStepParams) middleware.Responder
+
 	/* GetFreeAddresses Retrieves the free address list for a network. */
 	GetFreeAddresses(ctx context.Context, params installer.GetFreeAddressesParams) middleware.Responder
 
 	/* GetHost Retrieves the details of the OpenShift bare metal host. */
 	GetHost(ctx context.Context, params installer.GetHostParams) middleware.Responder
 
+	/* GetHostPullSecret Agent API to fetch the pull secret of the cluster for pulling the images the host needs. */
+	GetHostPullSecret(ctx context.Context, params installer.GetHostPullSecretParams) middleware.Responder
+
 	/* GetNextSteps Retrieves the next operations that the host agent needs to perform. */
 	GetNextSteps(ctx context.Context, params installer.GetNextStepsParams) middleware.Responder
 
@@ -87,12 +105,21 @@ type InstallerAPI interface {
 	/* ListClusters Retrieves the list of OpenShift bare metal clusters. */
 	ListClusters(ctx context.Context, params installer.ListClustersParams) middleware.Responder
 
+	/* ListDebugSteps Retrieves the debug steps of the host with their status and output. */
+	ListDebugSteps(ctx context.Context, params installer.ListDebugStepsParams) middleware.Responder
+
+	/* ListHostSteps Retrieves the latest steps that were sent to the host agent, with their replies. */
+	ListHostSteps(ctx context.Context, params installer.ListHostStepsParams) middleware.Responder
+
 	/* ListHosts Retrieves the list of OpenShift bare metal hosts. */
 	ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder
 
 	/* PostStepReply Posts the result of the operations from the host agent. */
 	PostStepReply(ctx context.Context, params installer.PostStepReplyParams) middleware.Responder
 
+	/* RebindHost Moves a host that was not installed yet to another cluster of the same owner. */
+	RebindHost(ctx context.Context, params installer.RebindHostParams) middleware.Responder
+
 	/* RegisterCluster Creates a new OpenShift bare metal cluster definition. */
 	RegisterCluster(ctx context.Context, params installer.RegisterClusterParams) middleware.Responder
 
@@ -102,17 +129,26 @@ type InstallerAPI interface {
 	/* ResetCluster Resets a failed installation. */
 	ResetCluster(ctx context.Context, params installer.ResetClusterParams) middleware.Responder
 
-	/* SetDebugStep Sets a single shot debug step that will be sent next time the host agent will ask for a command. */
+	/* SearchHosts Searches the hosts of all the accessible clusters. */
+	SearchHosts(ctx context.Context, params installer.SearchHostsParams) middleware.Responder
+
+	/* SetDebugStep Queues a single shot debug step that will be sent to the host agent when the previously queued debug steps are sent. */
 	SetDebugStep(ctx context.Context, params installer.SetDebugStepParams) middleware.Responder
 
 	/* UpdateCluster Updates an OpenShift bare metal cluster definition. */
 	UpdateCluster(ctx context.Context, params installer.UpdateClusterParams) middleware.Responder
 
+	/* UpdateClusterInstallConfig Override values in the install config. */
+	UpdateClusterInstallConfig(ctx context.Context, params installer.UpdateClusterInstallConfigParams) middleware.Responder
+
 	/* UpdateHostInstallProgress Update installation progress */
 	UpdateHostInstallProgress(ctx context.Context, params installer.UpdateHostInstallProgressParams) middleware.Responder
 
 	/* UploadClusterIngressCert Transfer the ingress certificate for the cluster. */
 	UploadClusterIngressCert(ctx context.Context, params installer.UploadClusterIngressCertParams) middleware.Responder
+
+	/* UploadHostLogs Agent API to upload a tarball with the agent, installer and journal logs of the host. */
+	UploadHostLogs(ctx context.Context, params installer.UploadHostLogsParams) middleware.Responder
 }
 
 //go:generate mockery -name ManagedDomainsAPI -inpkg
@@ -167,6 +203,7 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 	api.Logger = c.Logger
 
 	api.JSONConsumer = runtime.JSONConsumer()
+	api.MultipartformConsumer = runtime.DiscardConsumer
 	api.BinProducer = runtime.ByteStreamProducer()
 	api.JSONProducer = runtime.JSONProducer()
 	api.InstallerCancelInstallationHandler = installer.CancelInstallationHandlerFunc(func(params installer.CancelInstallationParams) middleware.Responder {
@@ -201,6 +238,10 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.DownloadClusterKubeconfig(ctx, params)
 	})
+	api.InstallerDownloadHostLogsHandler = installer.DownloadHostLogsHandlerFunc(func(params installer.DownloadHostLogsParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.DownloadHostLogs(ctx, params)
+	})
 	api.InstallerEnableHostHandler = installer.EnableHostHandlerFunc(func(params installer.EnableHostParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.EnableHost(ctx, params)
@@ -213,10 +254,26 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.GetCluster(ctx, params)
 	})
+	api.InstallerGetClusterConnectivityHandler = installer.GetClusterConnectivityHandlerFunc(func(params installer.GetClusterConnectivityParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.GetClusterConnectivity(ctx, params)
+	})
+	api.InstallerGetClusterInstallConfigHandler = installer.GetClusterInstallConfigHandlerFunc(func(params installer.GetClusterInstallConfigParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.GetClusterInstallConfig(ctx, params)
+	})
+	api.InstallerGetClusterNetworkTopologyHandler = installer.GetClusterNetworkTopologyHandlerFunc(func(params installer.GetClusterNetworkTopologyParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.GetClusterNetworkTopology(ctx, params)
+	})
 	api.InstallerGetCredentialsHandler = installer.GetCredentialsHandlerFunc(func(params installer.GetCredentialsParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.GetCredentials(ctx, params)
 	})
+	api.InstallerGetDebugStepHandler = installer.GetDebugStepHandlerFunc(func(params installer.GetDebugStepParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.GetDebugStep(ctx, params)
+	})
 	api.InstallerGetFreeAddressesHandler = installer.GetFreeAddressesHandlerFunc(func(params installer.GetFreeAddressesParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.GetFreeAddresses(ctx, params)
@@ -225,6 +282,10 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.GetHost(ctx, params)
 	})
+	api.InstallerGetHostPullSecretHandler = installer.GetHostPullSecretHandlerFunc(func(params installer.GetHostPullSecretParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.GetHostPullSecret(ctx, params)
+	})
 	api.InstallerGetNextStepsHandler = installer.GetNextStepsHandlerFunc(func(params installer.GetNextStepsParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.GetNextSteps(ctx, params)
@@ -241,10 +302,18 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx := params.HTTPRequest.Context()
 		return c.VersionsAPI.ListComponentVersions(ctx, params)
 	})
+	api.InstallerListDebugStepsHandler = installer.ListDebugStepsHandlerFunc(func(params installer.ListDebugStepsParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.ListDebugSteps(ctx, params)
+	})
 	api.EventsListEventsHandler = events.ListEventsHandlerFunc(func(params events.ListEventsParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.EventsAPI.ListEvents(ctx, params)
 	})
+	api.InstallerListHostStepsHandler = installer.ListHostStepsHandlerFunc(func(params installer.ListHostStepsParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.ListHostSteps(ctx, params)
+	})
 	api.InstallerListHostsHandler = installer.ListHostsHandlerFunc(func(params installer.ListHostsParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.ListHosts(ctx, params)
@@ -257,6 +326,10 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.PostStepReply(ctx, params)
 	})
+	api.InstallerRebindHostHandler = installer.RebindHostHandlerFunc(func(params installer.RebindHostParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.RebindHost(ctx, params)
+	})
 	api.InstallerRegisterClusterHandler = installer.RegisterClusterHandlerFunc(func(params installer.RegisterClusterParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.RegisterCluster(ctx, params)
@@ -269,6 +342,10 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.ResetCluster(ctx, params)
 	})
+	api.InstallerSearchHostsHandler = installer.SearchHostsHandlerFunc(func(params installer.SearchHostsParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.SearchHosts(ctx, params)
+	})
 	api.InstallerSetDebugStepHandler = installer.SetDebugStepHandlerFunc(func(params installer.SetDebugStepParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.SetDebugStep(ctx, params)
@@ -277,6 +354,10 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.UpdateCluster(ctx, params)
 	})
+	api.InstallerUpdateClusterInstallConfigHandler = installer.UpdateClusterInstallConfigHandlerFunc(func(params installer.UpdateClusterInstallConfigParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.UpdateClusterInstallConfig(ctx, params)
+	})
 	api.InstallerUpdateHostInstallProgressHandler = installer.UpdateHostInstallProgressHandlerFunc(func(params installer.UpdateHostInstallProgressParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.UpdateHostInstallProgress(ctx, params)
@@ -285,6 +366,10 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.UploadClusterIngressCert(ctx, params)
 	})
+	api.InstallerUploadHostLogsHandler = installer.UploadHostLogsHandlerFunc(func(params installer.UploadHostLogsParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		return c.InstallerAPI.UploadHostLogs(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
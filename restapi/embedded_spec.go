@@ -419,6 +419,44 @@ func init() {
         }
       }
     },
+    "/clusters/{cluster_id}/connectivity": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the connectivity matrix between the hosts of the cluster.",
+        "operationId": "GetClusterConnectivity",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/connectivity-matrix"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/clusters/{cluster_id}/credentials": {
       "get": {
         "tags": [
@@ -694,7 +732,7 @@ func init() {
             "required": true
           },
           {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+            "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
             "type": "string",
             "name": "network",
             "in": "query",
@@ -920,7 +958,7 @@ func init() {
         "tags": [
           "installer"
         ],
-        "summary": "Sets a single shot debug step that will be sent next time the host agent will ask for a command.",
+        "summary": "Queues a single shot debug step that will be sent to the host agent when the previously queued debug steps are sent.",
         "operationId": "SetDebugStep",
         "parameters": [
           {
@@ -1065,13 +1103,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/rebind": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the next operations that the host agent needs to perform.",
-        "operationId": "GetNextSteps",
+        "summary": "Moves a host that was not installed yet to another cluster of the same owner.",
+        "operationId": "RebindHost",
         "parameters": [
           {
             "type": "string",
@@ -1086,13 +1124,27 @@ func init() {
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "rebind-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rebind-host-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
@@ -1101,6 +1153,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1108,13 +1166,15 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/debug-steps": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Posts the result of the operations from the host agent.",
-        "operationId": "PostStepReply",
+        "summary": "Retrieves the debug steps of the host with their status and output.",
+        "operationId": "ListDebugSteps",
         "parameters": [
           {
             "type": "string",
@@ -1129,24 +1189,65 @@ func init() {
             "name": "host_id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/debug-step-result-list"
+            }
           },
-          "400": {
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/debug-steps/{step_id}": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves a debug step of the host with its status and output.",
+        "operationId": "GetDebugStep",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "step_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/debug-step-result"
+            }
           },
           "404": {
             "description": "Error.",
@@ -1163,18 +1264,17 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Update installation progress",
-        "operationId": "UpdateHostInstallProgress",
+        "summary": "Retrieves the next operations that the host agent needs to perform.",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -1182,24 +1282,25 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to retrieve",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "description": "New progress value",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
+            "maximum": 60,
+            "minimum": 0,
+            "type": "integer",
+            "description": "Maximum time to hold the request until new steps are available for the host. When set, the reply may have no instructions, in which case the agent should ask again right away. Agents that do not set it get the steps immediately and ask again after next_instruction_seconds.",
+            "name": "wait_seconds",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress"
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/steps"
+            }
           },
           "404": {
             "description": "Error.",
@@ -1214,15 +1315,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      },
       "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Transfer the ingress certificate for the cluster.",
-        "operationId": "UploadClusterIngressCert",
+        "summary": "Posts the result of the operations from the host agent.",
+        "operationId": "PostStepReply",
         "parameters": [
           {
             "type": "string",
@@ -1232,16 +1331,22 @@ func init() {
             "required": true
           },
           {
-            "name": "ingress-cert-params",
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "reply",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/ingress-cert-params"
+              "$ref": "#/definitions/step-reply"
             }
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "Success."
           },
           "400": {
@@ -1265,35 +1370,43 @@ func init() {
         }
       }
     },
-    "/component_versions": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "versions"
+          "installer"
         ],
-        "summary": "List of componenets versions",
-        "operationId": "ListComponentVersions",
-        "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/list-versions"
-            }
+        "summary": "Downloads the latest logs tarball uploaded by the host.",
+        "operationId": "DownloadHostLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
-        }
-      }
-    },
-    "/domains": {
-      "get": {
-        "tags": [
-          "managed_domains"
         ],
-        "summary": "List of managed DNS domains",
-        "operationId": "ListManagedDomains",
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/list-managed-domains"
+              "type": "file"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -1303,29 +1416,53 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/events/{entity_id}": {
-      "get": {
+      },
+      "post": {
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "events"
+          "installer"
         ],
-        "summary": "Lists events for an entity_id",
-        "operationId": "ListEvents",
+        "summary": "Agent API to upload a tarball with the agent, installer and journal logs of the host.",
+        "operationId": "UploadHostLogs",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "name": "entity_id",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "file",
+            "description": "The logs tarball to upload.",
+            "name": "upfile",
+            "in": "formData",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "404": {
+            "description": "Error.",
             "schema": {
-              "$ref": "#/definitions/event-list"
+              "$ref": "#/definitions/error"
+            }
+          },
+          "413": {
+            "description": "The logs tarball is larger than the service accepts.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -1336,73 +1473,1681 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "boot": {
-      "type": "object",
-      "properties": {
-        "current_boot_mode": {
-          "type": "string"
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Update installation progress",
+        "operationId": "UpdateHostInstallProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the cluster to retrieve",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to retrieve",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value",
+            "name": "host-progress",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-progress"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Update install progress"
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/pull-secret": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Agent API to fetch the pull secret of the cluster for pulling the images the host needs.",
+        "operationId": "GetHostPullSecret",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The cloud.openshift.com token of the pull secret that the agent was started with.",
+            "name": "X-Secret-Key",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull secret of the cluster.",
+            "schema": {
+              "type": "object"
+            }
+          },
+          "401": {
+            "description": "The key does not match the pull secret of the cluster.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/steps": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the latest steps that were sent to the host agent, with their replies.",
+        "operationId": "ListHostSteps",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-step-list"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/install-config": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Renders the install config that the installation would generate for the cluster in its current state, with the pull secret and the SSH key redacted.",
+        "operationId": "GetClusterInstallConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/install-config-preview"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Override values in the install config.",
+        "operationId": "UpdateClusterInstallConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "install-config-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/install-config-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/network-topology": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the networks of the hosts of the cluster, with their interfaces and the reachability between the hosts over each network.",
+        "operationId": "GetClusterNetworkTopology",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/network-topology"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/uploads/ingress-cert": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Transfer the ingress certificate for the cluster.",
+        "operationId": "UploadClusterIngressCert",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "ingress-cert-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ingress-cert-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/component_versions": {
+      "get": {
+        "tags": [
+          "versions"
+        ],
+        "summary": "List of componenets versions",
+        "operationId": "ListComponentVersions",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-versions"
+            }
+          }
+        }
+      }
+    },
+    "/domains": {
+      "get": {
+        "tags": [
+          "managed_domains"
+        ],
+        "summary": "List of managed DNS domains",
+        "operationId": "ListManagedDomains",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/list-managed-domains"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/events/{entity_id}": {
+      "get": {
+        "tags": [
+          "events"
+        ],
+        "summary": "Lists events for an entity_id",
+        "operationId": "ListEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "entity_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/event-list"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/hosts": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Searches the hosts of all the accessible clusters.",
+        "operationId": "SearchHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Comma-separated list of labels the host must have, each either as key or as key=value.",
+            "name": "labels",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "mac_address",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The system serial number of the host.",
+            "name": "serial_number",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "bmc_address",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A substring of the system manufacturer of the host.",
+            "name": "vendor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A substring of the system product name of the host.",
+            "name": "product",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "role",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-search-result"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "agent-version-drift": {
+      "description": "The hosts of a cluster whose discovery agent is not the version of the service.",
+      "type": "object",
+      "properties": {
+        "compatible_host_ids": {
+          "description": "Hosts with an older but supported agent, which are being upgraded.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "expected_version": {
+          "description": "The discovery agent version of the service.",
+          "type": "string"
+        },
+        "minimum_version": {
+          "description": "The oldest discovery agent version that hosts can be installed with, empty when all versions are supported.",
+          "type": "string"
+        },
+        "unsupported_host_ids": {
+          "description": "Hosts with an agent older than the minimum version.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        }
+      }
+    },
+    "agent-version-status": {
+      "description": "How the discovery agent version of a host relates to the version of the service. Current agents are the expected version, compatible agents are older but supported and are upgraded, unsupported agents are older than the minimum version and the host cannot be installed until it is upgraded.",
+      "type": "string",
+      "enum": [
+        "current",
+        "compatible",
+        "unsupported"
+      ]
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "pxe_interface": {
+          "type": "string"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "image_info",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "additional_machine_network_cidrs": {
+          "description": "Comma-separated list of the machine network CIDRs of the hosts that are not in machine_network_cidr, such as the subnets of the other racks of a routed network. The VIPs are in machine_network_cidr, so the masters must be in it.",
+          "type": "string"
+        },
+        "agent_version_drift": {
+          "x-go-custom-tag": "gorm:\"-\"",
+          "$ref": "#/definitions/agent-version-drift"
+        },
+        "api_vip": {
+          "description": "Virtual IP used to reach the OpenShift cluster API.",
+          "type": "string",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$"
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "host_networks": {
+          "description": "List of host networks to be filled during query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host_network"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "http_proxy": {
+          "description": "The URL of the proxy for the HTTP requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "The URL of the proxy for the HTTPS requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "ignition_generator_version": {
+          "type": "string"
+        },
+        "image_info": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
+          "$ref": "#/definitions/image_info"
+        },
+        "ingress_vip": {
+          "description": "Virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$"
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "install_config_overrides": {
+          "description": "JSON merge patch that is applied on top of the generated install config of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "install_started_at": {
+          "description": "The time that this cluster began installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object or 'ClusterLink' if it is just a link.",
+          "type": "string",
+          "enum": [
+            "Cluster"
+          ]
+        },
+        "machine_network_cidr": {
+          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "type": "string",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "network_type": {
+          "description": "The cluster network provider of the OpenShift cluster, among the ones supported by its version. IPv6 and dual-stack networks require OVNKubernetes. Empty when it is not selected, the cluster is then installed with OVNKubernetes for IPv6 and dual-stack networks, otherwise with OpenShiftSDN.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ]
+        },
+        "no_proxy": {
+          "description": "Comma-separated list of the domains, IP addresses and CIDRs that are not accessed through the proxy. When a proxy is set, it must contain the machine, cluster and service networks and the API domains of the cluster, or '*'.",
+          "type": "string"
+        },
+        "ntp_servers": {
+          "description": "Comma-separated list of the NTP servers, hostnames or IP addresses, that the hosts synchronize their clocks with. When empty, the default servers of the operating system are used.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "4.5"
+          ]
+        },
+        "org_id": {
+          "type": "string"
+        },
+        "pull_secret_set": {
+          "description": "True if the pull-secret has been added to the cluster",
+          "type": "boolean"
+        },
+        "secondary_cluster_network_cidr": {
+          "description": "IP address block, of the other IP family than cluster_network_cidr, from which the Pod IPs of a dual-stack cluster are allocated. The cluster is dual-stack when it is set together with secondary_service_network_cidr.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node out of secondary_cluster_network_cidr. Defaults to 23 for IPv4 and 64 for IPv6.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 0
+        },
+        "secondary_machine_network_cidr": {
+          "description": "The CIDR, of the other IP family than machine_network_cidr, of the network that the hosts of a dual-stack cluster have on the interface of the machine network.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "secondary_service_network_cidr": {
+          "description": "The IP address pool, of the other IP family than service_network_cidr, to use for the service IP addresses of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "preparing-for-installation",
+            "installing",
+            "finalizing",
+            "installed"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        },
+        "status_updated_at": {
+          "description": "The last time that the cluster status has been updated",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "user_id": {
+          "type": "string"
+        },
+        "validations_info": {
+          "description": "Json formatted string containing the cluster validations results for each validation id grouped by category (hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_allocation": {
+          "description": "Whether the service allocates the API and Ingress VIPs out of the free addresses that the hosts discovered in the machine network, instead of the user.",
+          "type": "boolean"
+        },
+        "vip_exclusion_ranges": {
+          "description": "Comma-separated list of addresses, address ranges (first-last) or CIDRs of the machine network that the service does not allocate as VIPs, such as the pool of a DHCP server.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version"
+      ],
+      "properties": {
+        "additional_machine_network_cidrs": {
+          "description": "Comma-separated list of the machine network CIDRs of the hosts that are not in machine_network_cidr, such as the subnets of the other racks of a routed network. The VIPs are in machine_network_cidr, so the masters must be in it.",
+          "type": "string"
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "10.128.0.0/14",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "default": 23,
+          "maximum": 128,
+          "minimum": 1
+        },
+        "http_proxy": {
+          "description": "The URL of the proxy for the HTTP requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "The URL of the proxy for the HTTPS requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string"
+        },
+        "ingress_vip": {
+          "description": "Virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "network_type": {
+          "description": "The cluster network provider of the OpenShift cluster, among the ones supported by its version. IPv6 and dual-stack networks require OVNKubernetes. Defaults to OVNKubernetes for IPv6 and dual-stack networks, otherwise to OpenShiftSDN.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ]
+        },
+        "no_proxy": {
+          "description": "Comma-separated list of the domains, IP addresses and CIDRs that are not accessed through the proxy. When a proxy is set, it must contain the machine, cluster and service networks and the API domains of the cluster, or '*'.",
+          "type": "string"
+        },
+        "ntp_servers": {
+          "description": "Comma-separated list of the NTP servers, hostnames or IP addresses, that the hosts synchronize their clocks with. When empty, the default servers of the operating system are used.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "4.5"
+          ]
+        },
+        "pull_secret": {
+          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
+          "type": "string"
+        },
+        "secondary_cluster_network_cidr": {
+          "description": "IP address block, of the other IP family than cluster_network_cidr, from which the Pod IPs of a dual-stack cluster are allocated. The cluster is dual-stack when it is set together with secondary_service_network_cidr.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node out of secondary_cluster_network_cidr. Defaults to 23 for IPv4 and 64 for IPv6.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 0
+        },
+        "secondary_service_network_cidr": {
+          "description": "The IP address pool, of the other IP family than service_network_cidr, to use for the service IP addresses of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "default": "172.30.0.0/16",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        },
+        "vip_allocation": {
+          "description": "Whether the service allocates the API and Ingress VIPs out of the free addresses that the hosts discovered in the machine network, instead of the user.",
+          "type": "boolean"
+        },
+        "vip_exclusion_ranges": {
+          "description": "Comma-separated list of addresses, address ranges (first-last) or CIDRs of the machine network that the service does not allocate as VIPs, such as the pool of a DHCP server.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-update-params": {
+      "type": "object",
+      "properties": {
+        "additional_machine_network_cidrs": {
+          "description": "Comma-separated list of the machine network CIDRs of the hosts that are not in machine_network_cidr, such as the subnets of the other racks of a routed network. The VIPs are in machine_network_cidr, so the masters must be in it.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "api_vip": {
+          "description": "Virtual IP used to reach the OpenShift cluster API.",
+          "type": "string",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$",
+          "x-nullable": true
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
+          "x-nullable": true
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "hosts_installation_disks": {
+          "description": "The desired installation disk for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "installation_disk_id": {
+                "description": "The serial, WWN or by-path identifier of the disk. An empty value clears the selection.",
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_labels": {
+          "description": "The user-defined labels of hosts associated with the cluster, replacing their current labels.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "labels": {
+                "type": "object",
+                "additionalProperties": {
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_names": {
+          "description": "The desired hostname for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "hostname": {
+                "type": "string"
+              },
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              }
+            }
+          },
+          "x-go-custom-tag": "gorm:\"type:varchar(64)[]\"",
+          "x-nullable": true
+        },
+        "hosts_notes": {
+          "description": "The user notes of hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "notes": {
+                "type": "string"
+              }
+            }
+          },
+          "x-nullable": true
+        },
+        "hosts_roles": {
+          "description": "The desired role for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "role": {
+                "$ref": "#/definitions/host-role-update-params"
+              }
+            }
+          },
+          "x-go-custom-tag": "gorm:\"type:varchar(64)[]\"",
+          "x-nullable": true
+        },
+        "http_proxy": {
+          "description": "The URL of the proxy for the HTTP requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "The URL of the proxy for the HTTPS requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ingress_vip": {
+          "description": "Virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$",
+          "x-nullable": true
+        },
+        "machine_network_cidr": {
+          "description": "The machine network, one of the host networks, that the VIPs are allocated in. It can be set only when vip_allocation is set, otherwise it is calculated from the VIPs.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "OpenShift cluster name",
+          "type": "string",
+          "x-nullable": true
+        },
+        "network_type": {
+          "description": "The cluster network provider of the OpenShift cluster, among the ones supported by its version. IPv6 and dual-stack networks require OVNKubernetes.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "Comma-separated list of the domains, IP addresses and CIDRs that are not accessed through the proxy. When a proxy is set, it must contain the machine, cluster and service networks and the API domains of the cluster, or '*'.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ntp_servers": {
+          "description": "Comma-separated list of the NTP servers, hostnames or IP addresses, that the hosts synchronize their clocks with. When empty, the default servers of the operating system are used.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "pull_secret": {
+          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "secondary_cluster_network_cidr": {
+          "description": "IP address block, of the other IP family than cluster_network_cidr, from which the Pod IPs of a dual-stack cluster are allocated. The cluster is dual-stack when it is set together with secondary_service_network_cidr.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
+          "x-nullable": true
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node out of secondary_cluster_network_cidr. Defaults to 23 for IPv4 and 64 for IPv6.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "secondary_service_network_cidr": {
+          "description": "The IP address pool, of the other IP family than service_network_cidr, to use for the service IP addresses of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
+          "x-nullable": true
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
+          "x-nullable": true
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "vip_allocation": {
+          "description": "Whether the service allocates the API and Ingress VIPs out of the free addresses that the hosts discovered in the machine network, instead of the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_exclusion_ranges": {
+          "description": "Comma-separated list of addresses, address ranges (first-last) or CIDRs of the machine network that the service does not allocate as VIPs, such as the pool of a DHCP server.",
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "host-clocks-synced",
+        "vips-allocated",
+        "network-prefix-valid",
+        "no-cidrs-overlapping",
+        "proxy-valid"
+      ]
+    },
+    "completion-params": {
+      "type": "object",
+      "required": [
+        "is_success"
+      ],
+      "properties": {
+        "error_info": {
+          "type": "string"
+        },
+        "is_success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "connectivity-check-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
+        }
+      }
+    },
+    "connectivity-check-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-matrix": {
+      "type": "object",
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-matrix-entry"
+          }
+        }
+      }
+    },
+    "connectivity-matrix-entry": {
+      "type": "object",
+      "properties": {
+        "l2_connected": {
+          "description": "The source host reached the target host over L2 within the machine network.",
+          "type": "boolean"
+        },
+        "l3_connected": {
+          "description": "The source host reached the target host over L3 within the machine network.",
+          "type": "boolean"
+        },
+        "source_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "pending"
+          ]
+        },
+        "target_host_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "container-image-availability": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "A fully qualified image name (FQIN).",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/container-image-availability-result"
+        }
+      }
+    },
+    "container-image-availability-response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container-image-availability"
+          }
+        }
+      }
+    },
+    "container-image-availability-result": {
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
+          "type": "string"
+        }
+      }
+    },
+    "credentials": {
+      "type": "object",
+      "properties": {
+        "console_url": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "debug-step": {
+      "type": "object",
+      "required": [
+        "command"
+      ],
+      "properties": {
+        "command": {
+          "type": "string"
+        },
+        "timeout": {
+          "description": "Seconds after which the command is stopped, a default timeout is used when not set.",
+          "type": "integer",
+          "maximum": 86400,
+          "minimum": 0
+        }
+      }
+    },
+    "debug-step-result": {
+      "type": "object",
+      "required": [
+        "step_id",
+        "host_id",
+        "cluster_id",
+        "command",
+        "status"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "command": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "completed_at": {
+          "description": "The time the host agent replied or the step timed out.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "exit_code": {
+          "type": "integer"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "started_at": {
+          "description": "The time the step was sent to the host agent.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "queued",
+            "running",
+            "completed",
+            "failed",
+            "timed-out"
+          ]
+        },
+        "stderr": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "stdout": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "step_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "timeout": {
+          "description": "Seconds after which the command is stopped.",
+          "type": "integer"
+        }
+      }
+    },
+    "debug-step-result-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/debug-step-result"
+      }
+    },
+    "disk": {
+      "type": "object",
+      "properties": {
+        "by_path": {
+          "type": "string"
+        },
+        "drive_type": {
+          "type": "string"
+        },
+        "hctl": {
+          "type": "string"
+        },
+        "model": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "serial": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "wwn": {
+          "type": "string"
+        }
+      }
+    },
+    "disk-speed": {
+      "type": "object",
+      "properties": {
+        "attempts": {
+          "description": "Number of times the disk was checked, a failed check is repeated a limited number of times.",
+          "type": "integer"
+        },
+        "exit_code": {
+          "description": "Exit code of the check, the duration is valid only if the check succeeded.",
+          "type": "integer"
+        },
+        "io_sync_duration": {
+          "description": "The 99th percentile of the fsync duration of the disk in milliseconds.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "Path of the checked disk.",
+          "type": "string"
+        }
+      }
+    },
+    "disk-speed-check-request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "Path of the disk that is checked, e.g. /dev/sda.",
+          "type": "string"
+        }
+      }
+    },
+    "disk-speed-check-response": {
+      "type": "object",
+      "properties": {
+        "io_sync_duration": {
+          "description": "The 99th percentile of the fsync duration of the disk in milliseconds.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "Path of the checked disk.",
+          "type": "string"
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, for if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "type": "string",
+          "enum": [
+            "Error"
+          ]
+        },
+        "reason": {
+          "description": "Human readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "entity_id",
+        "severity",
+        "message",
+        "event_time"
+      ],
+      "properties": {
+        "entity_id": {
+          "description": "Unique identifier of the object this event relates to.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "event_time": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "message": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        },
+        "request_id": {
+          "description": "Unique identifier for the request that caused this event to occure",
+          "type": "string",
+          "format": "uuid"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "error",
+            "critical"
+          ]
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "free-addresses-list": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
+    },
+    "free_addresses_request": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+      }
+    },
+    "free_network_addresses": {
+      "type": "object",
+      "properties": {
+        "free_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "pxe_interface": {
-          "type": "string"
+        "network": {
+          "type": "string",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
         }
       }
     },
-    "cluster": {
+    "free_networks_addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free_network_addresses"
+      }
+    },
+    "host": {
       "type": "object",
       "required": [
         "kind",
         "id",
         "href",
-        "image_info",
         "status",
         "status_info"
       ],
       "properties": {
-        "api_vip": {
-          "description": "Virtual IP used to reach the OpenShift cluster API.",
+        "agent_upgrade_attempts": {
+          "description": "The number of times the discovery agent of the host was asked to upgrade since it registered.",
+          "type": "integer"
+        },
+        "agent_version_status": {
+          "x-go-custom-tag": "gorm:\"-\"",
+          "$ref": "#/definitions/agent-version-status"
+        },
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "connectivity": {
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
+        "connectivity_updated_at": {
+          "description": "The last time the host's agent reported its connectivity to the other hosts.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
         "created_at": {
-          "description": "The time that this cluster was created.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "host_networks": {
-          "description": "List of host networks to be filled during query.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/host_network"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
+        "discovery_agent_version": {
+          "type": "string"
         },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/host"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        "disks_speed": {
+          "description": "JSON-formatted list of the fsync latency measurements of the host disks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "duplicate_of": {
+          "description": "A host of the cluster that was registered before by the same machine, as identified by the system serial number and an installation disk serial or a MAC address of their inventories.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "free_addresses": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
         "href": {
           "description": "Self link.",
@@ -1414,1143 +3159,1370 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "ignition_generator_version": {
+        "images_status": {
+          "description": "JSON-formatted list of the container images that were checked by the host and whether they could be pulled.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "installation_disk_id": {
+          "description": "The disk selected by the user for the installation, identified by its serial, WWN or by-path identifier. When empty, the installation disk is chosen automatically.",
           "type": "string"
         },
-        "image_info": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:image_\"",
-          "$ref": "#/definitions/image_info"
+        "installer_version": {
+          "description": "Installer version",
+          "type": "string"
         },
-        "ingress_vip": {
-          "description": "Virtual IP used for cluster ingress traffic.",
+        "inventory": {
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+          "enum": [
+            "Host"
+          ]
         },
-        "install_started_at": {
-          "description": "The time that this cluster began installation.",
+        "labels": {
+          "description": "JSON-formatted map of the user-defined labels of the host.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object or 'ClusterLink' if it is just a link.",
+        "logs_collected_at": {
+          "description": "The last time the host's agent uploaded the host logs.",
           "type": "string",
-          "enum": [
-            "Cluster"
-          ]
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
         "machine_network_cidr": {
-          "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
+          "description": "The machine network of the cluster that the host belongs to, filled when the cluster is queried.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "x-go-custom-tag": "gorm:\"-\""
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
+        "next_steps_at": {
+          "description": "The time at which the periodic steps of the host are due again.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
+        "notes": {
+          "description": "Free-form user notes about the host.",
           "type": "string",
-          "enum": [
-            "4.5"
-          ]
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "org_id": {
+        "previous_cluster_id": {
+          "description": "The cluster the host was moved from by the last rebind operation.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "progress": {
+          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
+          "$ref": "#/definitions/host-progress-info"
+        },
+        "progress_stages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-stage"
+          },
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "requested_hostname": {
           "type": "string"
         },
-        "pull_secret_set": {
-          "description": "True if the pull-secret has been added to the cluster",
-          "type": "boolean"
+        "role": {
+          "$ref": "#/definitions/host-role"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
         "status": {
-          "description": "Status of the OpenShift cluster.",
           "type": "string",
           "enum": [
+            "discovering",
+            "known",
+            "disconnected",
             "insufficient",
-            "ready",
-            "error",
+            "disabled",
             "preparing-for-installation",
+            "pending-for-input",
             "installing",
-            "finalizing",
-            "installed"
+            "installing-in-progress",
+            "installing-pending-user-action",
+            "resetting-pending-user-action",
+            "installed",
+            "error",
+            "resetting"
           ]
         },
         "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
         "status_updated_at": {
-          "description": "The last time that the cluster status has been updated",
+          "description": "The last time that the host status has been updated",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "steps_issued_at": {
+          "description": "The last time the periodic steps of the host were sent to its agent.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "time_sync": {
+          "description": "JSON-formatted clock offset of the host from the service and the time sources of the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "updated_at": {
-          "description": "The last time that this cluster was updated.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "user_id": {
-          "type": "string"
+        "validations_info": {
+          "description": "Json formatted string containing the validations results for each validation id grouped by category (network, hardware, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         }
       }
     },
-    "cluster-create-params": {
+    "host-create-params": {
       "type": "object",
       "required": [
-        "name",
-        "openshift_version"
+        "host_id"
       ],
       "properties": {
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string"
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "10.128.0.0/14",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "default": 23,
-          "maximum": 32,
-          "minimum": 1
-        },
-        "ingress_vip": {
-          "description": "Virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
-        },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
-          "type": "string"
-        },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string",
-          "enum": [
-            "4.5"
-          ]
-        },
-        "pull_secret": {
-          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
-          "type": "string"
-        },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "default": "172.30.0.0/16",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "discovery_agent_version": {
           "type": "string"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
         }
       }
     },
-    "cluster-list": {
+    "host-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/cluster"
+        "$ref": "#/definitions/host"
       }
     },
-    "cluster-update-params": {
+    "host-progress": {
       "type": "object",
+      "required": [
+        "current_stage"
+      ],
       "properties": {
-        "api_vip": {
-          "description": "Virtual IP used to reach the OpenShift cluster API.",
-          "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$",
-          "x-nullable": true
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
-          "type": "string",
-          "x-nullable": true
-        },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "current_stage": {
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
-          "x-nullable": true
-        },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1,
-          "x-nullable": true
-        },
-        "hosts_names": {
-          "description": "The desired hostname for hosts associated with the cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "hostname": {
-                "type": "string"
-              },
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              }
-            }
-          },
-          "x-go-custom-tag": "gorm:\"type:varchar(64)[]\"",
-          "x-nullable": true
-        },
-        "hosts_roles": {
-          "description": "The desired role for hosts associated with the cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              },
-              "role": {
-                "$ref": "#/definitions/host-role-update-params"
-              }
-            }
-          },
-          "x-go-custom-tag": "gorm:\"type:varchar(64)[]\"",
-          "x-nullable": true
+          "$ref": "#/definitions/host-stage"
         },
-        "ingress_vip": {
-          "description": "Virtual IP used for cluster ingress traffic.",
+        "progress_info": {
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$",
-          "x-nullable": true
-        },
-        "name": {
-          "description": "OpenShift cluster name",
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        }
+      }
+    },
+    "host-progress-info": {
+      "type": "object",
+      "required": [
+        "current_stage"
+      ],
+      "properties": {
+        "current_stage": {
           "type": "string",
-          "x-nullable": true
+          "$ref": "#/definitions/host-stage"
         },
-        "pull_secret": {
-          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
+        "progress_info": {
           "type": "string",
-          "x-nullable": true
+          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+        "stage_started_at": {
+          "description": "Time at which the current progress stage started",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "stage_updated_at": {
+          "description": "Time at which the current progress stage was last updated",
           "type": "string",
-          "x-nullable": true
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         }
       }
     },
-    "completion-params": {
+    "host-role": {
+      "type": "string",
+      "enum": [
+        "master",
+        "worker",
+        "bootstrap"
+      ]
+    },
+    "host-role-update-params": {
+      "type": "string",
+      "enum": [
+        "master",
+        "worker"
+      ]
+    },
+    "host-search-result": {
       "type": "object",
-      "required": [
-        "is_success"
-      ],
       "properties": {
-        "error_info": {
-          "type": "string"
+        "hosts": {
+          "$ref": "#/definitions/host-list"
         },
-        "is_success": {
-          "type": "boolean"
+        "total_count": {
+          "description": "The number of hosts matching the search, regardless of pagination.",
+          "type": "integer"
         }
       }
     },
-    "connectivity-check-host": {
+    "host-stage": {
+      "type": "string",
+      "enum": [
+        "Starting installation",
+        "Waiting for control plane",
+        "Start Waiting for control plane",
+        "Installing",
+        "Writing image to disk",
+        "Rebooting",
+        "Waiting for ignition",
+        "Configuring",
+        "Joined",
+        "Done",
+        "Failed"
+      ]
+    },
+    "host-static-network-config": {
       "type": "object",
+      "required": [
+        "mac_address",
+        "network_yaml"
+      ],
       "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "mac_address": {
+          "description": "MAC address of one of the interfaces of the host that the configuration belongs to.",
+          "type": "string"
         },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
-          }
+        "network_yaml": {
+          "description": "nmstate-style YAML with the interfaces, routes and DNS servers of the host.",
+          "type": "string"
         }
       }
     },
-    "connectivity-check-nic": {
+    "host-step": {
       "type": "object",
+      "required": [
+        "step_id",
+        "host_id",
+        "cluster_id"
+      ],
       "properties": {
-        "ip_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "args": {
+          "description": "JSON-formatted list of the step arguments, with secrets redacted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "mac": {
-          "type": "string"
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "name": {
+        "command": {
           "type": "string"
+        },
+        "duration_ms": {
+          "description": "Milliseconds from issuing the step until its reply arrived.",
+          "type": "integer"
+        },
+        "error": {
+          "description": "Error of the step, with secrets redacted and truncated.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "exit_code": {
+          "type": "integer"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "issued_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "output": {
+          "description": "Output of the step, with secrets redacted and truncated.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "output_truncated": {
+          "type": "boolean"
+        },
+        "replied_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "step_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "connectivity-check-params": {
+    "host-step-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/connectivity-check-host"
+        "$ref": "#/definitions/host-step"
       }
     },
-    "connectivity-remote-host": {
+    "host-time-sync": {
       "type": "object",
       "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "clock_offset_ms": {
+          "description": "The offset of the host clock from the service clock in milliseconds.",
+          "type": "integer"
         },
-        "l2_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l2-connectivity"
-          }
+        "source_offset": {
+          "description": "The offset of the host clock from the time source it is synchronized with, in seconds.",
+          "type": "number"
         },
-        "l3_connectivity": {
+        "sources": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/l3-connectivity"
+            "$ref": "#/definitions/time-sync-source"
           }
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "connectivity-report": {
-      "type": "object",
-      "properties": {
-        "remote_hosts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
-          }
-        }
-      }
+    "host-validation-id": {
+      "type": "string",
+      "enum": [
+        "connected",
+        "has-inventory",
+        "has-min-cpu-cores",
+        "has-min-valid-disks",
+        "has-min-memory",
+        "machine-cidr-defined",
+        "role-defined",
+        "has-cpu-cores-for-role",
+        "has-memory-for-role",
+        "hostname-unique",
+        "hostname-valid",
+        "belongs-to-machine-cidr",
+        "has-connectivity-to-all-hosts",
+        "has-disk-type-for-role",
+        "mtu-consistent",
+        "has-min-nic-speed",
+        "platform-uniform",
+        "valid-installation-disk",
+        "container-images-available",
+        "sufficient-installation-disk-speed",
+        "agent-version-supported"
+      ]
     },
-    "cpu": {
+    "host_network": {
       "type": "object",
       "properties": {
-        "architecture": {
+        "cidr": {
           "type": "string"
         },
-        "count": {
-          "type": "integer"
-        },
-        "flags": {
+        "host_ids": {
           "type": "array",
           "items": {
-            "type": "string"
+            "type": "string",
+            "format": "uuid"
           }
-        },
-        "frequency": {
-          "type": "number"
-        },
-        "model_name": {
-          "type": "string"
         }
       }
     },
-    "credentials": {
+    "image-create-params": {
       "type": "object",
       "properties": {
-        "console_url": {
+        "proxy_url": {
+          "description": "Deprecated, use the http_proxy and https_proxy of the cluster. The URL of the HTTP/S proxy that agents should\nuse to access the discovery service when the cluster has no proxy\nhttp://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/\n",
           "type": "string"
         },
-        "password": {
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
           "type": "string"
         },
-        "username": {
-          "type": "string"
-        }
-      }
-    },
-    "debug-step": {
-      "type": "object",
-      "required": [
-        "command"
-      ],
-      "properties": {
-        "command": {
-          "type": "string"
+        "static_network_config": {
+          "description": "Static network configuration of the hosts, for machine networks without DHCP.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-static-network-config"
+          }
         }
       }
     },
-    "disk": {
+    "image_info": {
       "type": "object",
       "properties": {
-        "by_path": {
-          "type": "string"
-        },
-        "drive_type": {
-          "type": "string"
-        },
-        "hctl": {
-          "type": "string"
-        },
-        "model": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "path": {
+        "generator_version": {
+          "description": "Image generator version",
           "type": "string"
         },
-        "serial": {
+        "http_proxy": {
+          "description": "The HTTP proxy of the cluster when the image was generated.",
           "type": "string"
         },
-        "size_bytes": {
-          "type": "integer"
-        },
-        "vendor": {
+        "https_proxy": {
+          "description": "The HTTPS proxy of the cluster when the image was generated.",
           "type": "string"
         },
-        "wwn": {
-          "type": "string"
-        }
-      }
-    },
-    "error": {
-      "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "code",
-        "reason"
-      ],
-      "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, for if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+        "no_proxy": {
+          "description": "The no-proxy list of the cluster when the image was generated.",
           "type": "string"
         },
-        "href": {
-          "description": "Self link.",
+        "ntp_servers": {
+          "description": "The NTP servers of the cluster when the image was generated.",
           "type": "string"
         },
-        "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
-          "type": "string",
-          "enum": [
-            "Error"
-          ]
-        },
-        "reason": {
-          "description": "Human readable description of the error.",
+        "proxy_url": {
+          "description": "The URL of the HTTP/S proxy that agents should use to access the discovery service\nhttp://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/\n",
           "type": "string"
-        }
-      }
-    },
-    "event": {
-      "type": "object",
-      "required": [
-        "entity_id",
-        "severity",
-        "message",
-        "event_time"
-      ],
-      "properties": {
-        "entity_id": {
-          "description": "Unique identifier of the object this event relates to.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"index\""
-        },
-        "event_time": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
-        },
-        "message": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
         },
-        "request_id": {
-          "description": "Unique identifier for the request that caused this event to occure",
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation",
           "type": "string",
-          "format": "uuid"
+          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
         },
-        "severity": {
+        "static_network_config": {
+          "description": "JSON-formatted list of static network configurations of the hosts.",
           "type": "string",
-          "enum": [
-            "info",
-            "warning",
-            "error",
-            "critical"
-          ]
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
-    "event-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/event"
-      }
-    },
-    "free-addresses-list": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "format": "ipv4"
-      }
+    "ingress-cert-params": {
+      "type": "string"
     },
-    "free_addresses_request": {
-      "type": "array",
-      "items": {
-        "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-      }
+    "install-config-params": {
+      "description": "JSON merge patch, or the equivalent YAML document, applied on top of the generated install config. It must not change the values that the service computes, such as the networking, the hosts and the pull secret. Of the platform, only the OS images and the provisioning settings of the baremetal platform may be set, and of the compute pools only their hyperthreading. An empty document removes the overrides.",
+      "type": "string"
     },
-    "free_network_addresses": {
+    "install-config-preview": {
       "type": "object",
       "properties": {
-        "free_addresses": {
+        "errors": {
+          "description": "The errors that would stop the generation of the install config when the installation starts.",
           "type": "array",
           "items": {
-            "type": "string",
-            "format": "ipv4"
+            "type": "string"
           }
         },
-        "network": {
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        "install_config": {
+          "description": "The install config in YAML, with the pull secret and the SSH key redacted. Empty when it cannot be generated.",
+          "type": "string"
         }
       }
     },
-    "free_networks_addresses": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/free_network_addresses"
-      }
-    },
-    "host": {
+    "interface": {
       "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "status",
-        "status_info"
-      ],
       "properties": {
-        "bootstrap": {
+        "biosdevname": {
+          "type": "string"
+        },
+        "client_id": {
+          "type": "string"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "has_carrier": {
           "type": "boolean"
         },
-        "checked_in_at": {
-          "description": "The last time the host's agent communicated with the service.",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "cluster_id": {
-          "description": "The cluster that this host is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "connectivity": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "mac_address": {
+          "type": "string"
         },
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "mtu": {
+          "type": "integer"
         },
-        "discovery_agent_version": {
+        "name": {
           "type": "string"
         },
-        "free_addresses": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "product": {
+          "type": "string"
         },
-        "href": {
-          "description": "Self link.",
+        "speed_mbps": {
+          "type": "integer"
+        },
+        "vendor": {
+          "type": "string"
+        }
+      }
+    },
+    "inventory": {
+      "type": "object",
+      "properties": {
+        "bmc_address": {
           "type": "string"
         },
-        "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key\""
-        },
-        "installer_version": {
-          "description": "Installer version",
+        "bmc_v6address": {
           "type": "string"
         },
-        "inventory": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
-        },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link.",
-          "type": "string",
-          "enum": [
-            "Host"
-          ]
+        "boot": {
+          "$ref": "#/definitions/boot"
         },
-        "progress": {
-          "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
-          "$ref": "#/definitions/host-progress-info"
+        "cpu": {
+          "$ref": "#/definitions/cpu"
         },
-        "progress_stages": {
+        "disks": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/host-stage"
-          },
-          "x-go-custom-tag": "gorm:\"-\""
+            "$ref": "#/definitions/disk"
+          }
         },
-        "requested_hostname": {
+        "hostname": {
           "type": "string"
         },
-        "role": {
-          "$ref": "#/definitions/host-role"
-        },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "interfaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/interface"
+          }
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "memory": {
+          "$ref": "#/definitions/memory"
         },
-        "status": {
-          "type": "string",
-          "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "preparing-for-installation",
-            "pending-for-input",
-            "installing",
-            "installing-in-progress",
-            "installing-pending-user-action",
-            "resetting-pending-user-action",
-            "installed",
-            "error",
-            "resetting"
-          ]
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
+        }
+      }
+    },
+    "l2-connectivity": {
+      "type": "object",
+      "properties": {
+        "outgoing_ip_address": {
+          "type": "string"
         },
-        "status_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "outgoing_nic": {
+          "type": "string"
         },
-        "status_updated_at": {
-          "description": "The last time that the host status has been updated",
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "remote_ip_address": {
+          "type": "string"
         },
-        "updated_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        "remote_mac": {
+          "type": "string"
         },
-        "validations_info": {
-          "description": "Json formatted string containing the validations results for each validation id grouped by category (network, hardware, etc.)",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "host-create-params": {
+    "l3-connectivity": {
       "type": "object",
-      "required": [
-        "host_id"
-      ],
       "properties": {
-        "discovery_agent_version": {
+        "outgoing_nic": {
           "type": "string"
         },
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "host-list": {
+    "list-managed-domains": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/host"
+        "$ref": "#/definitions/managed-domain"
       }
     },
-    "host-progress": {
+    "list-versions": {
       "type": "object",
-      "required": [
-        "current_stage"
-      ],
       "properties": {
-        "current_stage": {
-          "type": "string",
-          "$ref": "#/definitions/host-stage"
+        "release_tag": {
+          "type": "string"
         },
-        "progress_info": {
+        "versions": {
+          "$ref": "#/definitions/versions"
+        }
+      }
+    },
+    "managed-domain": {
+      "type": "object",
+      "properties": {
+        "domain": {
+          "type": "string"
+        },
+        "provider": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "enum": [
+            "route53"
+          ]
         }
       }
     },
-    "host-progress-info": {
+    "memory": {
       "type": "object",
-      "required": [
-        "current_stage"
-      ],
       "properties": {
-        "current_stage": {
+        "physical_bytes": {
+          "type": "integer"
+        },
+        "usable_bytes": {
+          "type": "integer"
+        }
+      }
+    },
+    "network-topology": {
+      "type": "object",
+      "properties": {
+        "networks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-network"
+          }
+        }
+      }
+    },
+    "network-topology-connectivity": {
+      "type": "object",
+      "properties": {
+        "l2_connected": {
+          "description": "The source host reached an address of the target host in the network over L2.",
+          "type": "boolean"
+        },
+        "l3_connected": {
+          "description": "The source host reached an address of the target host in the network over L3.",
+          "type": "boolean"
+        },
+        "reported_at": {
+          "description": "The last time the source host reported its connectivity to the other hosts.",
           "type": "string",
-          "$ref": "#/definitions/host-stage"
+          "format": "date-time"
         },
-        "progress_info": {
+        "source_host_id": {
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(2048)\""
+          "format": "uuid"
         },
-        "stage_started_at": {
-          "description": "Time at which the current progress stage started",
+        "status": {
+          "description": "Pending when the source host has no up to date connectivity report that checked the addresses of the target host in the network.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "enum": [
+            "success",
+            "failure",
+            "pending"
+          ]
         },
-        "stage_updated_at": {
-          "description": "Time at which the current progress stage was last updated",
+        "target_host_id": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+          "format": "uuid"
         }
       }
     },
-    "host-role": {
-      "type": "string",
-      "enum": [
-        "master",
-        "worker",
-        "bootstrap"
-      ]
-    },
-    "host-role-update-params": {
-      "type": "string",
-      "enum": [
-        "master",
-        "worker"
-      ]
-    },
-    "host-stage": {
-      "type": "string",
-      "enum": [
-        "Starting installation",
-        "Waiting for control plane",
-        "Start Waiting for control plane",
-        "Installing",
-        "Writing image to disk",
-        "Rebooting",
-        "Waiting for ignition",
-        "Configuring",
-        "Joined",
-        "Done",
-        "Failed"
-      ]
-    },
-    "host-validation-id": {
-      "type": "string",
-      "enum": [
-        "connected",
-        "has-inventory",
-        "has-min-cpu-cores",
-        "has-min-valid-disks",
-        "has-min-memory",
-        "machine-cidr-defined",
-        "role-defined",
-        "has-cpu-cores-for-role",
-        "has-memory-for-role",
-        "hostname-unique",
-        "hostname-valid",
-        "belongs-to-machine-cidr"
-      ]
-    },
-    "host_network": {
+    "network-topology-host": {
       "type": "object",
       "properties": {
-        "cidr": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
           "type": "string"
         },
-        "host_ids": {
+        "interfaces": {
+          "description": "The interfaces of the host with an address in the network.",
           "type": "array",
           "items": {
-            "type": "string",
-            "format": "uuid"
+            "$ref": "#/definitions/network-topology-interface"
           }
         }
       }
     },
-    "image-create-params": {
+    "network-topology-interface": {
       "type": "object",
       "properties": {
-        "proxy_url": {
-          "description": "The URL of the HTTP/S proxy that agents should use to access the discovery service\nhttp://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/\n",
-          "type": "string"
+        "has_carrier": {
+          "type": "boolean"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
+        "ip_address": {
+          "description": "The address of the interface in the network, with its prefix length.",
           "type": "string"
-        }
-      }
-    },
-    "image_info": {
-      "type": "object",
-      "properties": {
-        "created_at": {
-          "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
-        "generator_version": {
-          "description": "Image generator version",
+        "mac_address": {
           "type": "string"
         },
-        "proxy_url": {
-          "description": "The URL of the HTTP/S proxy that agents should use to access the discovery service\nhttp://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/\n",
+        "mtu": {
+          "type": "integer"
+        },
+        "name": {
           "type": "string"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+        "speed_mbps": {
+          "description": "The link speed of the interface, 0 if the NIC does not report it.",
+          "type": "integer"
         }
       }
     },
-    "ingress-cert-params": {
-      "type": "string"
-    },
-    "interface": {
+    "network-topology-network": {
       "type": "object",
       "properties": {
-        "biosdevname": {
-          "type": "string"
-        },
-        "client_id": {
+        "cidr": {
           "type": "string"
         },
-        "flags": {
+        "connectivity": {
+          "description": "The reachability over the network of every ordered pair of distinct hosts in it.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/network-topology-connectivity"
           }
         },
-        "has_carrier": {
-          "type": "boolean"
-        },
-        "ipv4_addresses": {
+        "hosts": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/network-topology-host"
           }
         },
-        "ipv6_addresses": {
+        "machine_network": {
+          "description": "Whether the network is one of the machine networks of the cluster.",
+          "type": "boolean"
+        }
+      }
+    },
+    "rebind-host-params": {
+      "type": "object",
+      "required": [
+        "new_cluster_id"
+      ],
+      "properties": {
+        "new_cluster_id": {
+          "description": "The cluster to move the host to.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "step": {
+      "type": "object",
+      "properties": {
+        "args": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "mac_address": {
+        "command": {
           "type": "string"
         },
-        "mtu": {
-          "type": "integer"
-        },
-        "name": {
+        "step_id": {
           "type": "string"
         },
-        "product": {
+        "step_type": {
+          "$ref": "#/definitions/step-type"
+        }
+      }
+    },
+    "step-reply": {
+      "type": "object",
+      "properties": {
+        "error": {
           "type": "string"
         },
-        "speed_mbps": {
+        "exit_code": {
           "type": "integer"
         },
-        "vendor": {
+        "output": {
+          "type": "string"
+        },
+        "step_id": {
           "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "inventory": {
+    "step-type": {
+      "type": "string",
+      "enum": [
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "install",
+        "free-network-addresses",
+        "reset-installation",
+        "logs-gather",
+        "container-image-availability",
+        "disk-speed-check",
+        "time-sync",
+        "upgrade-agent"
+      ]
+    },
+    "steps": {
       "type": "object",
       "properties": {
-        "bmc_address": {
+        "cluster_id": {
+          "description": "The cluster the host is bound to. When it differs from the cluster the steps were requested for, the host was moved to another cluster and the agent should use this cluster ID in all subsequent requests.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "instructions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/step"
+          }
+        },
+        "next_instruction_seconds": {
+          "type": "integer"
+        }
+      }
+    },
+    "steps-reply": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/step-reply"
+      }
+    },
+    "system_vendor": {
+      "type": "object",
+      "properties": {
+        "manufacturer": {
           "type": "string"
         },
-        "bmc_v6address": {
+        "product_name": {
           "type": "string"
         },
-        "boot": {
-          "$ref": "#/definitions/boot"
+        "serial_number": {
+          "type": "string"
+        }
+      }
+    },
+    "time-sync-response": {
+      "type": "object",
+      "properties": {
+        "offset": {
+          "description": "The offset of the host clock from the time source it is synchronized with, in seconds.",
+          "type": "number"
         },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
+        "reference_time": {
+          "description": "The time of the service clock when the step was sent to the host, as given in the step, in seconds since the epoch.",
+          "type": "number"
         },
-        "disks": {
+        "sources": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/disk"
+            "$ref": "#/definitions/time-sync-source"
           }
         },
-        "hostname": {
+        "time": {
+          "description": "The time of the host clock when the step was run, in seconds since the epoch.",
+          "type": "number"
+        }
+      }
+    },
+    "time-sync-source": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Hostname or IP address of the time source.",
           "type": "string"
         },
-        "interfaces": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/interface"
+        "state": {
+          "$ref": "#/definitions/time-sync-source-state"
+        },
+        "stratum": {
+          "type": "integer"
+        }
+      }
+    },
+    "time-sync-source-state": {
+      "type": "string",
+      "enum": [
+        "synced",
+        "combined",
+        "not-combined",
+        "unreachable",
+        "falseticker",
+        "variable",
+        "unknown"
+      ]
+    },
+    "versions": {
+      "type": "object",
+      "additionalProperties": {
+        "type": "string"
+      }
+    }
+  },
+  "tags": [
+    {
+      "description": "Assisted bare metal installation",
+      "name": "Assisted installation"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install/v1",
+  "paths": {
+    "/clusters": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the list of OpenShift bare metal clusters.",
+        "operationId": "ListClusters",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Creates a new OpenShift bare metal cluster definition.",
+        "operationId": "RegisterCluster",
+        "parameters": [
+          {
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "memory": {
-          "$ref": "#/definitions/memory"
-        },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
-        }
-      }
-    },
-    "l2-connectivity": {
-      "type": "object",
-      "properties": {
-        "outgoing_ip_address": {
-          "type": "string"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "remote_mac": {
-          "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
-        }
-      }
-    },
-    "l3-connectivity": {
-      "type": "object",
-      "properties": {
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
         }
       }
     },
-    "list-managed-domains": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/managed-domain"
-      }
-    },
-    "list-versions": {
-      "type": "object",
-      "properties": {
-        "release_tag": {
-          "type": "string"
-        },
-        "versions": {
-          "$ref": "#/definitions/versions"
+    "/clusters/{cluster_id}": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the details of the OpenShift bare metal cluster.",
+        "operationId": "GetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "managed-domain": {
-      "type": "object",
-      "properties": {
-        "domain": {
-          "type": "string"
-        },
-        "provider": {
-          "type": "string",
-          "enum": [
-            "route53"
-          ]
+      },
+      "delete": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Deletes an OpenShift bare metal cluster definition.",
+        "operationId": "DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "memory": {
-      "type": "object",
-      "properties": {
-        "physical_bytes": {
-          "type": "integer"
-        },
-        "usable_bytes": {
-          "type": "integer"
+      },
+      "patch": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Updates an OpenShift bare metal cluster definition.",
+        "operationId": "UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "step": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusters/{cluster_id}/actions/cancel": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Cancels an ongoing installation.",
+        "operationId": "CancelInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "command": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "step-reply": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
+    "/clusters/{cluster_id}/actions/complete_installation": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Agent API to mark a finalizing installation as complete.",
+        "operationId": "CompleteInstallation",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "completion-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/completion-params"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "step-type": {
-      "type": "string",
-      "enum": [
-        "connectivity-check",
-        "execute",
-        "inventory",
-        "install",
-        "free-network-addresses",
-        "reset-installation"
-      ]
-    },
-    "steps": {
-      "type": "object",
-      "properties": {
-        "instructions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/step"
+    "/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Installs the OpenShift bare metal cluster.",
+        "operationId": "InstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "next_instruction_seconds": {
-          "type": "integer"
         }
       }
     },
-    "steps-reply": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step-reply"
-      }
-    },
-    "system_vendor": {
-      "type": "object",
-      "properties": {
-        "manufacturer": {
-          "type": "string"
-        },
-        "product_name": {
-          "type": "string"
-        },
-        "serial_number": {
-          "type": "string"
+    "/clusters/{cluster_id}/actions/reset": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Resets a failed installation.",
+        "operationId": "ResetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "versions": {
-      "type": "object",
-      "additionalProperties": {
-        "type": "string"
-      }
-    }
-  },
-  "tags": [
-    {
-      "description": "Assisted bare metal installation",
-      "name": "Assisted installation"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install/v1",
-  "paths": {
-    "/clusters": {
+    "/clusters/{cluster_id}/connectivity": {
       "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the list of OpenShift bare metal clusters.",
-        "operationId": "ListClusters",
+        "summary": "Retrieves the connectivity matrix between the hosts of the cluster.",
+        "operationId": "GetClusterConnectivity",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "$ref": "#/definitions/connectivity-matrix"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -2560,31 +4532,38 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/clusters/{cluster_id}/credentials": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Creates a new OpenShift bare metal cluster definition.",
-        "operationId": "RegisterCluster",
+        "summary": "Get the the cluster admin credentials.",
+        "operationId": "GetCredentials",
         "parameters": [
           {
-            "name": "new-cluster-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-create-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/credentials"
             }
           },
-          "400": {
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -2599,13 +4578,16 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}": {
+    "/clusters/{cluster_id}/downloads/files": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the details of the OpenShift bare metal cluster.",
-        "operationId": "GetCluster",
+        "summary": "Downloads files relating to the installed/installing cluster.",
+        "operationId": "DownloadClusterFiles",
         "parameters": [
           {
             "type": "string",
@@ -2613,13 +4595,29 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig",
+              "kubeconfig-noingress",
+              "install-config.yaml"
+            ],
+            "type": "string",
+            "name": "file_name",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "file"
             }
           },
           "404": {
@@ -2628,6 +4626,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2635,13 +4639,18 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/clusters/{cluster_id}/downloads/image": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Deletes an OpenShift bare metal cluster definition.",
-        "operationId": "DeregisterCluster",
+        "summary": "Downloads the OpenShift per-cluster discovery ISO.",
+        "operationId": "DownloadClusterISO",
         "parameters": [
           {
             "type": "string",
@@ -2652,16 +4661,20 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
           },
-          "404": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -2675,12 +4688,12 @@ func init() {
           }
         }
       },
-      "patch": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Updates an OpenShift bare metal cluster definition.",
-        "operationId": "UpdateCluster",
+        "summary": "Creates a new OpenShift per-cluster discovery ISO.",
+        "operationId": "GenerateClusterISO",
         "parameters": [
           {
             "type": "string",
@@ -2690,11 +4703,11 @@ func init() {
             "required": true
           },
           {
-            "name": "cluster-update-params",
+            "name": "image-create-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-update-params"
+              "$ref": "#/definitions/image-create-params"
             }
           }
         ],
@@ -2732,13 +4745,16 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/cancel": {
-      "post": {
+    "/clusters/{cluster_id}/downloads/kubeconfig": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Cancels an ongoing installation.",
-        "operationId": "CancelInstallation",
+        "summary": "Downloads the kubeconfig file for this cluster.",
+        "operationId": "DownloadClusterKubeconfig",
         "parameters": [
           {
             "type": "string",
@@ -2749,10 +4765,11 @@ func init() {
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "type": "string",
+              "format": "binary"
             }
           },
           "404": {
@@ -2776,13 +4793,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/complete_installation": {
-      "post": {
+    "/clusters/{cluster_id}/free_addresses": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Agent API to mark a finalizing installation as complete.",
-        "operationId": "CompleteInstallation",
+        "summary": "Retrieves the free address list for a network.",
+        "operationId": "GetFreeAddresses",
         "parameters": [
           {
             "type": "string",
@@ -2792,19 +4809,31 @@ func init() {
             "required": true
           },
           {
-            "name": "completion-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/completion-params"
-            }
+            "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
+            "type": "string",
+            "name": "network",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 8000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8000,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
-          "202": {
-            "description": "Success.",
+          "200": {
+            "description": "Success",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/free-addresses-list"
             }
           },
           "404": {
@@ -2813,11 +4842,37 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the list of OpenShift bare metal hosts.",
+        "operationId": "ListHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-list"
+            }
           },
           "500": {
             "description": "Error.",
@@ -2826,15 +4881,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/actions/install": {
+      },
       "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Installs the OpenShift bare metal cluster.",
-        "operationId": "InstallCluster",
+        "summary": "Registers a new OpenShift bare metal host.",
+        "operationId": "RegisterHost",
         "parameters": [
           {
             "type": "string",
@@ -2842,13 +4895,21 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "new-host-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-create-params"
+            }
           }
         ],
         "responses": {
-          "202": {
+          "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -2857,13 +4918,13 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "404": {
+          "403": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -2878,13 +4939,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/reset": {
-      "post": {
+    "/clusters/{cluster_id}/hosts/{host_id}": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Resets a failed installation.",
-        "operationId": "ResetCluster",
+        "summary": "Retrieves the details of the OpenShift bare metal host.",
+        "operationId": "GetHost",
         "parameters": [
           {
             "type": "string",
@@ -2892,22 +4953,69 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "202": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Deregisters an OpenShift bare metal host.",
+        "operationId": "DeregisterHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
           },
-          "404": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -2922,13 +5030,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/credentials": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/debug": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Get the the cluster admin credentials.",
-        "operationId": "GetCredentials",
+        "summary": "Queues a single shot debug step that will be sent to the host agent when the previously queued debug steps are sent.",
+        "operationId": "SetDebugStep",
         "parameters": [
           {
             "type": "string",
@@ -2936,14 +5044,26 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "step",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/debug-step"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/credentials"
-            }
+          "204": {
+            "description": "Success."
           },
           "404": {
             "description": "Error.",
@@ -2951,12 +5071,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2966,16 +5080,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Downloads files relating to the installed/installing cluster.",
-        "operationId": "DownloadClusterFiles",
+        "summary": "Enables a host for inclusion in the cluster.",
+        "operationId": "EnableHost",
         "parameters": [
           {
             "type": "string",
@@ -2985,19 +5096,10 @@ func init() {
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig",
-              "kubeconfig-noingress",
-              "install-config.yaml"
-            ],
             "type": "string",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
             "required": true
           }
         ],
@@ -3005,7 +5107,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host"
             }
           },
           "404": {
@@ -3027,18 +5129,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/clusters/{cluster_id}/downloads/image": {
-      "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "delete": {
         "tags": [
           "installer"
         ],
-        "summary": "Downloads the OpenShift per-cluster discovery ISO.",
-        "operationId": "DownloadClusterISO",
+        "summary": "Disables a host for inclusion in the cluster.",
+        "operationId": "DisableHost",
         "parameters": [
           {
             "type": "string",
@@ -3046,23 +5143,29 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/host"
             }
           },
-          "400": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "404": {
+          "409": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -3075,13 +5178,15 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/rebind": {
       "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Creates a new OpenShift per-cluster discovery ISO.",
-        "operationId": "GenerateClusterISO",
+        "summary": "Moves a host that was not installed yet to another cluster of the same owner.",
+        "operationId": "RebindHost",
         "parameters": [
           {
             "type": "string",
@@ -3091,19 +5196,26 @@ func init() {
             "required": true
           },
           {
-            "name": "image-create-params",
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "rebind-host-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/image-create-params"
+              "$ref": "#/definitions/rebind-host-params"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -3133,16 +5245,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/kubeconfig": {
+    "/clusters/{cluster_id}/hosts/{host_id}/debug-steps": {
       "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
           "installer"
         ],
-        "summary": "Downloads the kubeconfig file for this cluster.",
-        "operationId": "DownloadClusterKubeconfig",
+        "summary": "Retrieves the debug steps of the host with their status and output.",
+        "operationId": "ListDebugSteps",
         "parameters": [
           {
             "type": "string",
@@ -3150,14 +5259,20 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/debug-step-result-list"
             }
           },
           "404": {
@@ -3166,12 +5281,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3181,13 +5290,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/free_addresses": {
+    "/clusters/{cluster_id}/hosts/{host_id}/debug-steps/{step_id}": {
       "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the free address list for a network.",
-        "operationId": "GetFreeAddresses",
+        "summary": "Retrieves a debug step of the host with its status and output.",
+        "operationId": "GetDebugStep",
         "parameters": [
           {
             "type": "string",
@@ -3197,31 +5306,24 @@ func init() {
             "required": true
           },
           {
-            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
             "type": "string",
-            "name": "network",
-            "in": "query",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
             "required": true
           },
-          {
-            "maximum": 8000,
-            "minimum": 1,
-            "type": "integer",
-            "default": 8000,
-            "name": "limit",
-            "in": "query"
-          },
           {
             "type": "string",
-            "name": "prefix",
-            "in": "query"
+            "name": "step_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success",
+            "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/free-addresses-list"
+              "$ref": "#/definitions/debug-step-result"
             }
           },
           "404": {
@@ -3239,13 +5341,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts": {
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
       "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the list of OpenShift bare metal hosts.",
-        "operationId": "ListHosts",
+        "summary": "Retrieves the next operations that the host agent needs to perform.",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
@@ -3253,13 +5355,34 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 60,
+            "minimum": 0,
+            "type": "integer",
+            "description": "Maximum time to hold the request until new steps are available for the host. When set, the reply may have no instructions, in which case the agent should ask again right away. Agents that do not set it get the steps immediately and ask again after next_instruction_seconds.",
+            "name": "wait_seconds",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/steps"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -3274,8 +5397,8 @@ func init() {
         "tags": [
           "installer"
         ],
-        "summary": "Registers a new OpenShift bare metal host.",
-        "operationId": "RegisterHost",
+        "summary": "Posts the result of the operations from the host agent.",
+        "operationId": "PostStepReply",
         "parameters": [
           {
             "type": "string",
@@ -3285,20 +5408,23 @@ func init() {
             "required": true
           },
           {
-            "name": "new-host-params",
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "reply",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/host-create-params"
+              "$ref": "#/definitions/step-reply"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
+          "204": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -3306,12 +5432,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "403": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -3327,13 +5447,16 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
+    "/clusters/{cluster_id}/hosts/{host_id}/logs": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the details of the OpenShift bare metal host.",
-        "operationId": "GetHost",
+        "summary": "Downloads the latest logs tarball uploaded by the host.",
+        "operationId": "DownloadHostLogs",
         "parameters": [
           {
             "type": "string",
@@ -3354,7 +5477,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "file"
             }
           },
           "404": {
@@ -3371,12 +5494,15 @@ func init() {
           }
         }
       },
-      "delete": {
+      "post": {
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
           "installer"
         ],
-        "summary": "Deregisters an OpenShift bare metal host.",
-        "operationId": "DeregisterHost",
+        "summary": "Agent API to upload a tarball with the agent, installer and journal logs of the host.",
+        "operationId": "UploadHostLogs",
         "parameters": [
           {
             "type": "string",
@@ -3391,20 +5517,27 @@ func init() {
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "file",
+            "description": "The logs tarball to upload.",
+            "name": "upfile",
+            "in": "formData",
+            "required": true
           }
         ],
         "responses": {
           "204": {
             "description": "Success."
           },
-          "400": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "404": {
-            "description": "Error.",
+          "413": {
+            "description": "The logs tarball is larger than the service accepts.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -3418,17 +5551,18 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/debug": {
-      "post": {
+    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
+      "put": {
         "tags": [
           "installer"
         ],
-        "summary": "Sets a single shot debug step that will be sent next time the host agent will ask for a command.",
-        "operationId": "SetDebugStep",
+        "summary": "Update installation progress",
+        "operationId": "UpdateHostInstallProgress",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
+            "description": "The ID of the cluster to retrieve",
             "name": "cluster_id",
             "in": "path",
             "required": true
@@ -3436,22 +5570,24 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
+            "description": "The ID of the host to retrieve",
             "name": "host_id",
             "in": "path",
             "required": true
           },
           {
-            "name": "step",
+            "description": "New progress value",
+            "name": "host-progress",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/debug-step"
+              "$ref": "#/definitions/host-progress"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Update install progress"
           },
           "404": {
             "description": "Error.",
@@ -3468,13 +5604,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
+    "/clusters/{cluster_id}/hosts/{host_id}/pull-secret": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Enables a host for inclusion in the cluster.",
-        "operationId": "EnableHost",
+        "summary": "Agent API to fetch the pull secret of the cluster for pulling the images the host needs.",
+        "operationId": "GetHostPullSecret",
         "parameters": [
           {
             "type": "string",
@@ -3489,22 +5625,29 @@ func init() {
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The cloud.openshift.com token of the pull secret that the agent was started with.",
+            "name": "X-Secret-Key",
+            "in": "header",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success.",
+            "description": "The pull secret of the cluster.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "type": "object"
             }
           },
-          "404": {
-            "description": "Error.",
+          "401": {
+            "description": "The key does not match the pull secret of the cluster.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -3517,13 +5660,15 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/steps": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Disables a host for inclusion in the cluster.",
-        "operationId": "DisableHost",
+        "summary": "Retrieves the latest steps that were sent to the host agent, with their replies.",
+        "operationId": "ListHostSteps",
         "parameters": [
           {
             "type": "string",
@@ -3544,7 +5689,7 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/host-step-list"
             }
           },
           "404": {
@@ -3553,12 +5698,6 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
-            "description": "Error.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3568,13 +5707,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
+    "/clusters/{cluster_id}/install-config": {
       "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the next operations that the host agent needs to perform.",
-        "operationId": "GetNextSteps",
+        "summary": "Renders the install config that the installation would generate for the cluster in its current state, with the pull secret and the SSH key redacted.",
+        "operationId": "GetClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
@@ -3582,20 +5721,13 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/install-config-preview"
             }
           },
           "404": {
@@ -3612,12 +5744,12 @@ func init() {
           }
         }
       },
-      "post": {
+      "patch": {
         "tags": [
           "installer"
         ],
-        "summary": "Posts the result of the operations from the host agent.",
-        "operationId": "PostStepReply",
+        "summary": "Override values in the install config.",
+        "operationId": "UpdateClusterInstallConfig",
         "parameters": [
           {
             "type": "string",
@@ -3627,22 +5759,16 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "reply",
+            "name": "install-config-params",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/step-reply"
+              "$ref": "#/definitions/install-config-params"
             }
           }
         ],
         "responses": {
-          "204": {
+          "201": {
             "description": "Success."
           },
           "400": {
@@ -3657,6 +5783,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -3666,43 +5798,28 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/progress": {
-      "put": {
+    "/clusters/{cluster_id}/network-topology": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Update installation progress",
-        "operationId": "UpdateHostInstallProgress",
+        "summary": "Retrieves the networks of the hosts of the cluster, with their interfaces and the reachability between the hosts over each network.",
+        "operationId": "GetClusterNetworkTopology",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve",
             "name": "cluster_id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The ID of the host to retrieve",
-            "name": "host_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "New progress value",
-            "name": "host-progress",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-progress"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Update install progress"
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/network-topology"
+            }
           },
           "404": {
             "description": "Error.",
@@ -3839,9 +5956,127 @@ func init() {
           }
         }
       }
+    },
+    "/hosts": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Searches the hosts of all the accessible clusters.",
+        "operationId": "SearchHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Comma-separated list of labels the host must have, each either as key or as key=value.",
+            "name": "labels",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "mac_address",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The system serial number of the host.",
+            "name": "serial_number",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "bmc_address",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A substring of the system manufacturer of the host.",
+            "name": "vendor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A substring of the system product name of the host.",
+            "name": "product",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "role",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host-search-result"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
     }
   },
   "definitions": {
+    "ClusterUpdateParamsHostsInstallationDisksItems0": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "installation_disk_id": {
+          "description": "The serial, WWN or by-path identifier of the disk. An empty value clears the selection.",
+          "type": "string"
+        }
+      }
+    },
+    "ClusterUpdateParamsHostsLabelsItems0": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "ClusterUpdateParamsHostsNamesItems0": {
       "type": "object",
       "properties": {
@@ -3854,6 +6089,18 @@ func init() {
         }
       }
     },
+    "ClusterUpdateParamsHostsNotesItems0": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "notes": {
+          "type": "string"
+        }
+      }
+    },
     "ClusterUpdateParamsHostsRolesItems0": {
       "type": "object",
       "properties": {
@@ -3866,6 +6113,45 @@ func init() {
         }
       }
     },
+    "agent-version-drift": {
+      "description": "The hosts of a cluster whose discovery agent is not the version of the service.",
+      "type": "object",
+      "properties": {
+        "compatible_host_ids": {
+          "description": "Hosts with an older but supported agent, which are being upgraded.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "expected_version": {
+          "description": "The discovery agent version of the service.",
+          "type": "string"
+        },
+        "minimum_version": {
+          "description": "The oldest discovery agent version that hosts can be installed with, empty when all versions are supported.",
+          "type": "string"
+        },
+        "unsupported_host_ids": {
+          "description": "Hosts with an agent older than the minimum version.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        }
+      }
+    },
+    "agent-version-status": {
+      "description": "How the discovery agent version of a host relates to the version of the service. Current agents are the expected version, compatible agents are older but supported and are upgraded, unsupported agents are older than the minimum version and the host cannot be installed until it is upgraded.",
+      "type": "string",
+      "enum": [
+        "current",
+        "compatible",
+        "unsupported"
+      ]
+    },
     "boot": {
       "type": "object",
       "properties": {
@@ -3888,10 +6174,18 @@ func init() {
         "status_info"
       ],
       "properties": {
+        "additional_machine_network_cidrs": {
+          "description": "Comma-separated list of the machine network CIDRs of the hosts that are not in machine_network_cidr, such as the subnets of the other racks of a routed network. The VIPs are in machine_network_cidr, so the masters must be in it.",
+          "type": "string"
+        },
+        "agent_version_drift": {
+          "x-go-custom-tag": "gorm:\"-\"",
+          "$ref": "#/definitions/agent-version-drift"
+        },
         "api_vip": {
           "description": "Virtual IP used to reach the OpenShift cluster API.",
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$"
         },
         "base_dns_domain": {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
@@ -3900,12 +6194,12 @@ func init() {
         "cluster_network_cidr": {
           "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
         },
         "cluster_network_host_prefix": {
           "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
           "type": "integer",
-          "maximum": 32,
+          "maximum": 128,
           "minimum": 1
         },
         "created_at": {
@@ -3935,6 +6229,14 @@ func init() {
           "description": "Self link.",
           "type": "string"
         },
+        "http_proxy": {
+          "description": "The URL of the proxy for the HTTP requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "The URL of the proxy for the HTTPS requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string"
+        },
         "id": {
           "description": "Unique identifier of the object.",
           "type": "string",
@@ -3951,7 +6253,7 @@ func init() {
         "ingress_vip": {
           "description": "Virtual IP used for cluster ingress traffic.",
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$"
         },
         "install_completed_at": {
           "description": "The time that this cluster completed installation.",
@@ -3959,6 +6261,11 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone;default:'2000-01-01 00:00:00z'\""
         },
+        "install_config_overrides": {
+          "description": "JSON merge patch that is applied on top of the generated install config of the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "install_started_at": {
           "description": "The time that this cluster began installation.",
           "type": "string",
@@ -3975,12 +6282,28 @@ func init() {
         "machine_network_cidr": {
           "description": "A CIDR that all hosts belonging to the cluster should have an interfaces with IP address that belongs to this CIDR. The api_vip belongs to this CIDR.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
         },
         "name": {
           "description": "Name of the OpenShift cluster.",
           "type": "string"
         },
+        "network_type": {
+          "description": "The cluster network provider of the OpenShift cluster, among the ones supported by its version. IPv6 and dual-stack networks require OVNKubernetes. Empty when it is not selected, the cluster is then installed with OVNKubernetes for IPv6 and dual-stack networks, otherwise with OpenShiftSDN.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ]
+        },
+        "no_proxy": {
+          "description": "Comma-separated list of the domains, IP addresses and CIDRs that are not accessed through the proxy. When a proxy is set, it must contain the machine, cluster and service networks and the API domains of the cluster, or '*'.",
+          "type": "string"
+        },
+        "ntp_servers": {
+          "description": "Comma-separated list of the NTP servers, hostnames or IP addresses, that the hosts synchronize their clocks with. When empty, the default servers of the operating system are used.",
+          "type": "string"
+        },
         "openshift_version": {
           "description": "Version of the OpenShift cluster.",
           "type": "string",
@@ -3995,10 +6318,31 @@ func init() {
           "description": "True if the pull-secret has been added to the cluster",
           "type": "boolean"
         },
+        "secondary_cluster_network_cidr": {
+          "description": "IP address block, of the other IP family than cluster_network_cidr, from which the Pod IPs of a dual-stack cluster are allocated. The cluster is dual-stack when it is set together with secondary_service_network_cidr.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node out of secondary_cluster_network_cidr. Defaults to 23 for IPv4 and 64 for IPv6.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 0
+        },
+        "secondary_machine_network_cidr": {
+          "description": "The CIDR, of the other IP family than machine_network_cidr, of the network that the hosts of a dual-stack cluster have on the interface of the machine network.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "secondary_service_network_cidr": {
+          "description": "The IP address pool, of the other IP family than service_network_cidr, to use for the service IP addresses of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
         },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
@@ -4037,6 +6381,19 @@ func init() {
         },
         "user_id": {
           "type": "string"
+        },
+        "validations_info": {
+          "description": "Json formatted string containing the cluster validations results for each validation id grouped by category (hosts-data, etc.)",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "vip_allocation": {
+          "description": "Whether the service allocates the API and Ingress VIPs out of the free addresses that the hosts discovered in the machine network, instead of the user.",
+          "type": "boolean"
+        },
+        "vip_exclusion_ranges": {
+          "description": "Comma-separated list of addresses, address ranges (first-last) or CIDRs of the machine network that the service does not allocate as VIPs, such as the pool of a DHCP server.",
+          "type": "string"
         }
       }
     },
@@ -4047,6 +6404,10 @@ func init() {
         "openshift_version"
       ],
       "properties": {
+        "additional_machine_network_cidrs": {
+          "description": "Comma-separated list of the machine network CIDRs of the hosts that are not in machine_network_cidr, such as the subnets of the other racks of a routed network. The VIPs are in machine_network_cidr, so the masters must be in it.",
+          "type": "string"
+        },
         "base_dns_domain": {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
           "type": "string"
@@ -4055,22 +6416,46 @@ func init() {
           "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
           "default": "10.128.0.0/14",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
         },
         "cluster_network_host_prefix": {
           "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
           "type": "integer",
           "default": 23,
-          "maximum": 32,
+          "maximum": 128,
           "minimum": 1
         },
+        "http_proxy": {
+          "description": "The URL of the proxy for the HTTP requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "The URL of the proxy for the HTTPS requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string"
+        },
         "ingress_vip": {
           "description": "Virtual IP used for cluster ingress traffic.",
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$"
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "network_type": {
+          "description": "The cluster network provider of the OpenShift cluster, among the ones supported by its version. IPv6 and dual-stack networks require OVNKubernetes. Defaults to OVNKubernetes for IPv6 and dual-stack networks, otherwise to OpenShiftSDN.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ]
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
+        "no_proxy": {
+          "description": "Comma-separated list of the domains, IP addresses and CIDRs that are not accessed through the proxy. When a proxy is set, it must contain the machine, cluster and service networks and the API domains of the cluster, or '*'.",
+          "type": "string"
+        },
+        "ntp_servers": {
+          "description": "Comma-separated list of the NTP servers, hostnames or IP addresses, that the hosts synchronize their clocks with. When empty, the default servers of the operating system are used.",
           "type": "string"
         },
         "openshift_version": {
@@ -4084,15 +6469,39 @@ func init() {
           "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
           "type": "string"
         },
+        "secondary_cluster_network_cidr": {
+          "description": "IP address block, of the other IP family than cluster_network_cidr, from which the Pod IPs of a dual-stack cluster are allocated. The cluster is dual-stack when it is set together with secondary_service_network_cidr.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node out of secondary_cluster_network_cidr. Defaults to 23 for IPv4 and 64 for IPv6.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 0
+        },
+        "secondary_service_network_cidr": {
+          "description": "The IP address pool, of the other IP family than service_network_cidr, to use for the service IP addresses of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
+        },
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
           "default": "172.30.0.0/16",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
         },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string"
+        },
+        "vip_allocation": {
+          "description": "Whether the service allocates the API and Ingress VIPs out of the free addresses that the hosts discovered in the machine network, instead of the user.",
+          "type": "boolean"
+        },
+        "vip_exclusion_ranges": {
+          "description": "Comma-separated list of addresses, address ranges (first-last) or CIDRs of the machine network that the service does not allocate as VIPs, such as the pool of a DHCP server.",
+          "type": "string"
         }
       }
     },
@@ -4105,10 +6514,15 @@ func init() {
     "cluster-update-params": {
       "type": "object",
       "properties": {
+        "additional_machine_network_cidrs": {
+          "description": "Comma-separated list of the machine network CIDRs of the hosts that are not in machine_network_cidr, such as the subnets of the other racks of a routed network. The VIPs are in machine_network_cidr, so the masters must be in it.",
+          "type": "string",
+          "x-nullable": true
+        },
         "api_vip": {
           "description": "Virtual IP used to reach the OpenShift cluster API.",
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$",
           "x-nullable": true
         },
         "base_dns_domain": {
@@ -4119,16 +6533,32 @@ func init() {
         "cluster_network_cidr": {
           "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
           "x-nullable": true
         },
         "cluster_network_host_prefix": {
           "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
           "type": "integer",
-          "maximum": 32,
+          "maximum": 128,
           "minimum": 1,
           "x-nullable": true
         },
+        "hosts_installation_disks": {
+          "description": "The desired installation disk for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClusterUpdateParamsHostsInstallationDisksItems0"
+          },
+          "x-nullable": true
+        },
+        "hosts_labels": {
+          "description": "The user-defined labels of hosts associated with the cluster, replacing their current labels.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClusterUpdateParamsHostsLabelsItems0"
+          },
+          "x-nullable": true
+        },
         "hosts_names": {
           "description": "The desired hostname for hosts associated with the cluster.",
           "type": "array",
@@ -4138,6 +6568,14 @@ func init() {
           "x-go-custom-tag": "gorm:\"type:varchar(64)[]\"",
           "x-nullable": true
         },
+        "hosts_notes": {
+          "description": "The user notes of hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClusterUpdateParamsHostsNotesItems0"
+          },
+          "x-nullable": true
+        },
         "hosts_roles": {
           "description": "The desired role for hosts associated with the cluster.",
           "type": "array",
@@ -4147,10 +6585,26 @@ func init() {
           "x-go-custom-tag": "gorm:\"type:varchar(64)[]\"",
           "x-nullable": true
         },
+        "http_proxy": {
+          "description": "The URL of the proxy for the HTTP requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string",
+          "x-nullable": true
+        },
+        "https_proxy": {
+          "description": "The URL of the proxy for the HTTPS requests of the hosts and of the installed cluster, http://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/",
+          "type": "string",
+          "x-nullable": true
+        },
         "ingress_vip": {
           "description": "Virtual IP used for cluster ingress traffic.",
           "type": "string",
-          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3})?$",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}|[0-9a-fA-F:]*:[0-9a-fA-F:]*)?$",
+          "x-nullable": true
+        },
+        "machine_network_cidr": {
+          "description": "The machine network, one of the host networks, that the VIPs are allocated in. It can be set only when vip_allocation is set, otherwise it is calculated from the VIPs.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
           "x-nullable": true
         },
         "name": {
@@ -4158,24 +6612,82 @@ func init() {
           "type": "string",
           "x-nullable": true
         },
+        "network_type": {
+          "description": "The cluster network provider of the OpenShift cluster, among the ones supported by its version. IPv6 and dual-stack networks require OVNKubernetes.",
+          "type": "string",
+          "enum": [
+            "OpenShiftSDN",
+            "OVNKubernetes"
+          ],
+          "x-nullable": true
+        },
+        "no_proxy": {
+          "description": "Comma-separated list of the domains, IP addresses and CIDRs that are not accessed through the proxy. When a proxy is set, it must contain the machine, cluster and service networks and the API domains of the cluster, or '*'.",
+          "type": "string",
+          "x-nullable": true
+        },
+        "ntp_servers": {
+          "description": "Comma-separated list of the NTP servers, hostnames or IP addresses, that the hosts synchronize their clocks with. When empty, the default servers of the operating system are used.",
+          "type": "string",
+          "x-nullable": true
+        },
         "pull_secret": {
           "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
           "type": "string",
           "x-nullable": true
         },
+        "secondary_cluster_network_cidr": {
+          "description": "IP address block, of the other IP family than cluster_network_cidr, from which the Pod IPs of a dual-stack cluster are allocated. The cluster is dual-stack when it is set together with secondary_service_network_cidr.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
+          "x-nullable": true
+        },
+        "secondary_cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node out of secondary_cluster_network_cidr. Defaults to 23 for IPv4 and 64 for IPv6.",
+          "type": "integer",
+          "maximum": 128,
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "secondary_service_network_cidr": {
+          "description": "The IP address pool, of the other IP family than service_network_cidr, to use for the service IP addresses of a dual-stack cluster.",
+          "type": "string",
+          "pattern": "^(|([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
+          "x-nullable": true
+        },
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$",
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$",
           "x-nullable": true
         },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
           "type": "string",
           "x-nullable": true
+        },
+        "vip_allocation": {
+          "description": "Whether the service allocates the API and Ingress VIPs out of the free addresses that the hosts discovered in the machine network, instead of the user.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "vip_exclusion_ranges": {
+          "description": "Comma-separated list of addresses, address ranges (first-last) or CIDRs of the machine network that the service does not allocate as VIPs, such as the pool of a DHCP server.",
+          "type": "string",
+          "x-nullable": true
         }
       }
     },
+    "cluster-validation-id": {
+      "type": "string",
+      "enum": [
+        "host-clocks-synced",
+        "vips-allocated",
+        "network-prefix-valid",
+        "no-cidrs-overlapping",
+        "proxy-valid"
+      ]
+    },
     "completion-params": {
       "type": "object",
       "required": [
@@ -4228,6 +6740,46 @@ func init() {
         "$ref": "#/definitions/connectivity-check-host"
       }
     },
+    "connectivity-matrix": {
+      "type": "object",
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-matrix-entry"
+          }
+        }
+      }
+    },
+    "connectivity-matrix-entry": {
+      "type": "object",
+      "properties": {
+        "l2_connected": {
+          "description": "The source host reached the target host over L2 within the machine network.",
+          "type": "boolean"
+        },
+        "l3_connected": {
+          "description": "The source host reached the target host over L3 within the machine network.",
+          "type": "boolean"
+        },
+        "source_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "pending"
+          ]
+        },
+        "target_host_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
     "connectivity-remote-host": {
       "type": "object",
       "properties": {
@@ -4260,6 +6812,40 @@ func init() {
         }
       }
     },
+    "container-image-availability": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "A fully qualified image name (FQIN).",
+          "type": "string"
+        },
+        "result": {
+          "$ref": "#/definitions/container-image-availability-result"
+        }
+      }
+    },
+    "container-image-availability-response": {
+      "type": "object",
+      "required": [
+        "images"
+      ],
+      "properties": {
+        "images": {
+          "description": "List of images that were checked.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/container-image-availability"
+          }
+        }
+      }
+    },
+    "container-image-availability-result": {
+      "type": "string",
+      "enum": [
+        "success",
+        "failure"
+      ]
+    },
     "cpu": {
       "type": "object",
       "properties": {
@@ -4305,9 +6891,92 @@ func init() {
       "properties": {
         "command": {
           "type": "string"
+        },
+        "timeout": {
+          "description": "Seconds after which the command is stopped, a default timeout is used when not set.",
+          "type": "integer",
+          "maximum": 86400,
+          "minimum": 0
+        }
+      }
+    },
+    "debug-step-result": {
+      "type": "object",
+      "required": [
+        "step_id",
+        "host_id",
+        "cluster_id",
+        "command",
+        "status"
+      ],
+      "properties": {
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "command": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "completed_at": {
+          "description": "The time the host agent replied or the step timed out.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "exit_code": {
+          "type": "integer"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "started_at": {
+          "description": "The time the step was sent to the host agent.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "queued",
+            "running",
+            "completed",
+            "failed",
+            "timed-out"
+          ]
+        },
+        "stderr": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "stdout": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "step_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "timeout": {
+          "description": "Seconds after which the command is stopped.",
+          "type": "integer"
         }
       }
     },
+    "debug-step-result-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/debug-step-result"
+      }
+    },
     "disk": {
       "type": "object",
       "properties": {
@@ -4343,6 +7012,52 @@ func init() {
         }
       }
     },
+    "disk-speed": {
+      "type": "object",
+      "properties": {
+        "attempts": {
+          "description": "Number of times the disk was checked, a failed check is repeated a limited number of times.",
+          "type": "integer"
+        },
+        "exit_code": {
+          "description": "Exit code of the check, the duration is valid only if the check succeeded.",
+          "type": "integer"
+        },
+        "io_sync_duration": {
+          "description": "The 99th percentile of the fsync duration of the disk in milliseconds.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "Path of the checked disk.",
+          "type": "string"
+        }
+      }
+    },
+    "disk-speed-check-request": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "description": "Path of the disk that is checked, e.g. /dev/sda.",
+          "type": "string"
+        }
+      }
+    },
+    "disk-speed-check-response": {
+      "type": "object",
+      "properties": {
+        "io_sync_duration": {
+          "description": "The 99th percentile of the fsync duration of the disk in milliseconds.",
+          "type": "integer"
+        },
+        "path": {
+          "description": "Path of the checked disk.",
+          "type": "string"
+        }
+      }
+    },
     "error": {
       "type": "object",
       "required": [
@@ -4430,15 +7145,14 @@ func init() {
     "free-addresses-list": {
       "type": "array",
       "items": {
-        "type": "string",
-        "format": "ipv4"
+        "type": "string"
       }
     },
     "free_addresses_request": {
       "type": "array",
       "items": {
         "type": "string",
-        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
       }
     },
     "free_network_addresses": {
@@ -4447,13 +7161,12 @@ func init() {
         "free_addresses": {
           "type": "array",
           "items": {
-            "type": "string",
-            "format": "ipv4"
+            "type": "string"
           }
         },
         "network": {
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^(([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$"
         }
       }
     },
@@ -4473,6 +7186,14 @@ func init() {
         "status_info"
       ],
       "properties": {
+        "agent_upgrade_attempts": {
+          "description": "The number of times the discovery agent of the host was asked to upgrade since it registered.",
+          "type": "integer"
+        },
+        "agent_version_status": {
+          "x-go-custom-tag": "gorm:\"-\"",
+          "$ref": "#/definitions/agent-version-status"
+        },
         "bootstrap": {
           "type": "boolean"
         },
@@ -4492,6 +7213,12 @@ func init() {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
         },
+        "connectivity_updated_at": {
+          "description": "The last time the host's agent reported its connectivity to the other hosts.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
         "created_at": {
           "type": "string",
           "format": "date-time",
@@ -4500,6 +7227,16 @@ func init() {
         "discovery_agent_version": {
           "type": "string"
         },
+        "disks_speed": {
+          "description": "JSON-formatted list of the fsync latency measurements of the host disks.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "duplicate_of": {
+          "description": "A host of the cluster that was registered before by the same machine, as identified by the system serial number and an installation disk serial or a MAC address of their inventories.",
+          "type": "string",
+          "format": "uuid"
+        },
         "free_addresses": {
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:text\""
@@ -4514,6 +7251,15 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primary_key\""
         },
+        "images_status": {
+          "description": "JSON-formatted list of the container images that were checked by the host and whether they could be pulled.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "installation_disk_id": {
+          "description": "The disk selected by the user for the installation, identified by its serial, WWN or by-path identifier. When empty, the installation disk is chosen automatically.",
+          "type": "string"
+        },
         "installer_version": {
           "description": "Installer version",
           "type": "string"
@@ -4525,9 +7271,41 @@ func init() {
         "kind": {
           "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link.",
           "type": "string",
-          "enum": [
-            "Host"
-          ]
+          "enum": [
+            "Host"
+          ]
+        },
+        "labels": {
+          "description": "JSON-formatted map of the user-defined labels of the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "logs_collected_at": {
+          "description": "The last time the host's agent uploaded the host logs.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "machine_network_cidr": {
+          "description": "The machine network of the cluster that the host belongs to, filled when the cluster is queried.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"-\""
+        },
+        "next_steps_at": {
+          "description": "The time at which the periodic steps of the host are due again.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "notes": {
+          "description": "Free-form user notes about the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "previous_cluster_id": {
+          "description": "The cluster the host was moved from by the last rebind operation.",
+          "type": "string",
+          "format": "uuid"
         },
         "progress": {
           "x-go-custom-tag": "gorm:\"embedded;embedded_prefix:progress_\"",
@@ -4587,6 +7365,17 @@ func init() {
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
         },
+        "steps_issued_at": {
+          "description": "The last time the periodic steps of the host were sent to its agent.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "time_sync": {
+          "description": "JSON-formatted clock offset of the host from the service and the time sources of the host.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "updated_at": {
           "type": "string",
           "format": "date-time",
@@ -4679,6 +7468,18 @@ func init() {
         "worker"
       ]
     },
+    "host-search-result": {
+      "type": "object",
+      "properties": {
+        "hosts": {
+          "$ref": "#/definitions/host-list"
+        },
+        "total_count": {
+          "description": "The number of hosts matching the search, regardless of pagination.",
+          "type": "integer"
+        }
+      }
+    },
     "host-stage": {
       "type": "string",
       "enum": [
@@ -4695,6 +7496,117 @@ func init() {
         "Failed"
       ]
     },
+    "host-static-network-config": {
+      "type": "object",
+      "required": [
+        "mac_address",
+        "network_yaml"
+      ],
+      "properties": {
+        "mac_address": {
+          "description": "MAC address of one of the interfaces of the host that the configuration belongs to.",
+          "type": "string"
+        },
+        "network_yaml": {
+          "description": "nmstate-style YAML with the interfaces, routes and DNS servers of the host.",
+          "type": "string"
+        }
+      }
+    },
+    "host-step": {
+      "type": "object",
+      "required": [
+        "step_id",
+        "host_id",
+        "cluster_id"
+      ],
+      "properties": {
+        "args": {
+          "description": "JSON-formatted list of the step arguments, with secrets redacted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "cluster_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "command": {
+          "type": "string"
+        },
+        "duration_ms": {
+          "description": "Milliseconds from issuing the step until its reply arrived.",
+          "type": "integer"
+        },
+        "error": {
+          "description": "Error of the step, with secrets redacted and truncated.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "exit_code": {
+          "type": "integer"
+        },
+        "host_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "issued_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone;index\""
+        },
+        "output": {
+          "description": "Output of the step, with secrets redacted and truncated.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "output_truncated": {
+          "type": "boolean"
+        },
+        "replied_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:timestamp with time zone\""
+        },
+        "step_id": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
+        }
+      }
+    },
+    "host-step-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host-step"
+      }
+    },
+    "host-time-sync": {
+      "type": "object",
+      "properties": {
+        "clock_offset_ms": {
+          "description": "The offset of the host clock from the service clock in milliseconds.",
+          "type": "integer"
+        },
+        "source_offset": {
+          "description": "The offset of the host clock from the time source it is synchronized with, in seconds.",
+          "type": "number"
+        },
+        "sources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/time-sync-source"
+          }
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "host-validation-id": {
       "type": "string",
       "enum": [
@@ -4709,7 +7621,16 @@ func init() {
         "has-memory-for-role",
         "hostname-unique",
         "hostname-valid",
-        "belongs-to-machine-cidr"
+        "belongs-to-machine-cidr",
+        "has-connectivity-to-all-hosts",
+        "has-disk-type-for-role",
+        "mtu-consistent",
+        "has-min-nic-speed",
+        "platform-uniform",
+        "valid-installation-disk",
+        "container-images-available",
+        "sufficient-installation-disk-speed",
+        "agent-version-supported"
       ]
     },
     "host_network": {
@@ -4731,12 +7652,19 @@ func init() {
       "type": "object",
       "properties": {
         "proxy_url": {
-          "description": "The URL of the HTTP/S proxy that agents should use to access the discovery service\nhttp://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/\n",
+          "description": "Deprecated, use the http_proxy and https_proxy of the cluster. The URL of the HTTP/S proxy that agents should\nuse to access the discovery service when the cluster has no proxy\nhttp://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/\n",
           "type": "string"
         },
         "ssh_public_key": {
           "description": "SSH public key for debugging the installation.",
           "type": "string"
+        },
+        "static_network_config": {
+          "description": "Static network configuration of the hosts, for machine networks without DHCP.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/host-static-network-config"
+          }
         }
       }
     },
@@ -4752,6 +7680,22 @@ func init() {
           "description": "Image generator version",
           "type": "string"
         },
+        "http_proxy": {
+          "description": "The HTTP proxy of the cluster when the image was generated.",
+          "type": "string"
+        },
+        "https_proxy": {
+          "description": "The HTTPS proxy of the cluster when the image was generated.",
+          "type": "string"
+        },
+        "no_proxy": {
+          "description": "The no-proxy list of the cluster when the image was generated.",
+          "type": "string"
+        },
+        "ntp_servers": {
+          "description": "The NTP servers of the cluster when the image was generated.",
+          "type": "string"
+        },
         "proxy_url": {
           "description": "The URL of the HTTP/S proxy that agents should use to access the discovery service\nhttp://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/\n",
           "type": "string"
@@ -4760,12 +7704,37 @@ func init() {
           "description": "SSH public key for debugging the installation",
           "type": "string",
           "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+        },
+        "static_network_config": {
+          "description": "JSON-formatted list of static network configurations of the hosts.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
     "ingress-cert-params": {
       "type": "string"
     },
+    "install-config-params": {
+      "description": "JSON merge patch, or the equivalent YAML document, applied on top of the generated install config. It must not change the values that the service computes, such as the networking, the hosts and the pull secret. Of the platform, only the OS images and the provisioning settings of the baremetal platform may be set, and of the compute pools only their hyperthreading. An empty document removes the overrides.",
+      "type": "string"
+    },
+    "install-config-preview": {
+      "type": "object",
+      "properties": {
+        "errors": {
+          "description": "The errors that would stop the generation of the install config when the installation starts.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "install_config": {
+          "description": "The install config in YAML, with the pull secret and the SSH key redacted. Empty when it cannot be generated.",
+          "type": "string"
+        }
+      }
+    },
     "interface": {
       "type": "object",
       "properties": {
@@ -4930,6 +7899,134 @@ func init() {
         }
       }
     },
+    "network-topology": {
+      "type": "object",
+      "properties": {
+        "networks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-network"
+          }
+        }
+      }
+    },
+    "network-topology-connectivity": {
+      "type": "object",
+      "properties": {
+        "l2_connected": {
+          "description": "The source host reached an address of the target host in the network over L2.",
+          "type": "boolean"
+        },
+        "l3_connected": {
+          "description": "The source host reached an address of the target host in the network over L3.",
+          "type": "boolean"
+        },
+        "reported_at": {
+          "description": "The last time the source host reported its connectivity to the other hosts.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "source_host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "description": "Pending when the source host has no up to date connectivity report that checked the addresses of the target host in the network.",
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "pending"
+          ]
+        },
+        "target_host_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "network-topology-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "interfaces": {
+          "description": "The interfaces of the host with an address in the network.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-interface"
+          }
+        }
+      }
+    },
+    "network-topology-interface": {
+      "type": "object",
+      "properties": {
+        "has_carrier": {
+          "type": "boolean"
+        },
+        "ip_address": {
+          "description": "The address of the interface in the network, with its prefix length.",
+          "type": "string"
+        },
+        "mac_address": {
+          "type": "string"
+        },
+        "mtu": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "speed_mbps": {
+          "description": "The link speed of the interface, 0 if the NIC does not report it.",
+          "type": "integer"
+        }
+      }
+    },
+    "network-topology-network": {
+      "type": "object",
+      "properties": {
+        "cidr": {
+          "type": "string"
+        },
+        "connectivity": {
+          "description": "The reachability over the network of every ordered pair of distinct hosts in it.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-connectivity"
+          }
+        },
+        "hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/network-topology-host"
+          }
+        },
+        "machine_network": {
+          "description": "Whether the network is one of the machine networks of the cluster.",
+          "type": "boolean"
+        }
+      }
+    },
+    "rebind-host-params": {
+      "type": "object",
+      "required": [
+        "new_cluster_id"
+      ],
+      "properties": {
+        "new_cluster_id": {
+          "description": "The cluster to move the host to.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
     "step": {
       "type": "object",
       "properties": {
@@ -4978,12 +8075,22 @@ func init() {
         "inventory",
         "install",
         "free-network-addresses",
-        "reset-installation"
+        "reset-installation",
+        "logs-gather",
+        "container-image-availability",
+        "disk-speed-check",
+        "time-sync",
+        "upgrade-agent"
       ]
     },
     "steps": {
       "type": "object",
       "properties": {
+        "cluster_id": {
+          "description": "The cluster the host is bound to. When it differs from the cluster the steps were requested for, the host was moved to another cluster and the agent should use this cluster ID in all subsequent requests.",
+          "type": "string",
+          "format": "uuid"
+        },
         "instructions": {
           "type": "array",
           "items": {
@@ -5015,6 +8122,56 @@ func init() {
         }
       }
     },
+    "time-sync-response": {
+      "type": "object",
+      "properties": {
+        "offset": {
+          "description": "The offset of the host clock from the time source it is synchronized with, in seconds.",
+          "type": "number"
+        },
+        "reference_time": {
+          "description": "The time of the service clock when the step was sent to the host, as given in the step, in seconds since the epoch.",
+          "type": "number"
+        },
+        "sources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/time-sync-source"
+          }
+        },
+        "time": {
+          "description": "The time of the host clock when the step was run, in seconds since the epoch.",
+          "type": "number"
+        }
+      }
+    },
+    "time-sync-source": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Hostname or IP address of the time source.",
+          "type": "string"
+        },
+        "state": {
+          "$ref": "#/definitions/time-sync-source-state"
+        },
+        "stratum": {
+          "type": "integer"
+        }
+      }
+    },
+    "time-sync-source-state": {
+      "type": "string",
+      "enum": [
+        "synced",
+        "combined",
+        "not-combined",
+        "unreachable",
+        "falseticker",
+        "variable",
+        "unknown"
+      ]
+    },
     "versions": {
       "type": "object",
       "additionalProperties": {
@@ -42,7 +42,8 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
-		JSONConsumer: runtime.JSONConsumer(),
+		JSONConsumer:          runtime.JSONConsumer(),
+		MultipartformConsumer: runtime.DiscardConsumer,
 
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
@@ -71,6 +72,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerDownloadClusterKubeconfigHandler: installer.DownloadClusterKubeconfigHandlerFunc(func(params installer.DownloadClusterKubeconfigParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DownloadClusterKubeconfig has not yet been implemented")
 		}),
+		InstallerDownloadHostLogsHandler: installer.DownloadHostLogsHandlerFunc(func(params installer.DownloadHostLogsParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DownloadHostLogs has not yet been implemented")
+		}),
 		InstallerEnableHostHandler: installer.EnableHostHandlerFunc(func(params installer.EnableHostParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.EnableHost has not yet been implemented")
 		}),
@@ -80,15 +84,30 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetClusterHandler: installer.GetClusterHandlerFunc(func(params installer.GetClusterParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetCluster has not yet been implemented")
 		}),
+		InstallerGetClusterConnectivityHandler: installer.GetClusterConnectivityHandlerFunc(func(params installer.GetClusterConnectivityParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterConnectivity has not yet been implemented")
+		}),
+		InstallerGetClusterInstallConfigHandler: installer.GetClusterInstallConfigHandlerFunc(func(params installer.GetClusterInstallConfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterInstallConfig has not yet been implemented")
+		}),
+		InstallerGetClusterNetworkTopologyHandler: installer.GetClusterNetworkTopologyHandlerFunc(func(params installer.GetClusterNetworkTopologyParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterNetworkTopology has not yet been implemented")
+		}),
 		InstallerGetCredentialsHandler: installer.GetCredentialsHandlerFunc(func(params installer.GetCredentialsParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetCredentials has not yet been implemented")
 		}),
+		InstallerGetDebugStepHandler: installer.GetDebugStepHandlerFunc(func(params installer.GetDebugStepParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetDebugStep has not yet been implemented")
+		}),
 		InstallerGetFreeAddressesHandler: installer.GetFreeAddressesHandlerFunc(func(params installer.GetFreeAddressesParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetFreeAddresses has not yet been implemented")
 		}),
 		InstallerGetHostHandler: installer.GetHostHandlerFunc(func(params installer.GetHostParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetHost has not yet been implemented")
 		}),
+		InstallerGetHostPullSecretHandler: installer.GetHostPullSecretHandlerFunc(func(params installer.GetHostPullSecretParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetHostPullSecret has not yet been implemented")
+		}),
 		InstallerGetNextStepsHandler: installer.GetNextStepsHandlerFunc(func(params installer.GetNextStepsParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetNextSteps has not yet been implemented")
 		}),
@@ -101,9 +120,15 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		VersionsListComponentVersionsHandler: versions.ListComponentVersionsHandlerFunc(func(params versions.ListComponentVersionsParams) middleware.Responder {
 			return middleware.NotImplemented("operation versions.ListComponentVersions has not yet been implemented")
 		}),
+		InstallerListDebugStepsHandler: installer.ListDebugStepsHandlerFunc(func(params installer.ListDebugStepsParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListDebugSteps has not yet been implemented")
+		}),
 		EventsListEventsHandler: events.ListEventsHandlerFunc(func(params events.ListEventsParams) middleware.Responder {
 			return middleware.NotImplemented("operation events.ListEvents has not yet been implemented")
 		}),
+		InstallerListHostStepsHandler: installer.ListHostStepsHandlerFunc(func(params installer.ListHostStepsParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListHostSteps has not yet been implemented")
+		}),
 		InstallerListHostsHandler: installer.ListHostsHandlerFunc(func(params installer.ListHostsParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListHosts has not yet been implemented")
 		}),
@@ -113,6 +138,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerPostStepReplyHandler: installer.PostStepReplyHandlerFunc(func(params installer.PostStepReplyParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.PostStepReply has not yet been implemented")
 		}),
+		InstallerRebindHostHandler: installer.RebindHostHandlerFunc(func(params installer.RebindHostParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.RebindHost has not yet been implemented")
+		}),
 		InstallerRegisterClusterHandler: installer.RegisterClusterHandlerFunc(func(params installer.RegisterClusterParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.RegisterCluster has not yet been implemented")
 		}),
@@ -122,18 +150,27 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerResetClusterHandler: installer.ResetClusterHandlerFunc(func(params installer.ResetClusterParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ResetCluster has not yet been implemented")
 		}),
+		InstallerSearchHostsHandler: installer.SearchHostsHandlerFunc(func(params installer.SearchHostsParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.SearchHosts has not yet been implemented")
+		}),
 		InstallerSetDebugStepHandler: installer.SetDebugStepHandlerFunc(func(params installer.SetDebugStepParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.SetDebugStep has not yet been implemented")
 		}),
 		InstallerUpdateClusterHandler: installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateCluster has not yet been implemented")
 		}),
+		InstallerUpdateClusterInstallConfigHandler: installer.UpdateClusterInstallConfigHandlerFunc(func(params installer.UpdateClusterInstallConfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UpdateClusterInstallConfig has not yet been implemented")
+		}),
 		InstallerUpdateHostInstallProgressHandler: installer.UpdateHostInstallProgressHandlerFunc(func(params installer.UpdateHostInstallProgressParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateHostInstallProgress has not yet been implemented")
 		}),
 		InstallerUploadClusterIngressCertHandler: installer.UploadClusterIngressCertHandlerFunc(func(params installer.UploadClusterIngressCertParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UploadClusterIngressCert has not yet been implemented")
 		}),
+		InstallerUploadHostLogsHandler: installer.UploadHostLogsHandlerFunc(func(params installer.UploadHostLogsParams) middleware.Responder {
+			return middleware.NotImplemented("operation installer.UploadHostLogs has not yet been implemented")
+		}),
 	}
 }
 
@@ -162,6 +199,9 @@ type AssistedInstallAPI struct {
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
 	JSONConsumer runtime.Consumer
+	// MultipartformConsumer registers a consumer for the following mime types:
+	//   - multipart/form-data
+	MultipartformConsumer runtime.Consumer
 
 	// BinProducer registers a producer for the following mime types:
 	//   - application/octet-stream
@@ -186,18 +226,30 @@ type AssistedInstallAPI struct {
 	InstallerDownloadClusterISOHandler installer.DownloadClusterISOHandler
 	// InstallerDownloadClusterKubeconfigHandler sets the operation handler for the download cluster kubeconfig operation
 	InstallerDownloadClusterKubeconfigHandler installer.DownloadClusterKubeconfigHandler
+	// InstallerDownloadHostLogsHandler sets the operation handler for the download host logs operation
+	InstallerDownloadHostLogsHandler installer.DownloadHostLogsHandler
 	// InstallerEnableHostHandler sets the operation handler for the enable host operation
 	InstallerEnableHostHandler installer.EnableHostHandler
 	// InstallerGenerateClusterISOHandler sets the operation handler for the generate cluster i s o operation
 	InstallerGenerateClusterISOHandler installer.GenerateClusterISOHandler
 	// InstallerGetClusterHandler sets the operation handler for the get cluster operation
 	InstallerGetClusterHandler installer.GetClusterHandler
+	// InstallerGetClusterConnectivityHandler sets the operation handler for the get cluster connectivity operation
+	InstallerGetClusterConnectivityHandler installer.GetClusterConnectivityHandler
+	// InstallerGetClusterInstallConfigHandler sets the operation handler for the get cluster install config operation
+	InstallerGetClusterInstallConfigHandler installer.GetClusterInstallConfigHandler
+	// InstallerGetClusterNetworkTopologyHandler sets the operation handler for the get cluster network topology operation
+	InstallerGetClusterNetworkTopologyHandler installer.GetClusterNetworkTopologyHandler
 	// InstallerGetCredentialsHandler sets the operation handler for the get credentials operation
 	InstallerGetCredentialsHandler installer.GetCredentialsHandler
+	// InstallerGetDebugStepHandler sets the operation handler for the get debug step operation
+	InstallerGetDebugStepHandler installer.GetDebugStepHandler
 	// InstallerGetFreeAddressesHandler sets the operation handler for the get free addresses operation
 	InstallerGetFreeAddressesHandler installer.GetFreeAddressesHandler
 	// InstallerGetHostHandler sets the operation handler for the get host operation
 	InstallerGetHostHandler installer.GetHostHandler
+	// InstallerGetHostPullSecretHandler sets the operation handler for the get host pull secret operation
+	InstallerGetHostPullSecretHandler installer.GetHostPullSecretHandler
 	// InstallerGetNextStepsHandler sets the operation handler for the get next steps operation
 	InstallerGetNextStepsHandler installer.GetNextStepsHandler
 	// InstallerInstallClusterHandler sets the operation handler for the install cluster operation
@@ -206,28 +258,40 @@ type AssistedInstallAPI struct {
 	InstallerListClustersHandler installer.ListClustersHandler
 	// VersionsListComponentVersionsHandler sets the operation handler for the list component versions operation
 	VersionsListComponentVersionsHandler versions.ListComponentVersionsHandler
+	// InstallerListDebugStepsHandler sets the operation handler for the list debug steps operation
+	InstallerListDebugStepsHandler installer.ListDebugStepsHandler
 	// EventsListEventsHandler sets the operation handler for the list events operation
 	EventsListEventsHandler events.ListEventsHandler
+	// InstallerListHostStepsHandler sets the operation handler for the list host steps operation
+	InstallerListHostStepsHandler installer.ListHostStepsHandler
 	// InstallerListHostsHandler sets the operation handler for the list hosts operation
 	InstallerListHostsHandler installer.ListHostsHandler
 	// ManagedDomainsListManagedDomainsHandler sets the operation handler for the list managed domains operation
 	ManagedDomainsListManagedDomainsHandler managed_domains.ListManagedDomainsHandler
 	// InstallerPostStepReplyHandler sets the operation handler for the post step reply operation
 	InstallerPostStepReplyHandler installer.PostStepReplyHandler
+	// InstallerRebindHostHandler sets the operation handler for the rebind host operation
+	InstallerRebindHostHandler installer.RebindHostHandler
 	// InstallerRegisterClusterHandler sets the operation handler for the register cluster operation
 	InstallerRegisterClusterHandler installer.RegisterClusterHandler
 	// InstallerRegisterHostHandler sets the operation handler for the register host operation
 	InstallerRegisterHostHandler installer.RegisterHostHandler
 	// InstallerResetClusterHandler sets the operation handler for the reset cluster operation
 	InstallerResetClusterHandler installer.ResetClusterHandler
+	// InstallerSearchHostsHandler sets the operation handler for the search hosts operation
+	InstallerSearchHostsHandler installer.SearchHostsHandler
 	// InstallerSetDebugStepHandler sets the operation handler for the set debug step operation
 	InstallerSetDebugStepHandler installer.SetDebugStepHandler
 	// InstallerUpdateClusterHandler sets the operation handler for the update cluster operation
 	InstallerUpdateClusterHandler installer.UpdateClusterHandler
+	// InstallerUpdateClusterInstallConfigHandler sets the operation handler for the update cluster install config operation
+	InstallerUpdateClusterInstallConfigHandler installer.UpdateClusterInstallConfigHandler
 	// InstallerUpdateHostInstallProgressHandler sets the operation handler for the update host install progress operation
 	InstallerUpdateHostInstallProgressHandler installer.UpdateHostInstallProgressHandler
 	// InstallerUploadClusterIngressCertHandler sets the operation handler for the upload cluster ingress cert operation
 	InstallerUploadClusterIngressCertHandler installer.UploadClusterIngressCertHandler
+	// InstallerUploadHostLogsHandler sets the operation handler for the upload host logs operation
+	InstallerUploadHostLogsHandler installer.UploadHostLogsHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -289,6 +353,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
+	if o.MultipartformConsumer == nil {
+		unregistered = append(unregistered, "MultipartformConsumer")
+	}
 
 	if o.BinProducer == nil {
 		unregistered = append(unregistered, "BinProducer")
@@ -321,6 +388,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerDownloadClusterKubeconfigHandler == nil {
 		unregistered = append(unregistered, "installer.DownloadClusterKubeconfigHandler")
 	}
+	if o.InstallerDownloadHostLogsHandler == nil {
+		unregistered = append(unregistered, "installer.DownloadHostLogsHandler")
+	}
 	if o.InstallerEnableHostHandler == nil {
 		unregistered = append(unregistered, "installer.EnableHostHandler")
 	}
@@ -330,15 +400,30 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterHandler")
 	}
+	if o.InstallerGetClusterConnectivityHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterConnectivityHandler")
+	}
+	if o.InstallerGetClusterInstallConfigHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterInstallConfigHandler")
+	}
+	if o.InstallerGetClusterNetworkTopologyHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterNetworkTopologyHandler")
+	}
 	if o.InstallerGetCredentialsHandler == nil {
 		unregistered = append(unregistered, "installer.GetCredentialsHandler")
 	}
+	if o.InstallerGetDebugStepHandler == nil {
+		unregistered = append(unregistered, "installer.GetDebugStepHandler")
+	}
 	if o.InstallerGetFreeAddressesHandler == nil {
 		unregistered = append(unregistered, "installer.GetFreeAddressesHandler")
 	}
 	if o.InstallerGetHostHandler == nil {
 		unregistered = append(unregistered, "installer.GetHostHandler")
 	}
+	if o.InstallerGetHostPullSecretHandler == nil {
+		unregistered = append(unregistered, "installer.GetHostPullSecretHandler")
+	}
 	if o.InstallerGetNextStepsHandler == nil {
 		unregistered = append(unregistered, "installer.GetNextStepsHandler")
 	}
@@ -351,9 +436,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.VersionsListComponentVersionsHandler == nil {
 		unregistered = append(unregistered, "versions.ListComponentVersionsHandler")
 	}
+	if o.InstallerListDebugStepsHandler == nil {
+		unregistered = append(unregistered, "installer.ListDebugStepsHandler")
+	}
 	if o.EventsListEventsHandler == nil {
 		unregistered = append(unregistered, "events.ListEventsHandler")
 	}
+	if o.InstallerListHostStepsHandler == nil {
+		unregistered = append(unregistered, "installer.ListHostStepsHandler")
+	}
 	if o.InstallerListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.ListHostsHandler")
 	}
@@ -363,6 +454,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerPostStepReplyHandler == nil {
 		unregistered = append(unregistered, "installer.PostStepReplyHandler")
 	}
+	if o.InstallerRebindHostHandler == nil {
+		unregistered = append(unregistered, "installer.RebindHostHandler")
+	}
 	if o.InstallerRegisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.RegisterClusterHandler")
 	}
@@ -372,18 +466,27 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerResetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.ResetClusterHandler")
 	}
+	if o.InstallerSearchHostsHandler == nil {
+		unregistered = append(unregistered, "installer.SearchHostsHandler")
+	}
 	if o.InstallerSetDebugStepHandler == nil {
 		unregistered = append(unregistered, "installer.SetDebugStepHandler")
 	}
 	if o.InstallerUpdateClusterHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateClusterHandler")
 	}
+	if o.InstallerUpdateClusterInstallConfigHandler == nil {
+		unregistered = append(unregistered, "installer.UpdateClusterInstallConfigHandler")
+	}
 	if o.InstallerUpdateHostInstallProgressHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateHostInstallProgressHandler")
 	}
 	if o.InstallerUploadClusterIngressCertHandler == nil {
 		unregistered = append(unregistered, "installer.UploadClusterIngressCertHandler")
 	}
+	if o.InstallerUploadHostLogsHandler == nil {
+		unregistered = append(unregistered, "installer.UploadHostLogsHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -415,6 +518,8 @@ func (o *AssistedInstallAPI) ConsumersFor(mediaTypes []string) map[string]runtim
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "multipart/form-data":
+			result["multipart/form-data"] = o.MultipartformConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -506,6 +611,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/clusters/{cluster_id}/downloads/kubeconfig"] = installer.NewDownloadClusterKubeconfig(o.context, o.InstallerDownloadClusterKubeconfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/logs"] = installer.NewDownloadHostLogs(o.context, o.InstallerDownloadHostLogsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -521,10 +630,26 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/connectivity"] = installer.NewGetClusterConnectivity(o.context, o.InstallerGetClusterConnectivityHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/install-config"] = installer.NewGetClusterInstallConfig(o.context, o.InstallerGetClusterInstallConfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/network-topology"] = installer.NewGetClusterNetworkTopology(o.context, o.InstallerGetClusterNetworkTopologyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/credentials"] = installer.NewGetCredentials(o.context, o.InstallerGetCredentialsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/debug-steps/{step_id}"] = installer.NewGetDebugStep(o.context, o.InstallerGetDebugStepHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/free_addresses"] = installer.NewGetFreeAddresses(o.context, o.InstallerGetFreeAddressesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -533,6 +658,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/pull-secret"] = installer.NewGetHostPullSecret(o.context, o.InstallerGetHostPullSecretHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/instructions"] = installer.NewGetNextSteps(o.context, o.InstallerGetNextStepsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -549,10 +678,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/debug-steps"] = installer.NewListDebugSteps(o.context, o.InstallerListDebugStepsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/events/{entity_id}"] = events.NewListEvents(o.context, o.EventsListEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}/steps"] = installer.NewListHostSteps(o.context, o.InstallerListHostStepsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/hosts"] = installer.NewListHosts(o.context, o.InstallerListHostsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -565,6 +702,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/clusters/{cluster_id}/hosts/{host_id}/actions/rebind"] = installer.NewRebindHost(o.context, o.InstallerRebindHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters"] = installer.NewRegisterCluster(o.context, o.InstallerRegisterClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -574,6 +715,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/actions/reset"] = installer.NewResetCluster(o.context, o.InstallerResetClusterHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/hosts"] = installer.NewSearchHosts(o.context, o.InstallerSearchHostsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -582,6 +727,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/clusters/{cluster_id}"] = installer.NewUpdateCluster(o.context, o.InstallerUpdateClusterHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/clusters/{cluster_id}/install-config"] = installer.NewUpdateClusterInstallConfig(o.context, o.InstallerUpdateClusterInstallConfigHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -590,6 +739,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/clusters/{cluster_id}/uploads/ingress-cert"] = installer.NewUploadClusterIngressCert(o.context, o.InstallerUploadClusterIngressCertHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusters/{cluster_id}/hosts/{host_id}/logs"] = installer.NewUploadHostLogs(o.context, o.InstallerUploadHostLogsHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -54,7 +54,7 @@ type GetFreeAddressesParams struct {
 	Limit *int64
 	/*
 	  Required: true
-	  Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	  Pattern: ^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$
 	  In: query
 	*/
 	Network string
@@ -203,7 +203,7 @@ func (o *GetFreeAddressesParams) bindNetwork(rawData []string, hasKey bool, form
 // validateNetwork carries on validations for parameter Network
 func (o *GetFreeAddressesParams) validateNetwork(formats strfmt.Registry) error {
 
-	if err := validate.Pattern("network", "query", o.Network, `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("network", "query", o.Network, `^(([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])|[0-9a-fA-F:]*:[0-9a-fA-F:]*\/([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8]))$`); err != nil {
 		return err
 	}
 
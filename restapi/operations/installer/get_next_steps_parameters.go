@@ -9,8 +9,10 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
@@ -40,6 +42,12 @@ type GetNextStepsParams struct {
 	  In: path
 	*/
 	HostID strfmt.UUID
+	/*Maximum time to hold the request until new steps are available for the host. When set, the reply may have no instructions, in which case the agent should ask again right away. Agents that do not set it get the steps immediately and ask again after next_instruction_seconds.
+	  Maximum: 60
+	  Minimum: 0
+	  In: query
+	*/
+	WaitSeconds *int64
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -51,6 +59,8 @@ func (o *GetNextStepsParams) BindRequest(r *http.Request, route *middleware.Matc
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
@@ -61,6 +71,11 @@ func (o *GetNextStepsParams) BindRequest(r *http.Request, route *middleware.Matc
 		res = append(res, err)
 	}
 
+	qWaitSeconds, qhkWaitSeconds, _ := qs.GetOK("wait_seconds")
+	if err := o.bindWaitSeconds(qWaitSeconds, qhkWaitSeconds, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -132,3 +147,43 @@ func (o *GetNextStepsParams) validateHostID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindWaitSeconds binds and validates parameter WaitSeconds from query.
+func (o *GetNextStepsParams) bindWaitSeconds(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("wait_seconds", "query", "int64", raw)
+	}
+	o.WaitSeconds = &value
+
+	if err := o.validateWaitSeconds(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateWaitSeconds carries on validations for parameter WaitSeconds
+func (o *GetNextStepsParams) validateWaitSeconds(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("wait_seconds", "query", int64(*o.WaitSeconds), 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("wait_seconds", "query", int64(*o.WaitSeconds), 60, false); err != nil {
+		return err
+	}
+
+	return nil
+}
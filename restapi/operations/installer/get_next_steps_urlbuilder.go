@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // GetNextStepsURL generates an URL for the get next steps operation
@@ -19,6 +20,8 @@ type GetNextStepsURL struct {
 	ClusterID strfmt.UUID
 	HostID    strfmt.UUID
 
+	WaitSeconds *int64
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -65,6 +68,18 @@ func (o *GetNextStepsURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var waitSecondsQ string
+	if o.WaitSeconds != nil {
+		waitSecondsQ = swag.FormatInt64(*o.WaitSeconds)
+	}
+	if waitSecondsQ != "" {
+		qs.Set("wait_seconds", waitSecondsQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -31,7 +31,7 @@ func NewSetDebugStep(ctx *middleware.Context, handler SetDebugStepHandler) *SetD
 
 /*SetDebugStep swagger:route POST /clusters/{cluster_id}/hosts/{host_id}/actions/debug installer setDebugStep
 
-Sets a single shot debug step that will be sent next time the host agent will ask for a command.
+Queues a single shot debug step that will be sent to the host agent when the previously queued debug steps are sent.
 
 */
 type SetDebugStep struct {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/filanov/bm-inventory/internal/bminventory"
 	"github.com/filanov/bm-inventory/internal/host"
+	"github.com/filanov/bm-inventory/internal/network"
 
 	"github.com/alecthomas/units"
 	"github.com/go-openapi/strfmt"
@@ -43,7 +44,7 @@ var (
 		Memory: &models.Memory{PhysicalBytes: int64(32 * units.GiB)},
 		Disks: []*models.Disk{
 			{DriveType: "SSD", Name: "loop0", SizeBytes: validDiskSize},
-			{DriveType: "HDD", Name: "sdb", SizeBytes: validDiskSize}},
+			{DriveType: "SSD", Name: "sdb", SizeBytes: validDiskSize, Serial: "sdb-serial", Wwn: "0x5000c500a0b1c2d3"}},
 		Interfaces: []*models.Interface{
 			{
 				IPV4Addresses: []string{
@@ -56,7 +57,7 @@ var (
 	validFreeAddresses = models.FreeNetworksAddresses{
 		{
 			Network: "1.2.3.0/24",
-			FreeAddresses: []strfmt.IPv4{
+			FreeAddresses: []string{
 				"1.2.3.8",
 				"1.2.3.9",
 				"1.2.3.5",
@@ -116,6 +117,230 @@ var _ = Describe("Cluster tests", func() {
 		Expect(err).Should(HaveOccurred())
 	})
 
+	It("cluster NTP servers", func() {
+		c, err := bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{NtpServers: swag.String("clock.example.com, 10.0.0.1")},
+			ClusterID:           clusterID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.GetPayload().NtpServers).Should(Equal("clock.example.com,10.0.0.1"))
+
+		_, err = bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{NtpServers: swag.String("clock_1.example.com")},
+			ClusterID:           clusterID,
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewUpdateClusterBadRequest()))
+
+		_, err = bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("test-cluster"),
+				OpenshiftVersion: swag.String("4.5"),
+				NtpServers:       "ntp://clock.example.com",
+			},
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewRegisterClusterBadRequest()))
+	})
+
+	It("cluster dual-stack networks", func() {
+		c, err := bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:                        swag.String("test-cluster"),
+				OpenshiftVersion:            swag.String("4.5"),
+				SecondaryClusterNetworkCidr: "fd01::/48",
+				SecondaryServiceNetworkCidr: "fd02::/112",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.GetPayload().SecondaryClusterNetworkCidr).Should(Equal("fd01::/48"))
+		Expect(c.GetPayload().SecondaryClusterNetworkHostPrefix).Should(Equal(int64(64)))
+		Expect(c.GetPayload().SecondaryServiceNetworkCidr).Should(Equal("fd02::/112"))
+		Expect(c.GetPayload().NetworkType).Should(BeEmpty())
+		Expect(network.GetNetworkType(c.GetPayload())).Should(Equal(models.ClusterNetworkTypeOVNKubernetes))
+
+		_, err = bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:                        swag.String("test-cluster"),
+				OpenshiftVersion:            swag.String("4.5"),
+				SecondaryClusterNetworkCidr: "10.200.0.0/14",
+				SecondaryServiceNetworkCidr: "172.31.0.0/16",
+			},
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewRegisterClusterBadRequest()))
+
+		_, err = bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{ServiceNetworkCidr: swag.String("fd02::/112")},
+			ClusterID:           clusterID,
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewUpdateClusterBadRequest()))
+	})
+
+	It("cluster install config overrides", func() {
+		_, err := bmclient.Installer.UpdateClusterInstallConfig(ctx, &installer.UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: `{"fips":true}`,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		c, err := bmclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.GetPayload().InstallConfigOverrides).Should(Equal(`{"fips":true}`))
+
+		_, err = bmclient.Installer.UpdateClusterInstallConfig(ctx, &installer.UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: `{"pullSecret":"{}"}`,
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewUpdateClusterInstallConfigBadRequest()))
+	})
+
+	It("cluster install config preview", func() {
+		reply, err := bmclient.Installer.GetClusterInstallConfig(ctx, &installer.GetClusterInstallConfigParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reply.GetPayload().Errors).NotTo(BeEmpty())
+		Expect(reply.GetPayload().InstallConfig).NotTo(ContainSubstring(pullSecret))
+
+		_, err = bmclient.Installer.GetClusterInstallConfig(ctx, &installer.GetClusterInstallConfigParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewGetClusterInstallConfigNotFound()))
+	})
+
+	It("cluster network type", func() {
+		c, err := bmclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.GetPayload().NetworkType).Should(BeEmpty())
+		Expect(network.GetNetworkType(c.GetPayload())).Should(Equal(models.ClusterNetworkTypeOpenShiftSDN))
+
+		u, err := bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{NetworkType: swag.String(models.ClusterUpdateParamsNetworkTypeOVNKubernetes)},
+			ClusterID:           clusterID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u.GetPayload().NetworkType).Should(Equal(models.ClusterNetworkTypeOVNKubernetes))
+
+		_, err = bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:                        swag.String("test-cluster"),
+				OpenshiftVersion:            swag.String("4.5"),
+				SecondaryClusterNetworkCidr: "fd01::/48",
+				SecondaryServiceNetworkCidr: "fd02::/112",
+				NetworkType:                 models.ClusterCreateParamsNetworkTypeOpenShiftSDN,
+			},
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewRegisterClusterBadRequest()))
+	})
+
+	It("cluster network overlaps", func() {
+		_, err := bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:               swag.String("test-cluster"),
+				OpenshiftVersion:   swag.String("4.5"),
+				ServiceNetworkCidr: swag.String("10.130.0.0/16"),
+			},
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewRegisterClusterBadRequest()))
+
+		_, err = bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:                     swag.String("test-cluster"),
+				OpenshiftVersion:         swag.String("4.5"),
+				ClusterNetworkCidr:       swag.String("10.128.0.0/22"),
+				ClusterNetworkHostPrefix: 23,
+			},
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewRegisterClusterBadRequest()))
+
+		_, err = bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{ServiceNetworkCidr: swag.String("10.128.0.0/16")},
+			ClusterID:           clusterID,
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewUpdateClusterBadRequest()))
+	})
+
+	It("cluster proxy", func() {
+		c, err := bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("test-cluster"),
+				OpenshiftVersion: swag.String("4.5"),
+				HTTPProxy:        "http://proxy.example.com:3128",
+				NoProxy:          ".example.com, 10.0.0.0/8",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.GetPayload().HTTPProxy).Should(Equal("http://proxy.example.com:3128"))
+		Expect(c.GetPayload().NoProxy).Should(Equal(".example.com,10.0.0.0/8"))
+
+		_, err = bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("test-cluster"),
+				OpenshiftVersion: swag.String("4.5"),
+				HTTPSProxy:       "proxy.example.com:3128",
+			},
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewRegisterClusterBadRequest()))
+
+		_, err = bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{NoProxy: swag.String("*,.example.com")},
+			ClusterID:           clusterID,
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewUpdateClusterBadRequest()))
+	})
+
+	It("additional machine networks", func() {
+		c, err := bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:                          swag.String("test-cluster"),
+				OpenshiftVersion:              swag.String("4.5"),
+				AdditionalMachineNetworkCidrs: "1.2.4.0/24, 1.2.5.0/24",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.GetPayload().AdditionalMachineNetworkCidrs).Should(Equal("1.2.4.0/24,1.2.5.0/24"))
+
+		_, err = bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:                          swag.String("test-cluster"),
+				OpenshiftVersion:              swag.String("4.5"),
+				AdditionalMachineNetworkCidrs: "1.2.4.0/24,1.2.4.128/25",
+			},
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewRegisterClusterBadRequest()))
+
+		_, err = bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{AdditionalMachineNetworkCidrs: swag.String("rack2")},
+			ClusterID:           clusterID,
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewUpdateClusterBadRequest()))
+	})
+
+	It("cluster VIP allocation", func() {
+		c, err := bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:               swag.String("test-cluster"),
+				OpenshiftVersion:   swag.String("4.5"),
+				VipAllocation:      true,
+				VipExclusionRanges: "10.0.0.0/24, 10.0.1.10-10.0.1.20",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.GetPayload().VipAllocation).Should(BeTrue())
+		Expect(c.GetPayload().VipExclusionRanges).Should(Equal("10.0.0.0/24, 10.0.1.10-10.0.1.20"))
+
+		_, err = bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("test-cluster"),
+				OpenshiftVersion: swag.String("4.5"),
+				VipAllocation:    true,
+				IngressVip:       "10.0.1.5",
+			},
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewRegisterClusterBadRequest()))
+
+		_, err = bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{VipExclusionRanges: swag.String("10.0.1.20-10.0.1.10")},
+			ClusterID:           clusterID,
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewUpdateClusterBadRequest()))
+	})
+
 	It("cluster update", func() {
 		host1 := registerHost(clusterID)
 		host2 := registerHost(clusterID)
@@ -304,6 +529,10 @@ var _ = Describe("cluster install", func() {
 			ClusterID: clusterID,
 		})
 		Expect(err).ShouldNot(HaveOccurred())
+		generateFullMeshConnectivity(ctx, clusterID, "1.2.3.10")
+		generateImagesAvailability(ctx, clusterID)
+		generateDisksSpeed(ctx, clusterID)
+		generateTimeSync(ctx, clusterID)
 		return []*models.Host{h1, h2, h3}
 	}
 
@@ -1011,6 +1240,10 @@ var _ = Describe("cluster install", func() {
 				h := registerHost(clusterID)
 				generateHWPostStepReply(h, validHwInfo, "hostname")
 				generateFAPostStepReply(h, validFreeAddresses)
+				generateFullMeshConnectivity(ctx, clusterID, "1.2.3.10")
+				generateImagesAvailability(ctx, clusterID)
+				generateDisksSpeed(ctx, clusterID)
+				generateTimeSync(ctx, clusterID)
 				_, err = bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
 					ClusterUpdateParams: &models.ClusterUpdateParams{HostsRoles: []*models.ClusterUpdateParamsHostsRolesItems0{
 						{ID: *h.ID, Role: models.HostRoleUpdateParamsMaster},
@@ -1225,6 +1458,67 @@ var _ = Describe("cluster install", func() {
 
 	})
 
+	It("[only_k8s]cluster connectivity matrix", func() {
+		clusterID := *cluster.ID
+		register3nodes(clusterID)
+
+		reply, err := bmclient.Installer.GetClusterConnectivity(ctx, &installer.GetClusterConnectivityParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		matrix := reply.GetPayload()
+		Expect(matrix.Entries).To(HaveLen(6))
+		for _, entry := range matrix.Entries {
+			Expect(entry.Status).To(Equal(models.ConnectivityMatrixEntryStatusSuccess))
+			Expect(entry.SourceHostID).NotTo(Equal(entry.TargetHostID))
+		}
+
+		By("New host is pending until it is covered by the connectivity reports")
+		h4 := registerHost(clusterID)
+		generateHWPostStepReply(h4, validHwInfo, "h4")
+		reply, err = bmclient.Installer.GetClusterConnectivity(ctx, &installer.GetClusterConnectivityParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		matrix = reply.GetPayload()
+		Expect(matrix.Entries).To(HaveLen(12))
+		for _, entry := range matrix.Entries {
+			if entry.SourceHostID == *h4.ID || entry.TargetHostID == *h4.ID {
+				Expect(entry.Status).To(Equal(models.ConnectivityMatrixEntryStatusPending))
+			} else {
+				Expect(entry.Status).To(Equal(models.ConnectivityMatrixEntryStatusSuccess))
+			}
+		}
+
+		generateFullMeshConnectivity(ctx, clusterID, "1.2.3.10")
+		generateImagesAvailability(ctx, clusterID)
+		generateDisksSpeed(ctx, clusterID)
+		generateTimeSync(ctx, clusterID)
+		reply, err = bmclient.Installer.GetClusterConnectivity(ctx, &installer.GetClusterConnectivityParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		for _, entry := range reply.GetPayload().Entries {
+			Expect(entry.Status).To(Equal(models.ConnectivityMatrixEntryStatusSuccess))
+		}
+	})
+
+	It("[only_k8s]cluster network topology", func() {
+		clusterID := *cluster.ID
+		register3nodes(clusterID)
+
+		reply, err := bmclient.Installer.GetClusterNetworkTopology(ctx, &installer.GetClusterNetworkTopologyParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		var machineNetwork *models.NetworkTopologyNetwork
+		for _, n := range reply.GetPayload().Networks {
+			if n.MachineNetwork {
+				machineNetwork = n
+			}
+		}
+		Expect(machineNetwork).NotTo(BeNil())
+		Expect(machineNetwork.Cidr).To(Equal("1.2.3.0/24"))
+		Expect(machineNetwork.Hosts).To(HaveLen(3))
+		Expect(machineNetwork.Connectivity).To(HaveLen(6))
+		for _, c := range machineNetwork.Connectivity {
+			Expect(c.Status).To(Equal(models.NetworkTopologyConnectivityStatusSuccess))
+			Expect(c.ReportedAt).NotTo(Equal(strfmt.DateTime{}))
+		}
+	})
+
 	It("install_cluster_states", func() {
 		clusterID := *cluster.ID
 
@@ -1245,6 +1539,10 @@ var _ = Describe("cluster install", func() {
 		generateHWPostStepReply(mh2, validHwInfo, "mh2")
 		mh3 := registerHost(clusterID)
 		generateHWPostStepReply(mh3, validHwInfo, "mh3")
+		generateFullMeshConnectivity(ctx, clusterID, "1.2.3.10")
+		generateImagesAvailability(ctx, clusterID)
+		generateDisksSpeed(ctx, clusterID)
+		generateTimeSync(ctx, clusterID)
 
 		apiVip := "1.2.3.5"
 		ingressVip := "1.2.3.6"
@@ -1462,6 +1760,10 @@ var _ = Describe("cluster install", func() {
 
 		By("Changing hostname, verify host is known now")
 		generateHWPostStepReply(h4, validHwInfo, "h4")
+		generateFullMeshConnectivity(ctx, clusterID, "1.2.3.10")
+		generateImagesAvailability(ctx, clusterID)
+		generateDisksSpeed(ctx, clusterID)
+		generateTimeSync(ctx, clusterID)
 		waitForHostState(ctx, clusterID, *h4.ID, "known", 60*time.Second)
 		h4 = getHost(clusterID, *h4.ID)
 		Expect(h4.RequestedHostname).Should(Equal("h4"))
@@ -1532,6 +1834,48 @@ var _ = Describe("cluster install", func() {
 
 	})
 
+	It("[only_k8s]select installation disk", func() {
+		clusterID := *cluster.ID
+		hosts := register3nodes(clusterID)
+		h1 := getHost(clusterID, *hosts[0].ID)
+
+		By("Selecting a valid disk")
+		_, err := bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{
+				HostsInstallationDisks: []*models.ClusterUpdateParamsHostsInstallationDisksItems0{
+					{ID: *h1.ID, InstallationDiskID: "0x5000c500a0b1c2d3"},
+				},
+				HostsRoles: []*models.ClusterUpdateParamsHostsRolesItems0{
+					{ID: *h1.ID, Role: models.HostRoleUpdateParamsMaster},
+				},
+			},
+			ClusterID: clusterID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		h1 = getHost(clusterID, *h1.ID)
+		Expect(h1.InstallationDiskID).Should(Equal("0x5000c500a0b1c2d3"))
+		waitForHostState(ctx, clusterID, *h1.ID, models.HostStatusKnown, time.Minute)
+
+		By("Selecting a disk that is not in the inventory")
+		_, err = bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{
+				HostsInstallationDisks: []*models.ClusterUpdateParamsHostsInstallationDisksItems0{
+					{ID: *h1.ID, InstallationDiskID: "no-such-disk"},
+				},
+			},
+			ClusterID: clusterID,
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewUpdateClusterBadRequest()))
+		h1 = getHost(clusterID, *h1.ID)
+		Expect(h1.InstallationDiskID).Should(Equal("0x5000c500a0b1c2d3"))
+
+		By("Removing the selected disk from the inventory")
+		hwInfo := *validHwInfo
+		hwInfo.Disks = []*models.Disk{validHwInfo.Disks[0]}
+		generateHWPostStepReply(h1, &hwInfo, "h1")
+		waitForHostState(ctx, clusterID, *h1.ID, models.HostStatusInsufficient, time.Minute)
+	})
+
 	It("[only_k8s]different_roles_stages", func() {
 		clusterID := *cluster.ID
 		registerHostsAndSetRoles(clusterID, 4)
@@ -1618,6 +1962,11 @@ var _ = Describe("cluster install", func() {
 		waitForHostState(ctx, clusterID, *h5.ID, host.HostStatusPendingForInput, time.Minute)
 		waitForHostState(ctx, clusterID, *h1.ID, models.HostStatusInsufficient, time.Minute)
 
+		generateFullMeshConnectivity(ctx, clusterID, "1.2.3.10")
+		generateImagesAvailability(ctx, clusterID)
+		generateDisksSpeed(ctx, clusterID)
+		generateTimeSync(ctx, clusterID)
+
 		By("Change requested hostname of an insufficient node")
 		_, err = bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
 			ClusterUpdateParams: &models.ClusterUpdateParams{
@@ -1793,6 +2142,10 @@ func registerHostsAndSetRoles(clusterID strfmt.UUID, numHosts int) []*models.Hos
 		})
 		Expect(err).NotTo(HaveOccurred())
 	}
+	generateFullMeshConnectivity(ctx, clusterID, "1.2.3.10")
+	generateImagesAvailability(ctx, clusterID)
+	generateDisksSpeed(ctx, clusterID)
+	generateTimeSync(ctx, clusterID)
 	apiVip := ""
 	ingressVip := ""
 	_, err := bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
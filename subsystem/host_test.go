@@ -1,12 +1,17 @@
 package subsystem
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/filanov/stateswitch/examples/host/host"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 
@@ -150,6 +155,82 @@ var _ = Describe("Host tests", func() {
 		Expect(ok).Should(Equal(true))
 	})
 
+	It("next step long poll", func() {
+		host := registerHost(clusterID)
+		getWaitingSteps := func(waitSeconds int64) models.Steps {
+			reply, err := bmclient.Installer.GetNextSteps(ctx, &installer.GetNextStepsParams{
+				ClusterID:   clusterID,
+				HostID:      *host.ID,
+				WaitSeconds: swag.Int64(waitSeconds),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return *reply.GetPayload()
+		}
+		steps := getWaitingSteps(1)
+		Expect(steps.NextInstructionSeconds).Should(Equal(int64(0)))
+		_, ok := getStepInList(steps, models.StepTypeInventory)
+		Expect(ok).Should(Equal(true))
+
+		// Nothing changed since the steps were sent
+		steps = getWaitingSteps(1)
+		Expect(steps.NextInstructionSeconds).Should(Equal(int64(0)))
+		Expect(steps.Instructions).Should(BeEmpty())
+
+		// Disabling the host wakes the waiting agent
+		go func() {
+			defer GinkgoRecover()
+			time.Sleep(time.Second)
+			_, err := bmclient.Installer.DisableHost(ctx, &installer.DisableHostParams{
+				ClusterID: clusterID,
+				HostID:    *host.ID,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}()
+		start := time.Now()
+		steps = getWaitingSteps(30)
+		Expect(time.Since(start)).Should(BeNumerically("<", 20*time.Second))
+		Expect(steps.Instructions).Should(BeEmpty())
+		Expect(*getHost(clusterID, *host.ID).Status).Should(Equal(models.HostStatusDisabled))
+
+		// Agents that do not wait keep getting the steps interval
+		steps = getNextSteps(clusterID, *host.ID)
+		Expect(steps.NextInstructionSeconds).Should(Equal(int64(120)))
+	})
+
+	It("outdated agent", func() {
+		host := registerHostWithAgentVersion(clusterID, "quay.io/ocpmetal/agent:v0.0.1")
+		Expect(host.AgentVersionStatus).Should(Equal(models.AgentVersionStatusCompatible))
+		// A supported agent keeps getting its steps, it is upgraded after them only when the version of the service
+		// agent is known to be newer
+		steps := getNextSteps(clusterID, *host.ID)
+		_, ok := getStepInList(steps, models.StepTypeInventory)
+		Expect(ok).Should(Equal(true))
+		if upgrade, ok := getStepInList(steps, models.StepTypeUpgradeAgent); ok {
+			Expect(steps.Instructions[len(steps.Instructions)-1]).Should(Equal(upgrade))
+			Expect(upgrade.Args[1]).Should(ContainSubstring("--agent-version " + currentAgentVersion()))
+		}
+
+		c, err := bmclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.GetPayload().AgentVersionDrift.ExpectedVersion).Should(Equal(currentAgentVersion()))
+		Expect(c.GetPayload().AgentVersionDrift.CompatibleHostIds).Should(ConsistOf(*host.ID))
+
+		// The upgraded agent registers again with the current version
+		_, err = bmclient.Installer.RegisterHost(ctx, &installer.RegisterHostParams{
+			ClusterID: clusterID,
+			NewHostParams: &models.HostCreateParams{
+				HostID:                host.ID,
+				DiscoveryAgentVersion: currentAgentVersion(),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		steps = getNextSteps(clusterID, *host.ID)
+		_, ok = getStepInList(steps, models.StepTypeUpgradeAgent)
+		Expect(ok).Should(Equal(false))
+		_, ok = getStepInList(steps, models.StepTypeInventory)
+		Expect(ok).Should(Equal(true))
+	})
+
 	It("host installation progress", func() {
 		host := registerHost(clusterID)
 		Expect(db.Model(host).Update("status", "installing").Error).NotTo(HaveOccurred())
@@ -283,8 +364,8 @@ var _ = Describe("Host tests", func() {
 		})
 		Expect(err).ToNot(HaveOccurred())
 		Expect(freeAddressesReply.Payload).To(HaveLen(2))
-		Expect(freeAddressesReply.Payload[0]).To(Equal(strfmt.IPv4("10.0.0.0")))
-		Expect(freeAddressesReply.Payload[1]).To(Equal(strfmt.IPv4("10.0.0.1")))
+		Expect(freeAddressesReply.Payload[0]).To(Equal("10.0.0.0"))
+		Expect(freeAddressesReply.Payload[1]).To(Equal("10.0.0.1"))
 
 		freeAddressesReply, err = bmclient.Installer.GetFreeAddresses(ctx, &installer.GetFreeAddressesParams{
 			ClusterID: clusterID,
@@ -292,7 +373,7 @@ var _ = Describe("Host tests", func() {
 		})
 		Expect(err).ToNot(HaveOccurred())
 		Expect(freeAddressesReply.Payload).To(HaveLen(1))
-		Expect(freeAddressesReply.Payload[0]).To(Equal(strfmt.IPv4("10.0.1.0")))
+		Expect(freeAddressesReply.Payload[0]).To(Equal("10.0.1.0"))
 
 		freeAddressesReply, err = bmclient.Installer.GetFreeAddresses(ctx, &installer.GetFreeAddressesParams{
 			ClusterID: clusterID,
@@ -362,6 +443,13 @@ var _ = Describe("Host tests", func() {
 			Step:      &models.DebugStep{Command: swag.String("echo hello")},
 		})
 		Expect(err).NotTo(HaveOccurred())
+		list, err := bmclient.Installer.ListDebugSteps(ctx, &installer.ListDebugStepsParams{
+			ClusterID: clusterID,
+			HostID:    *host1.ID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.GetPayload()).To(HaveLen(1))
+		Expect(*list.GetPayload()[0].Status).Should(Equal(models.DebugStepResultStatusQueued))
 
 		var step *models.Step
 		var ok bool
@@ -371,8 +459,8 @@ var _ = Describe("Host tests", func() {
 
 		step, ok = getStepInList(getNextSteps(clusterID, *host1.ID), models.StepTypeExecute)
 		Expect(ok).Should(Equal(true))
-		Expect(step.Command).Should(Equal("bash"))
-		Expect(step.Args).Should(Equal([]string{"-c", "echo hello"}))
+		Expect(step.Command).Should(Equal("timeout"))
+		Expect(step.Args).Should(Equal([]string{"600", "bash", "-c", "echo hello"}))
 
 		// debug executed only once
 		_, ok = getStepInList(getNextSteps(clusterID, *host1.ID), models.StepTypeExecute)
@@ -388,6 +476,137 @@ var _ = Describe("Host tests", func() {
 			},
 		})
 		Expect(err).NotTo(HaveOccurred())
+
+		debugStep, err := bmclient.Installer.GetDebugStep(ctx, &installer.GetDebugStepParams{
+			ClusterID: clusterID,
+			HostID:    *host1.ID,
+			StepID:    step.StepID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*debugStep.GetPayload().Status).Should(Equal(models.DebugStepResultStatusCompleted))
+		Expect(debugStep.GetPayload().Stdout).Should(Equal("hello"))
+
+		debugSteps, err := bmclient.Installer.ListDebugSteps(ctx, &installer.ListDebugStepsParams{
+			ClusterID: clusterID,
+			HostID:    *host1.ID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(debugSteps.GetPayload()).To(HaveLen(1))
+
+		hostSteps, err := bmclient.Installer.ListHostSteps(ctx, &installer.ListHostStepsParams{
+			ClusterID: clusterID,
+			HostID:    *host1.ID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		var found bool
+		for _, hostStep := range hostSteps.GetPayload() {
+			if *hostStep.StepID == step.StepID {
+				found = true
+				Expect(hostStep.Output).Should(Equal("hello"))
+			}
+		}
+		Expect(found).Should(BeTrue())
+	})
+
+	It("host logs", func() {
+		h := registerHost(clusterID)
+		_, err := bmclient.Installer.DownloadHostLogs(ctx, &installer.DownloadHostLogsParams{
+			ClusterID: clusterID,
+			HostID:    *h.ID,
+		}, ioutil.Discard)
+		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewDownloadHostLogsNotFound())))
+
+		_, err = bmclient.Installer.UploadHostLogs(ctx, &installer.UploadHostLogsParams{
+			ClusterID: clusterID,
+			HostID:    *h.ID,
+			Upfile:    runtime.NamedReader("logs.tar.gz", strings.NewReader("logs")),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		buf := &bytes.Buffer{}
+		_, err = bmclient.Installer.DownloadHostLogs(ctx, &installer.DownloadHostLogsParams{
+			ClusterID: clusterID,
+			HostID:    *h.ID,
+		}, buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(buf.String()).Should(Equal("logs"))
+	})
+
+	It("labels, notes and search", func() {
+		h1 := registerHost(clusterID)
+		h2 := registerHost(clusterID)
+		_, err := bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{
+				HostsLabels: []*models.ClusterUpdateParamsHostsLabelsItems0{
+					{ID: *h1.ID, Labels: map[string]string{"rack": "r1", "gpu": ""}},
+					{ID: *h2.ID, Labels: map[string]string{"rack": "r2"}},
+				},
+				HostsNotes: []*models.ClusterUpdateParamsHostsNotesItems0{
+					{ID: *h1.ID, Notes: "replace the PSU"},
+				},
+			},
+			ClusterID: clusterID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		h1 = getHost(clusterID, *h1.ID)
+		Expect(h1.Notes).Should(Equal("replace the PSU"))
+
+		reply, err := bmclient.Installer.SearchHosts(ctx, &installer.SearchHostsParams{Labels: swag.String("rack=r1,gpu")})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reply.GetPayload().TotalCount).Should(Equal(int64(1)))
+		Expect(*reply.GetPayload().Hosts[0].ID).Should(Equal(*h1.ID))
+
+		reply, err = bmclient.Installer.SearchHosts(ctx, &installer.SearchHostsParams{Labels: swag.String("rack"),
+			Limit: swag.Int64(1)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reply.GetPayload().TotalCount).Should(Equal(int64(2)))
+		Expect(reply.GetPayload().Hosts).Should(HaveLen(1))
+
+		_, err = bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterUpdateParams: &models.ClusterUpdateParams{
+				HostsLabels: []*models.ClusterUpdateParamsHostsLabelsItems0{
+					{ID: *h1.ID, Labels: map[string]string{"rack": "r 1"}},
+				},
+			},
+			ClusterID: clusterID,
+		})
+		Expect(err).To(BeAssignableToTypeOf(installer.NewUpdateClusterBadRequest()))
+	})
+
+	It("rebind host", func() {
+		h := registerHost(clusterID)
+		cluster2, err := bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
+			NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("another-cluster"),
+				OpenshiftVersion: swag.String("4.5"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		cluster2ID := *cluster2.GetPayload().ID
+
+		reply, err := bmclient.Installer.RebindHost(ctx, &installer.RebindHostParams{
+			ClusterID:        clusterID,
+			HostID:           *h.ID,
+			RebindHostParams: &models.RebindHostParams{NewClusterID: &cluster2ID},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reply.GetPayload().ClusterID).Should(Equal(cluster2ID))
+
+		_, err = bmclient.Installer.GetHost(ctx, &installer.GetHostParams{ClusterID: clusterID, HostID: *h.ID})
+		Expect(err).Should(HaveOccurred())
+		h = getHost(cluster2ID, *h.ID)
+		Expect(h.PreviousClusterID).Should(Equal(clusterID))
+
+		By("agent polling the previous cluster is told about the new cluster")
+		steps, err := bmclient.Installer.GetNextSteps(ctx, &installer.GetNextStepsParams{ClusterID: clusterID, HostID: *h.ID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(steps.GetPayload().ClusterID).Should(Equal(cluster2ID))
+		Expect(steps.GetPayload().Instructions).Should(BeEmpty())
+
+		steps, err = bmclient.Installer.GetNextSteps(ctx, &installer.GetNextStepsParams{ClusterID: cluster2ID, HostID: *h.ID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(steps.GetPayload().ClusterID).Should(Equal(cluster2ID))
+		Expect(steps.GetPayload().Instructions).ShouldNot(BeEmpty())
 	})
 
 	It("register_same_host_id", func() {
@@ -76,6 +76,29 @@ var _ = Describe("system-test image tests", func() {
 		Expect(nRegisteredEvents).ShouldNot(Equal(0))
 
 	})
+
+	It("[only_k8s]create_image_with_static_network_config", func() {
+		networkYaml := "interfaces:\n  - name: eth0\n    type: ethernet\n    ipv4:\n      enabled: true\n      address:\n" +
+			"        - ip: 192.168.126.10\n          prefix-length: 24\n"
+		reply, err := bmclient.Installer.GenerateClusterISO(ctx, &installer.GenerateClusterISOParams{
+			ClusterID: clusterID,
+			ImageCreateParams: &models.ImageCreateParams{StaticNetworkConfig: []*models.HostStaticNetworkConfig{
+				{MacAddress: swag.String("52:54:00:aa:bb:01"), NetworkYaml: swag.String(networkYaml)},
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reply.GetPayload().ImageInfo.StaticNetworkConfig).To(ContainSubstring("52:54:00:aa:bb:01"))
+	})
+
+	It("create_image_with_invalid_static_network_config", func() {
+		_, err := bmclient.Installer.GenerateClusterISO(ctx, &installer.GenerateClusterISOParams{
+			ClusterID: clusterID,
+			ImageCreateParams: &models.ImageCreateParams{StaticNetworkConfig: []*models.HostStaticNetworkConfig{
+				{MacAddress: swag.String("52:54:00:aa:bb:01"), NetworkYaml: swag.String("interfaces:\n  - name: br0\n    type: linux-bridge\n")},
+			}},
+		})
+		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewGenerateClusterISOBadRequest())))
+	})
 })
 
 var _ = Describe("image tests", func() {
@@ -2,9 +2,11 @@ package subsystem
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/filanov/bm-inventory/client/installer"
+	"github.com/filanov/bm-inventory/client/versions"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -27,16 +29,28 @@ func strToUUID(s string) *strfmt.UUID {
 }
 
 func registerHost(clusterID strfmt.UUID) *models.Host {
+	return registerHostWithAgentVersion(clusterID, currentAgentVersion())
+}
+
+func registerHostWithAgentVersion(clusterID strfmt.UUID, agentVersion string) *models.Host {
 	host, err := bmclient.Installer.RegisterHost(context.Background(), &installer.RegisterHostParams{
 		ClusterID: clusterID,
 		NewHostParams: &models.HostCreateParams{
-			HostID: strToUUID(uuid.New().String()),
+			HostID:                strToUUID(uuid.New().String()),
+			DiscoveryAgentVersion: agentVersion,
 		},
 	})
 	Expect(err).NotTo(HaveOccurred())
 	return host.GetPayload()
 }
 
+// The discovery agent version of the service, older agents are upgraded to it
+func currentAgentVersion() string {
+	reply, err := bmclient.Versions.ListComponentVersions(context.Background(), &versions.ListComponentVersionsParams{})
+	Expect(err).NotTo(HaveOccurred())
+	return reply.GetPayload().Versions["discovery-agent"]
+}
+
 func getHost(clusterID, hostID strfmt.UUID) *models.Host {
 	host, err := bmclient.Installer.GetHost(context.Background(), &installer.GetHostParams{
 		ClusterID: clusterID,
@@ -83,3 +97,131 @@ func updateProgressWithInfo(hostID strfmt.UUID, clusterID strfmt.UUID, current_s
 	Expect(err).ShouldNot(HaveOccurred())
 	Expect(updateReply).Should(BeAssignableToTypeOf(installer.NewUpdateHostInstallProgressOK()))
 }
+
+// generateFullMeshConnectivity posts a connectivity report for every host of the cluster that reported its inventory,
+// in which all the other such hosts are reachable on remoteIPAddress
+func generateFullMeshConnectivity(ctx context.Context, clusterID strfmt.UUID, remoteIPAddress string) {
+	reply, err := bmclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+	Expect(err).NotTo(HaveOccurred())
+	hosts := make([]*models.Host, 0)
+	for _, h := range reply.GetPayload().Hosts {
+		if h.Inventory != "" {
+			hosts = append(hosts, h)
+		}
+	}
+	for _, h := range hosts {
+		report := models.ConnectivityReport{RemoteHosts: make([]*models.ConnectivityRemoteHost, 0)}
+		for _, remote := range hosts {
+			if remote.ID.String() == h.ID.String() {
+				continue
+			}
+			report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+				HostID: *remote.ID,
+				L2Connectivity: []*models.L2Connectivity{
+					{RemoteIPAddress: remoteIPAddress, Successful: true},
+				},
+				L3Connectivity: []*models.L3Connectivity{
+					{RemoteIPAddress: remoteIPAddress, Successful: true},
+				},
+			})
+		}
+		b, err := json.Marshal(&report)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = bmclient.Installer.PostStepReply(ctx, &installer.PostStepReplyParams{
+			ClusterID: clusterID,
+			HostID:    *h.ID,
+			Reply: &models.StepReply{
+				ExitCode: 0,
+				Output:   string(b),
+				StepID:   string(models.StepTypeConnectivityCheck),
+				StepType: models.StepTypeConnectivityCheck,
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+}
+
+// generateImagesAvailability posts a container images availability report for every host of the cluster that
+// reported its inventory, in which all the images were pulled successfully
+func generateImagesAvailability(ctx context.Context, clusterID strfmt.UUID) {
+	reply, err := bmclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+	Expect(err).NotTo(HaveOccurred())
+	report := models.ContainerImageAvailabilityResponse{
+		Images: []*models.ContainerImageAvailability{
+			{Name: "quay.io/openshift-release-dev/ocp-release:4.5.0-x86_64", Result: models.ContainerImageAvailabilityResultSuccess},
+			{Name: "quay.io/ocpmetal/assisted-installer:latest", Result: models.ContainerImageAvailabilityResultSuccess},
+		},
+	}
+	b, err := json.Marshal(&report)
+	Expect(err).NotTo(HaveOccurred())
+	for _, h := range reply.GetPayload().Hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		_, err = bmclient.Installer.PostStepReply(ctx, &installer.PostStepReplyParams{
+			ClusterID: clusterID,
+			HostID:    *h.ID,
+			Reply: &models.StepReply{
+				ExitCode: 0,
+				Output:   string(b),
+				StepID:   string(models.StepTypeContainerImageAvailability),
+				StepType: models.StepTypeContainerImageAvailability,
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+}
+
+// generateDisksSpeed posts a fast disk speed report for every disk of every host of the cluster that reported its
+// inventory
+func generateTimeSync(ctx context.Context, clusterID strfmt.UUID) {
+	reply, err := bmclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+	Expect(err).NotTo(HaveOccurred())
+	for _, h := range reply.GetPayload().Hosts {
+		now := float64(time.Now().UnixNano()) / float64(time.Second)
+		b, err := json.Marshal(&models.TimeSyncResponse{
+			Time:          now,
+			ReferenceTime: now,
+			Sources:       []*models.TimeSyncSource{{Name: "clock.example.com", State: models.TimeSyncSourceStateSynced, Stratum: 2}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = bmclient.Installer.PostStepReply(ctx, &installer.PostStepReplyParams{
+			ClusterID: clusterID,
+			HostID:    *h.ID,
+			Reply: &models.StepReply{
+				ExitCode: 0,
+				Output:   string(b),
+				StepID:   string(models.StepTypeTimeSync),
+				StepType: models.StepTypeTimeSync,
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+}
+
+func generateDisksSpeed(ctx context.Context, clusterID strfmt.UUID) {
+	reply, err := bmclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+	Expect(err).NotTo(HaveOccurred())
+	for _, h := range reply.GetPayload().Hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		var inventory models.Inventory
+		Expect(json.Unmarshal([]byte(h.Inventory), &inventory)).NotTo(HaveOccurred())
+		for _, disk := range inventory.Disks {
+			b, err := json.Marshal(&models.DiskSpeedCheckResponse{Path: "/dev/" + disk.Name, IoSyncDuration: 2})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = bmclient.Installer.PostStepReply(ctx, &installer.PostStepReplyParams{
+				ClusterID: clusterID,
+				HostID:    *h.ID,
+				Reply: &models.StepReply{
+					ExitCode: 0,
+					Output:   string(b),
+					StepID:   string(models.StepTypeDiskSpeedCheck),
+					StepType: models.StepTypeDiskSpeedCheck,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+}
@@ -13,6 +13,8 @@ ENVS = [
     ("HW_VALIDATOR_MIN_RAM_GIB_WORKER", "3"),
     ("HW_VALIDATOR_MIN_RAM_GIB_MASTER", "8"),
     ("HW_VALIDATOR_MIN_DISK_SIZE_GIB", "10"),
+    ("HW_VALIDATOR_MIN_NIC_SPEED_MBPS", "1000"),
+    ("HW_VALIDATOR_MAX_DISK_SYNC_DURATION_MS", "10"),
     ("INSTALLER_IMAGE", ""),
     ("CONTROLLER_IMAGE", ""),
     ("INVENTORY_URL", ""),